@@ -1,16 +1,74 @@
 import logging
+import textwrap
+from datetime import datetime
 from typing import AsyncGenerator, Literal
 
 from openai import AsyncOpenAI
 from pydantic import BaseModel
 
 
+class Allergy(BaseModel):
+    substance: str
+    reaction: str = ""
+    severity: str = ""
+
+
+class Medication(BaseModel):
+    name: str
+    dosage: str = ""
+    frequency: str = ""
+
+
+class Condition(BaseModel):
+    name: str
+    diagnosed_year: int = 0
+    notes: str = ""
+
+
+class Surgery(BaseModel):
+    procedure: str
+    year: int = 0
+    notes: str = ""
+
+
+class FamilyHistoryEntry(BaseModel):
+    relative: str
+    condition: str
+
+
 class PatientInfo(BaseModel):
     name: str
     age: int
     gender: str
     weight: float
     height: float
+    allergies: list[Allergy] = []
+    medications: list[Medication] = []
+    conditions: list[Condition] = []
+    surgeries: list[Surgery] = []
+    family_history: list[FamilyHistoryEntry] = []
+
+
+class VitalSigns(BaseModel):
+    recorded_at: datetime
+    systolic: int | None = None
+    diastolic: int | None = None
+    heart_rate: int | None = None
+    temperature: float | None = None
+    spo2: int | None = None
+    glucose: float | None = None
+    weight: float | None = None
+
+
+class VitalTrend(BaseModel):
+    metric: str
+    unit: str
+    count: int
+    latest: float
+    average: float
+    min: float
+    max: float
+    change: float
 
 
 class Message(BaseModel):
@@ -18,6 +76,136 @@ class Message(BaseModel):
     content: str
 
 
+class DiagnoseInput(BaseModel):
+    patient_info: PatientInfo
+    messages: list[Message]
+    recent_vitals: list[VitalSigns] = []
+    vital_trends: list[VitalTrend] = []
+    conversation_summary: str = ""
+    # Generation parameters chosen by the web server; unset ones use the defaults of DoctorChat
+    model: str = ""
+    temperature: float | None = None
+    max_tokens: int = 0
+    language: str = ""
+
+
+class DiagnoseMetadata(BaseModel):
+    model: str
+
+
+class TokenUsage(BaseModel):
+    prompt_tokens: int
+    completion_tokens: int
+
+
+class Summary(BaseModel):
+    summary: str
+    model: str
+    usage: TokenUsage | None = None
+
+
+class DifferentialDiagnosis(BaseModel):
+    condition: str
+    likelihood: float
+    rationale: str
+
+
+class StructuredDiagnosis(BaseModel):
+    differentials: list[DifferentialDiagnosis]
+    triage_level: Literal["emergency", "urgent", "routine", "self_care"]
+    specialties: list[str]
+    red_flags: list[str]
+    disclaimers: list[str]
+    next_steps: list[str]
+
+
+class Moderation(BaseModel):
+    outcome: Literal["allow", "warn", "block"]
+    categories: list[str]
+
+
+# Moderation categories that block a message; the others only flag it for review, since patients
+# describe injuries, self-harm thoughts and drug use when asking for help
+BLOCKED_CATEGORIES = {"sexual/minors", "illicit/violent", "hate/threatening", "harassment/threatening"}
+
+
+# Names of the vital sign metrics in the prompt
+VITAL_NAMES = {
+    "systolic": "Pressão sistólica",
+    "diastolic": "Pressão diastólica",
+    "heart_rate": "Frequência cardíaca",
+    "temperature": "Temperatura",
+    "spo2": "Saturação de oxigênio",
+    "glucose": "Glicemia",
+    "weight": "Peso",
+}
+
+
+def _details(*values) -> str:
+    """Joins the non-empty details of a history entry, in parentheses"""
+    details = ", ".join(str(value) for value in values if value)
+    return f" ({details})" if details else ""
+
+
+def format_history(patient_info: PatientInfo) -> str:
+    """Renders the medical history of a patient as prompt lines, leaving out empty sections"""
+    sections = [
+        ("Alergias", [f"{a.substance}{_details(a.reaction, a.severity)}" for a in patient_info.allergies]),
+        ("Medicamentos em uso", [f"{m.name}{_details(m.dosage, m.frequency)}" for m in patient_info.medications]),
+        (
+            "Condições de saúde",
+            [
+                f"{c.name}{_details(c.diagnosed_year and f'desde {c.diagnosed_year}', c.notes)}"
+                for c in patient_info.conditions
+            ],
+        ),
+        ("Cirurgias", [f"{s.procedure}{_details(s.year, s.notes)}" for s in patient_info.surgeries]),
+        ("Histórico familiar", [f"{f.relative}: {f.condition}" for f in patient_info.family_history]),
+    ]
+
+    lines = []
+    for title, entries in sections:
+        if entries:
+            lines.append(f"- {title}:")
+            lines.extend(f"    - {entry}" for entry in entries)
+    return "\n".join(lines) if lines else "- Nenhum histórico médico informado"
+
+
+def format_vitals(recent_vitals: list[VitalSigns], vital_trends: list[VitalTrend]) -> str:
+    """Renders the recent vital signs and their trends as prompt lines"""
+    lines = []
+    if recent_vitals:
+        lines.append("- Medições recentes:")
+        for vitals in sorted(recent_vitals, key=lambda v: v.recorded_at, reverse=True):
+            values = []
+            if vitals.systolic is not None and vitals.diastolic is not None:
+                values.append(f"pressão {vitals.systolic}/{vitals.diastolic} mmHg")
+            elif vitals.systolic is not None:
+                values.append(f"pressão sistólica {vitals.systolic} mmHg")
+            elif vitals.diastolic is not None:
+                values.append(f"pressão diastólica {vitals.diastolic} mmHg")
+            if vitals.heart_rate is not None:
+                values.append(f"frequência cardíaca {vitals.heart_rate} bpm")
+            if vitals.temperature is not None:
+                values.append(f"temperatura {vitals.temperature:.1f} °C")
+            if vitals.spo2 is not None:
+                values.append(f"saturação {vitals.spo2}%")
+            if vitals.glucose is not None:
+                values.append(f"glicemia {vitals.glucose:g} mg/dL")
+            if vitals.weight is not None:
+                values.append(f"peso {vitals.weight:.1f} kg")
+            lines.append(f"    - {vitals.recorded_at:%d/%m/%Y %H:%M} (UTC): {', '.join(values)}")
+    if vital_trends:
+        lines.append("- Tendências:")
+        for trend in vital_trends:
+            name = VITAL_NAMES.get(trend.metric, trend.metric)
+            lines.append(
+                f"    - {name}: última {trend.latest:g} {trend.unit}, média {trend.average:.1f}, "
+                f"mínima {trend.min:g}, máxima {trend.max:g}, variação {trend.change:+g} em {trend.count} medições"
+            )
+    return "\n".join(lines) if lines else "- Nenhuma medição informada"
+
+
 class DoctorChat:
     def __init__(self):
         self.client = AsyncOpenAI()
@@ -41,35 +229,181 @@ class DoctorChat:
                 - Sexo: {gender}
                 - Peso: {weight}kg
                 - Altura: {height}cm
+
+            Histórico médico do paciente:
+                {history}
+
+            Sinais vitais do paciente:
+                {vitals}
+
+            Considere as alergias e os medicamentos em uso antes de sugerir qualquer tratamento,
+            e as tendências dos sinais vitais ao avaliar os sintomas.
+        """
+
+        self.language_prompt = """
+            Responda no idioma de código BCP 47 "{language}", mesmo que as instruções
+            acima peçam português brasileiro, mantendo o formato markdown.
+        """
+
+        self.conversation_summary_prompt = """
+            Resumo das mensagens anteriores da consulta, que não são mais enviadas:
+                {summary}
+        """
+
+        self.summary_prompt = """
+            Você resume consultas médicas para que possam continuar sem o histórico
+            completo. Escreva em português brasileiro, em tópicos curtos, mantendo
+            sintomas, datas, medicamentos, alergias, sinais de alerta e orientações
+            já dadas. Não acrescente nada que não esteja na conversa.
+        """
+
+        self.structure_prompt = """
+            Você recebe a resposta de um médico a um paciente e extrai dela um resumo
+            estruturado, em português brasileiro: os diagnósticos diferenciais do mais
+            provável ao menos provável, com a probabilidade entre 0 e 1 e a justificativa,
+            o nível de triagem ("emergency", "urgent", "routine" ou "self_care"), as
+            especialidades indicadas, os sinais de alerta, as ressalvas e os próximos passos.
+            Use apenas o que está na resposta; deixe vazias as listas sem informação.
         """
 
         self.model = "gpt-4.1"
+        self.moderation_model = "omni-moderation-latest"
         self.temperature = 0.2
         self.logger.info(f"DoctorChat initialized with model: {self.model}")
 
-    async def diagnose(self, patient_info: PatientInfo, messages: list[Message]) -> AsyncGenerator[str, None]:
-        self.logger.info(f"Processing diagnosis request with messages length: {len(messages)}")
+    async def diagnose(
+        self, diagnose_input: DiagnoseInput
+    ) -> AsyncGenerator[DiagnoseMetadata | str | StructuredDiagnosis | TokenUsage, None]:
+        """Streams the metadata and the markdown reply in chunks, then its structured summary when one was extracted
+        and the token usage of the reply when the model provider sent it"""
+        self.logger.info(f"Processing diagnosis request with messages length: {len(diagnose_input.messages)}")
+
+        model = diagnose_input.model or self.model
+        temperature = self.temperature if diagnose_input.temperature is None else diagnose_input.temperature
+        yield DiagnoseMetadata(model=model)
 
-        system_prompt = {"role": "developer", "content": self.system_prompt.format(**patient_info.model_dump())}
+        patient_info = diagnose_input.patient_info
+        system_prompt = {
+            "role": "developer",
+            "content": self.system_prompt.format(
+                history=textwrap.indent(format_history(patient_info), " " * 16).lstrip(),
+                vitals=textwrap.indent(
+                    format_vitals(diagnose_input.recent_vitals, diagnose_input.vital_trends), " " * 16
+                ).lstrip(),
+                **patient_info.model_dump(),
+            ),
+        }
+        if diagnose_input.language:
+            system_prompt["content"] += self.language_prompt.format(language=diagnose_input.language)
+        if diagnose_input.conversation_summary:
+            system_prompt["content"] += self.conversation_summary_prompt.format(
+                summary=textwrap.indent(diagnose_input.conversation_summary, " " * 16).lstrip()
+            )
         self.logger.debug(f"System prompt: {system_prompt}")
 
-        dict_messages = [{"role": message.role, "content": message.content} for message in messages]
+        dict_messages = [{"role": message.role, "content": message.content} for message in diagnose_input.messages]
         self.logger.debug(f"Messages: {dict_messages}")
 
         try:
             response = await self.client.chat.completions.create(
-                model=self.model,
+                model=model,
                 messages=[system_prompt, *dict_messages],  # type: ignore
-                temperature=self.temperature,
+                temperature=temperature,
+                max_tokens=diagnose_input.max_tokens or None,
                 stream=True,
+                stream_options={"include_usage": True},
             )
 
+            reply = ""
+            usage = None
             async for chunk in response:
+                # The usage comes in a last chunk without choices
+                if chunk.usage is not None:
+                    usage = TokenUsage(
+                        prompt_tokens=chunk.usage.prompt_tokens, completion_tokens=chunk.usage.completion_tokens
+                    )
+                if not chunk.choices:
+                    continue
                 delta = chunk.choices[0].delta.content
 
                 if delta is not None:
+                    reply += delta
                     yield delta
 
         except Exception as e:
             self.logger.error(f"Error generating diagnosis: {str(e)}")
             raise
+
+        summary = await self.structure(reply)
+        if summary is not None:
+            yield summary
+        if usage is not None:
+            yield usage
+
+    async def structure(self, reply: str) -> StructuredDiagnosis | None:
+        """Extracts the structured summary of a reply; the reply stands on its own, so failures only lose the summary"""
+        if not reply.strip():
+            return None
+
+        try:
+            response = await self.client.beta.chat.completions.parse(
+                model=self.model,
+                messages=[
+                    {"role": "developer", "content": self.structure_prompt},
+                    {"role": "user", "content": reply},
+                ],
+                temperature=self.temperature,
+                response_format=StructuredDiagnosis,
+            )
+            return response.choices[0].message.parsed
+
+        except Exception as e:
+            self.logger.warning(f"Error structuring diagnosis: {str(e)}")
+            return None
+
+    async def summarize(self, previous_summary: str, messages: list[Message], max_tokens: int) -> Summary:
+        self.logger.info(f"Processing summary request with messages length: {len(messages)}")
+
+        content = ""
+        if previous_summary:
+            content += f"Resumo anterior:\n{previous_summary}\n\n"
+        content += "Mensagens seguintes:\n"
+        content += "\n".join(f"- {message.role}: {message.content}" for message in messages)
+
+        try:
+            response = await self.client.chat.completions.create(
+                model=self.model,
+                messages=[
+                    {"role": "developer", "content": self.summary_prompt},
+                    {"role": "user", "content": content},
+                ],
+                temperature=self.temperature,
+                max_tokens=max_tokens or None,
+            )
+            usage = None
+            if response.usage is not None:
+                usage = TokenUsage(
+                    prompt_tokens=response.usage.prompt_tokens, completion_tokens=response.usage.completion_tokens
+                )
+            return Summary(summary=response.choices[0].message.content or "", model=self.model, usage=usage)
+
+        except Exception as e:
+            self.logger.error(f"Error generating summary: {str(e)}")
+            raise
+
+    async def moderate(self, text: str) -> Moderation:
+        """Checks a user message with the moderation API, naming the flagged categories in snake case"""
+        try:
+            response = await self.client.moderations.create(model=self.moderation_model, input=text)
+        except Exception as e:
+            self.logger.error(f"Error moderating message: {str(e)}")
+            raise
+
+        result = response.results[0]
+        flagged = [name for name, value in result.categories.model_dump(by_alias=True).items() if value]
+        categories = [name.replace("/", "_").replace("-", "_") for name in flagged]
+        if any(name in BLOCKED_CATEGORIES for name in flagged):
+            return Moderation(outcome="block", categories=categories)
+        if result.flagged or flagged:
+            return Moderation(outcome="warn", categories=categories)
+        return Moderation(outcome="allow", categories=[])
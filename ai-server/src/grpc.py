@@ -1,10 +1,26 @@
 import asyncio
 import logging
 import signal
+from datetime import datetime, timezone
 
 import grpc
 
-from src.doctor_chat import DoctorChat, Message, PatientInfo
+from src.doctor_chat import (
+    Allergy,
+    Condition,
+    DiagnoseInput,
+    DiagnoseMetadata,
+    DoctorChat,
+    FamilyHistoryEntry,
+    Medication,
+    Message,
+    PatientInfo,
+    StructuredDiagnosis,
+    Surgery,
+    TokenUsage,
+    VitalSigns,
+    VitalTrend,
+)
 from src.logging_config import configure_logging
 
 from .proto import ai_server_pb2, ai_server_pb2_grpc
@@ -21,23 +37,13 @@ class AiServicer(ai_server_pb2_grpc.AiServiceServicer):
             client_ip = context.peer()
             self.logger.info(f"Received diagnosis request from {client_ip}")
 
-            # Convert proto PatientInfo to our PatientInfo model
-            patient_info = PatientInfo(
-                name=request.patient_info.name,
-                age=request.patient_info.age,
-                gender=request.patient_info.gender,
-                weight=request.patient_info.weight,
-                height=request.patient_info.height,
-            )
-
-            # Convert proto Messages to our Message model
-            messages = [Message(role=msg.role, content=msg.content) for msg in request.messages]
+            diagnose_input = self._diagnose_input(request)
 
-            self.logger.debug(f"Received {len(messages)} messages")
+            self.logger.debug(f"Received {len(diagnose_input.messages)} messages")
 
             # Stream the diagnosis response
-            async for content_chunk in self.doctor_chat.diagnose(patient_info, messages):
-                yield ai_server_pb2.DiagnoseResponse(content=content_chunk)
+            async for event in self.doctor_chat.diagnose(diagnose_input):
+                yield ai_server_pb2.DiagnoseResponse(**self._diagnose_event(event))
 
             self.logger.info(f"Diagnosis stream completed for client {client_ip}")
 
@@ -46,6 +52,180 @@ class AiServicer(ai_server_pb2_grpc.AiServiceServicer):
             context.set_code(grpc.StatusCode.INTERNAL)
             context.set_details(f"Internal server error occurred: {str(e)}")
 
+    async def Chat(self, request_iterator, context):
+        """Answers the turns of a chat session one at a time, stopping a turn when asked to"""
+        client_ip = context.peer()
+        self.logger.info(f"Chat session opened by {client_ip}")
+
+        events: asyncio.Queue = asyncio.Queue()
+        turns: dict[str, asyncio.Task] = {}
+
+        async def answer(turn_id, request):
+            try:
+                diagnose_input = self._diagnose_input(request)
+                async for event in self.doctor_chat.diagnose(diagnose_input):
+                    events.put_nowait(ai_server_pb2.ChatSessionResponse(turn_id=turn_id, **self._diagnose_event(event)))
+                stopped = False
+            except asyncio.CancelledError:
+                stopped = True
+            except Exception as e:
+                # There is no error event, so a failed turn fails the whole session
+                events.put_nowait(e)
+                return
+            finally:
+                turns.pop(turn_id, None)
+            events.put_nowait(ai_server_pb2.ChatSessionResponse(turn_id=turn_id, end=ai_server_pb2.TurnEnd(stopped=stopped)))
+
+        async def read_requests():
+            try:
+                async for request in request_iterator:
+                    action = request.WhichOneof("action")
+                    if action == "turn":
+                        self.logger.debug(f"Starting turn {request.turn_id} with {len(request.turn.messages)} messages")
+                        turns[request.turn_id] = asyncio.create_task(answer(request.turn_id, request.turn))
+                    elif action == "stop" and request.turn_id in turns:
+                        self.logger.debug(f"Stopping turn {request.turn_id}")
+                        turns[request.turn_id].cancel()
+                # Let the turns still being answered finish once the client stops sending
+                await asyncio.gather(*turns.values(), return_exceptions=True)
+            finally:
+                events.put_nowait(None)
+
+        reader = asyncio.create_task(read_requests())
+        try:
+            while (event := await events.get()) is not None:
+                if isinstance(event, Exception):
+                    raise event
+                yield event
+            await reader
+            self.logger.info(f"Chat session closed by {client_ip}")
+        except Exception as e:
+            self.logger.error(f"Error in Chat method: {str(e)}", exc_info=True)
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal server error occurred: {str(e)}")
+        finally:
+            # The client went away or the session failed, so nobody reads the remaining answers
+            reader.cancel()
+            for task in list(turns.values()):
+                task.cancel()
+
+    async def Summarize(self, request, context):
+        """Summarizes the oldest messages of a conversation, extending the previous summary"""
+        try:
+            self.logger.info(f"Received summary request from {context.peer()}")
+
+            messages = [Message(role=msg.role, content=msg.content) for msg in request.messages]
+            summary = await self.doctor_chat.summarize(request.previous_summary, messages, request.max_tokens)
+            return ai_server_pb2.SummarizeResponse(
+                summary=summary.summary, model=summary.model, usage=self._token_usage(summary.usage)
+            )
+
+        except Exception as e:
+            self.logger.error(f"Error in Summarize method: {str(e)}", exc_info=True)
+            await context.abort(grpc.StatusCode.INTERNAL, f"Internal server error occurred: {str(e)}")
+
+    async def Moderate(self, request, context):
+        """Checks a user message before the web server diagnoses it"""
+        try:
+            self.logger.info(f"Received moderation request from {context.peer()}")
+
+            moderation = await self.doctor_chat.moderate(request.text)
+            return ai_server_pb2.ModerateResponse(outcome=moderation.outcome, categories=moderation.categories)
+
+        except Exception as e:
+            self.logger.error(f"Error in Moderate method: {str(e)}", exc_info=True)
+            await context.abort(grpc.StatusCode.INTERNAL, f"Internal server error occurred: {str(e)}")
+
+    def _token_usage(self, usage):
+        """Converts our TokenUsage model to its proto message, None when the model provider sent none"""
+        if usage is None:
+            return None
+        return ai_server_pb2.TokenUsage(prompt_tokens=usage.prompt_tokens, completion_tokens=usage.completion_tokens)
+
+    def _diagnose_event(self, event):
+        """Converts an event of a diagnosis to the fields of its proto DiagnoseResponse or ChatSessionResponse"""
+        if isinstance(event, DiagnoseMetadata):
+            return {"metadata": ai_server_pb2.DiagnoseMetadata(model=event.model)}
+        if isinstance(event, TokenUsage):
+            return {"usage": self._token_usage(event)}
+        if isinstance(event, StructuredDiagnosis):
+            return {
+                "summary": ai_server_pb2.StructuredDiagnosis(
+                    differentials=[
+                        ai_server_pb2.DifferentialDiagnosis(
+                            condition=d.condition, likelihood=d.likelihood, rationale=d.rationale
+                        )
+                        for d in event.differentials
+                    ],
+                    triage_level=event.triage_level,
+                    specialties=event.specialties,
+                    red_flags=event.red_flags,
+                    disclaimers=event.disclaimers,
+                    next_steps=event.next_steps,
+                )
+            }
+        return {"content": event}
+
+    def _diagnose_input(self, request):
+        """Converts a proto DiagnoseRequest to our DiagnoseInput model"""
+        info = request.patient_info
+        patient_info = PatientInfo(
+            name=info.name,
+            age=info.age,
+            gender=info.gender,
+            weight=info.weight,
+            height=info.height,
+            allergies=[
+                Allergy(substance=a.substance, reaction=a.reaction, severity=a.severity) for a in info.allergies
+            ],
+            medications=[
+                Medication(name=m.name, dosage=m.dosage, frequency=m.frequency) for m in info.medications
+            ],
+            conditions=[
+                Condition(name=c.name, diagnosed_year=c.diagnosed_year, notes=c.notes) for c in info.conditions
+            ],
+            surgeries=[Surgery(procedure=s.procedure, year=s.year, notes=s.notes) for s in info.surgeries],
+            family_history=[
+                FamilyHistoryEntry(relative=f.relative, condition=f.condition) for f in info.family_history
+            ],
+        )
+        messages = [Message(role=msg.role, content=msg.content) for msg in request.messages]
+        recent_vitals = [
+            VitalSigns(
+                recorded_at=datetime.fromtimestamp(v.recorded_at / 1000, tz=timezone.utc),
+                **{
+                    field: getattr(v, field)
+                    for field in ("systolic", "diastolic", "heart_rate", "temperature", "spo2", "glucose", "weight")
+                    if v.HasField(field)
+                },
+            )
+            for v in request.recent_vitals
+        ]
+        vital_trends = [
+            VitalTrend(
+                metric=t.metric,
+                unit=t.unit,
+                count=t.count,
+                latest=t.latest,
+                average=t.average,
+                min=t.min,
+                max=t.max,
+                change=t.change,
+            )
+            for t in request.vital_trends
+        ]
+        return DiagnoseInput(
+            patient_info=patient_info,
+            messages=messages,
+            recent_vitals=recent_vitals,
+            vital_trends=vital_trends,
+            conversation_summary=request.conversation_summary,
+            model=request.model,
+            temperature=request.temperature if request.HasField("temperature") else None,
+            max_tokens=request.max_tokens,
+            language=request.language,
+        )
+
 
 async def serve(port=50051):
     # Configure logging
@@ -18,7 +18,7 @@ _sym_db = _symbol_database.Default()
 
 
 DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(
-    b'\n\x0f\x61i-server.proto\x12\x02\x61i"`\n\x0f\x44iagnoseRequest\x12.\n\x0cpatient_info\x18\x01 \x01(\x0b\x32\x18.ai.PatientInfoForPrompt\x12\x1d\n\x08messages\x18\x02 \x03(\x0b\x32\x0b.ai.Message"#\n\x10\x44iagnoseResponse\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\t"a\n\x14PatientInfoForPrompt\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0b\n\x03\x61ge\x18\x02 \x01(\x05\x12\x0e\n\x06gender\x18\x03 \x01(\t\x12\x0e\n\x06weight\x18\x04 \x01(\x02\x12\x0e\n\x06height\x18\x05 \x01(\x02"(\n\x07Message\x12\x0c\n\x04role\x18\x01 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\t2F\n\tAiService\x12\x39\n\x08\x44iagnose\x12\x13.ai.DiagnoseRequest\x1a\x14.ai.DiagnoseResponse"\x00\x30\x01\x42\x1dZ\x1bunb.br/web-server/src/protob\x06proto3'
+    b'\n\x0f\x61i-server.proto\x12\x02\x61i"\xbc\x02\n\x0f\x44iagnoseRequest\x12.\n\x0cpatient_info\x18\x01 \x01(\x0b\x32\x18.ai.PatientInfoForPrompt\x12\x1d\n\x08messages\x18\x02 \x03(\x0b\x32\x0b.ai.Message\x12.\n\rrecent_vitals\x18\x03 \x03(\x0b\x32\x17.ai.VitalSignsForPrompt\x12-\n\x0cvital_trends\x18\x04 \x03(\x0b\x32\x17.ai.VitalTrendForPrompt\x12\x1c\n\x14\x63onversation_summary\x18\x05 \x01(\t\x12\r\n\x05model\x18\x06 \x01(\t\x12\x18\n\x0btemperature\x18\x07 \x01(\x02H\x00\x88\x01\x01\x12\x12\n\nmax_tokens\x18\x08 \x01(\x05\x12\x10\n\x08language\x18\t \x01(\tB\x0e\n\x0c_temperature"\xa5\x01\n\x10\x44iagnoseResponse\x12\x11\n\x07\x63ontent\x18\x01 \x01(\tH\x00\x12*\n\x07summary\x18\x02 \x01(\x0b\x32\x17.ai.StructuredDiagnosisH\x00\x12(\n\x08metadata\x18\x03 \x01(\x0b\x32\x14.ai.DiagnoseMetadataH\x00\x12\x1f\n\x05usage\x18\x04 \x01(\x0b\x32\x0e.ai.TokenUsageH\x00\x42\x07\n\x05\x65vent"!\n\x10\x44iagnoseMetadata\x12\r\n\x05model\x18\x01 \x01(\t">\n\nTokenUsage\x12\x15\n\rprompt_tokens\x18\x01 \x01(\x05\x12\x19\n\x11\x63ompletion_tokens\x18\x02 \x01(\x05"\xae\x01\n\x13StructuredDiagnosis\x12\x30\n\rdifferentials\x18\x01 \x03(\x0b\x32\x19.ai.DifferentialDiagnosis\x12\x14\n\x0ctriage_level\x18\x02 \x01(\t\x12\x13\n\x0bspecialties\x18\x03 \x03(\t\x12\x11\n\tred_flags\x18\x04 \x03(\t\x12\x13\n\x0b\x64isclaimers\x18\x05 \x03(\t\x12\x12\n\nnext_steps\x18\x06 \x03(\t"Q\n\x15\x44ifferentialDiagnosis\x12\x11\n\tcondition\x18\x01 \x01(\t\x12\x12\n\nlikelihood\x18\x02 \x01(\x02\x12\x11\n\trationale\x18\x03 \x01(\t"\xc1\x02\n\x14PatientInfoForPrompt\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0b\n\x03\x61ge\x18\x02 \x01(\x05\x12\x0e\n\x06gender\x18\x03 \x01(\t\x12\x0e\n\x06weight\x18\x04 \x01(\x02\x12\x0e\n\x06height\x18\x05 \x01(\x02\x12\'\n\tallergies\x18\x06 \x03(\x0b\x32\x14.ai.AllergyForPrompt\x12,\n\x0bmedications\x18\x07 \x03(\x0b\x32\x17.ai.MedicationForPrompt\x12*\n\nconditions\x18\x08 \x03(\x0b\x32\x16.ai.ConditionForPrompt\x12\'\n\tsurgeries\x18\t \x03(\x0b\x32\x14.ai.SurgeryForPrompt\x12\x32\n\x0e\x66\x61mily_history\x18\n \x03(\x0b\x32\x1a.ai.FamilyHistoryForPrompt"I\n\x10\x41llergyForPrompt\x12\x11\n\tsubstance\x18\x01 \x01(\t\x12\x10\n\x08reaction\x18\x02 \x01(\t\x12\x10\n\x08severity\x18\x03 \x01(\t"F\n\x13MedicationForPrompt\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06\x64osage\x18\x02 \x01(\t\x12\x11\n\tfrequency\x18\x03 \x01(\t"I\n\x12\x43onditionForPrompt\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x16\n\x0e\x64iagnosed_year\x18\x02 \x01(\x05\x12\r\n\x05notes\x18\x03 \x01(\t"B\n\x10SurgeryForPrompt\x12\x11\n\tprocedure\x18\x01 \x01(\t\x12\x0c\n\x04year\x18\x02 \x01(\x05\x12\r\n\x05notes\x18\x03 \x01(\t"=\n\x16\x46\x61milyHistoryForPrompt\x12\x10\n\x08relative\x18\x01 \x01(\t\x12\x11\n\tcondition\x18\x02 \x01(\t"(\n\x07Message\x12\x0c\n\x04role\x18\x01 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\t"\xa4\x02\n\x13VitalSignsForPrompt\x12\x13\n\x0brecorded_at\x18\x01 \x01(\x03\x12\x15\n\x08systolic\x18\x02 \x01(\x05H\x00\x88\x01\x01\x12\x16\n\tdiastolic\x18\x03 \x01(\x05H\x01\x88\x01\x01\x12\x17\n\nheart_rate\x18\x04 \x01(\x05H\x02\x88\x01\x01\x12\x18\n\x0btemperature\x18\x05 \x01(\x02H\x03\x88\x01\x01\x12\x11\n\x04spo2\x18\x06 \x01(\x05H\x04\x88\x01\x01\x12\x14\n\x07glucose\x18\x07 \x01(\x02H\x05\x88\x01\x01\x12\x13\n\x06weight\x18\x08 \x01(\x02H\x06\x88\x01\x01\x42\x0b\n\t_systolicB\x0c\n\n_diastolicB\r\n\x0b_heart_rateB\x0e\n\x0c_temperatureB\x07\n\x05_spo2B\n\n\x08_glucoseB\t\n\x07_weight"\x8d\x01\n\x13VitalTrendForPrompt\x12\x0e\n\x06metric\x18\x01 \x01(\t\x12\x0c\n\x04unit\x18\x02 \x01(\t\x12\r\n\x05\x63ount\x18\x03 \x01(\x05\x12\x0e\n\x06latest\x18\x04 \x01(\x02\x12\x0f\n\x07\x61verage\x18\x05 \x01(\x02\x12\x0b\n\x03min\x18\x06 \x01(\x02\x12\x0b\n\x03max\x18\x07 \x01(\x02\x12\x0e\n\x06\x63hange\x18\x08 \x01(\x02"x\n\x12\x43hatSessionRequest\x12\x0f\n\x07turn_id\x18\x01 \x01(\t\x12#\n\x04turn\x18\x02 \x01(\x0b\x32\x13.ai.DiagnoseRequestH\x00\x12"\n\x04stop\x18\x03 \x01(\x0b\x32\x12.ai.StopGenerationH\x00\x42\x08\n\x06\x61\x63tion"\x10\n\x0eStopGeneration"\xd5\x01\n\x13\x43hatSessionResponse\x12\x0f\n\x07turn_id\x18\x01 \x01(\t\x12\x11\n\x07\x63ontent\x18\x02 \x01(\tH\x00\x12*\n\x07summary\x18\x03 \x01(\x0b\x32\x17.ai.StructuredDiagnosisH\x00\x12\x1a\n\x03\x65nd\x18\x04 \x01(\x0b\x32\x0b.ai.TurnEndH\x00\x12(\n\x08metadata\x18\x05 \x01(\x0b\x32\x14.ai.DiagnoseMetadataH\x00\x12\x1f\n\x05usage\x18\x06 \x01(\x0b\x32\x0e.ai.TokenUsageH\x00\x42\x07\n\x05\x65vent"\x1a\n\x07TurnEnd\x12\x0f\n\x07stopped\x18\x01 \x01(\x08"_\n\x10SummarizeRequest\x12\x18\n\x10previous_summary\x18\x01 \x01(\t\x12\x1d\n\x08messages\x18\x02 \x03(\x0b\x32\x0b.ai.Message\x12\x12\n\nmax_tokens\x18\x03 \x01(\x05"R\n\x11SummarizeResponse\x12\x0f\n\x07summary\x18\x01 \x01(\t\x12\x1d\n\x05usage\x18\x02 \x01(\x0b\x32\x0e.ai.TokenUsage\x12\r\n\x05model\x18\x03 \x01(\t"\x1f\n\x0fModerateRequest\x12\x0c\n\x04text\x18\x01 \x01(\t"7\n\x10ModerateResponse\x12\x0f\n\x07outcome\x18\x01 \x01(\t\x12\x12\n\ncategories\x18\x02 \x03(\t2\xfa\x01\n\tAiService\x12\x39\n\x08\x44iagnose\x12\x13.ai.DiagnoseRequest\x1a\x14.ai.DiagnoseResponse"\x00\x30\x01\x12=\n\x04\x43hat\x12\x16.ai.ChatSessionRequest\x1a\x17.ai.ChatSessionResponse"\x00(\x01\x30\x01\x12:\n\tSummarize\x12\x14.ai.SummarizeRequest\x1a\x15.ai.SummarizeResponse"\x00\x12\x37\n\x08Moderate\x12\x13.ai.ModerateRequest\x1a\x14.ai.ModerateResponse"\x00\x42\x1dZ\x1bunb.br/web-server/src/protob\x06proto3'
 )
 
 _globals = globals()
@@ -27,14 +27,52 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, "ai_server_pb2", _globals)
 if not _descriptor._USE_C_DESCRIPTORS:
     _globals["DESCRIPTOR"]._loaded_options = None
     _globals["DESCRIPTOR"]._serialized_options = b"Z\033unb.br/web-server/src/proto"
-    _globals["_DIAGNOSEREQUEST"]._serialized_start = 23
-    _globals["_DIAGNOSEREQUEST"]._serialized_end = 119
-    _globals["_DIAGNOSERESPONSE"]._serialized_start = 121
-    _globals["_DIAGNOSERESPONSE"]._serialized_end = 156
-    _globals["_PATIENTINFOFORPROMPT"]._serialized_start = 158
-    _globals["_PATIENTINFOFORPROMPT"]._serialized_end = 255
-    _globals["_MESSAGE"]._serialized_start = 257
-    _globals["_MESSAGE"]._serialized_end = 297
-    _globals["_AISERVICE"]._serialized_start = 299
-    _globals["_AISERVICE"]._serialized_end = 369
+    _globals["_DIAGNOSEREQUEST"]._serialized_start = 24
+    _globals["_DIAGNOSEREQUEST"]._serialized_end = 340
+    _globals["_DIAGNOSERESPONSE"]._serialized_start = 343
+    _globals["_DIAGNOSERESPONSE"]._serialized_end = 508
+    _globals["_DIAGNOSEMETADATA"]._serialized_start = 510
+    _globals["_DIAGNOSEMETADATA"]._serialized_end = 543
+    _globals["_TOKENUSAGE"]._serialized_start = 545
+    _globals["_TOKENUSAGE"]._serialized_end = 607
+    _globals["_STRUCTUREDDIAGNOSIS"]._serialized_start = 610
+    _globals["_STRUCTUREDDIAGNOSIS"]._serialized_end = 784
+    _globals["_DIFFERENTIALDIAGNOSIS"]._serialized_start = 786
+    _globals["_DIFFERENTIALDIAGNOSIS"]._serialized_end = 867
+    _globals["_PATIENTINFOFORPROMPT"]._serialized_start = 870
+    _globals["_PATIENTINFOFORPROMPT"]._serialized_end = 1191
+    _globals["_ALLERGYFORPROMPT"]._serialized_start = 1193
+    _globals["_ALLERGYFORPROMPT"]._serialized_end = 1266
+    _globals["_MEDICATIONFORPROMPT"]._serialized_start = 1268
+    _globals["_MEDICATIONFORPROMPT"]._serialized_end = 1338
+    _globals["_CONDITIONFORPROMPT"]._serialized_start = 1340
+    _globals["_CONDITIONFORPROMPT"]._serialized_end = 1413
+    _globals["_SURGERYFORPROMPT"]._serialized_start = 1415
+    _globals["_SURGERYFORPROMPT"]._serialized_end = 1481
+    _globals["_FAMILYHISTORYFORPROMPT"]._serialized_start = 1483
+    _globals["_FAMILYHISTORYFORPROMPT"]._serialized_end = 1544
+    _globals["_MESSAGE"]._serialized_start = 1546
+    _globals["_MESSAGE"]._serialized_end = 1586
+    _globals["_VITALSIGNSFORPROMPT"]._serialized_start = 1589
+    _globals["_VITALSIGNSFORPROMPT"]._serialized_end = 1881
+    _globals["_VITALTRENDFORPROMPT"]._serialized_start = 1884
+    _globals["_VITALTRENDFORPROMPT"]._serialized_end = 2025
+    _globals["_CHATSESSIONREQUEST"]._serialized_start = 2027
+    _globals["_CHATSESSIONREQUEST"]._serialized_end = 2147
+    _globals["_STOPGENERATION"]._serialized_start = 2149
+    _globals["_STOPGENERATION"]._serialized_end = 2165
+    _globals["_CHATSESSIONRESPONSE"]._serialized_start = 2168
+    _globals["_CHATSESSIONRESPONSE"]._serialized_end = 2381
+    _globals["_TURNEND"]._serialized_start = 2383
+    _globals["_TURNEND"]._serialized_end = 2409
+    _globals["_SUMMARIZEREQUEST"]._serialized_start = 2411
+    _globals["_SUMMARIZEREQUEST"]._serialized_end = 2506
+    _globals["_SUMMARIZERESPONSE"]._serialized_start = 2508
+    _globals["_SUMMARIZERESPONSE"]._serialized_end = 2590
+    _globals["_MODERATEREQUEST"]._serialized_start = 2592
+    _globals["_MODERATEREQUEST"]._serialized_end = 2623
+    _globals["_MODERATERESPONSE"]._serialized_start = 2625
+    _globals["_MODERATERESPONSE"]._serialized_end = 2680
+    _globals["_AISERVICE"]._serialized_start = 2683
+    _globals["_AISERVICE"]._serialized_end = 2933
 # @@protoc_insertion_point(module_scope)
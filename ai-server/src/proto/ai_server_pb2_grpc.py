@@ -41,6 +41,24 @@ class AiServiceStub(object):
             response_deserializer=ai__server__pb2.DiagnoseResponse.FromString,
             _registered_method=True,
         )
+        self.Chat = channel.stream_stream(
+            "/ai.AiService/Chat",
+            request_serializer=ai__server__pb2.ChatSessionRequest.SerializeToString,
+            response_deserializer=ai__server__pb2.ChatSessionResponse.FromString,
+            _registered_method=True,
+        )
+        self.Summarize = channel.unary_unary(
+            "/ai.AiService/Summarize",
+            request_serializer=ai__server__pb2.SummarizeRequest.SerializeToString,
+            response_deserializer=ai__server__pb2.SummarizeResponse.FromString,
+            _registered_method=True,
+        )
+        self.Moderate = channel.unary_unary(
+            "/ai.AiService/Moderate",
+            request_serializer=ai__server__pb2.ModerateRequest.SerializeToString,
+            response_deserializer=ai__server__pb2.ModerateResponse.FromString,
+            _registered_method=True,
+        )
 
 
 class AiServiceServicer(object):
@@ -52,6 +70,24 @@ class AiServiceServicer(object):
         context.set_details("Method not implemented!")
         raise NotImplementedError("Method not implemented!")
 
+    def Chat(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details("Method not implemented!")
+        raise NotImplementedError("Method not implemented!")
+
+    def Summarize(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details("Method not implemented!")
+        raise NotImplementedError("Method not implemented!")
+
+    def Moderate(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details("Method not implemented!")
+        raise NotImplementedError("Method not implemented!")
+
 
 def add_AiServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -60,6 +96,21 @@ def add_AiServiceServicer_to_server(servicer, server):
             request_deserializer=ai__server__pb2.DiagnoseRequest.FromString,
             response_serializer=ai__server__pb2.DiagnoseResponse.SerializeToString,
         ),
+        "Chat": grpc.stream_stream_rpc_method_handler(
+            servicer.Chat,
+            request_deserializer=ai__server__pb2.ChatSessionRequest.FromString,
+            response_serializer=ai__server__pb2.ChatSessionResponse.SerializeToString,
+        ),
+        "Summarize": grpc.unary_unary_rpc_method_handler(
+            servicer.Summarize,
+            request_deserializer=ai__server__pb2.SummarizeRequest.FromString,
+            response_serializer=ai__server__pb2.SummarizeResponse.SerializeToString,
+        ),
+        "Moderate": grpc.unary_unary_rpc_method_handler(
+            servicer.Moderate,
+            request_deserializer=ai__server__pb2.ModerateRequest.FromString,
+            response_serializer=ai__server__pb2.ModerateResponse.SerializeToString,
+        ),
     }
     generic_handler = grpc.method_handlers_generic_handler("ai.AiService", rpc_method_handlers)
     server.add_generic_rpc_handlers((generic_handler,))
@@ -99,3 +150,96 @@ class AiService(object):
             metadata,
             _registered_method=True,
         )
+
+
+    @staticmethod
+    def Chat(
+        request_iterator,
+        target,
+        options=(),
+        channel_credentials=None,
+        call_credentials=None,
+        insecure=False,
+        compression=None,
+        wait_for_ready=None,
+        timeout=None,
+        metadata=None,
+    ):
+        return grpc.experimental.stream_stream(
+            request_iterator,
+            target,
+            "/ai.AiService/Chat",
+            ai__server__pb2.ChatSessionRequest.SerializeToString,
+            ai__server__pb2.ChatSessionResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True,
+        )
+
+
+    @staticmethod
+    def Summarize(
+        request,
+        target,
+        options=(),
+        channel_credentials=None,
+        call_credentials=None,
+        insecure=False,
+        compression=None,
+        wait_for_ready=None,
+        timeout=None,
+        metadata=None,
+    ):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            "/ai.AiService/Summarize",
+            ai__server__pb2.SummarizeRequest.SerializeToString,
+            ai__server__pb2.SummarizeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True,
+        )
+
+
+    @staticmethod
+    def Moderate(
+        request,
+        target,
+        options=(),
+        channel_credentials=None,
+        call_credentials=None,
+        insecure=False,
+        compression=None,
+        wait_for_ready=None,
+        timeout=None,
+        metadata=None,
+    ):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            "/ai.AiService/Moderate",
+            ai__server__pb2.ModerateRequest.SerializeToString,
+            ai__server__pb2.ModerateResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True,
+        )
@@ -0,0 +1,6 @@
+-- AlterTable
+ALTER TABLE "User" ADD COLUMN "role" TEXT NOT NULL DEFAULT 'user';
+
+-- AlterTable
+ALTER TABLE "AuditEvent" ADD COLUMN "requestId" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "AuditEvent" ADD COLUMN "clientIp" TEXT NOT NULL DEFAULT '';
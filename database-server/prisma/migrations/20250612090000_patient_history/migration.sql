@@ -0,0 +1,6 @@
+-- AlterTable
+ALTER TABLE "Patient" ADD COLUMN "allergies" TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE "Patient" ADD COLUMN "conditions" TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE "Patient" ADD COLUMN "familyHistory" TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE "Patient" ADD COLUMN "medications" TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE "Patient" ADD COLUMN "surgeries" TEXT NOT NULL DEFAULT '[]';
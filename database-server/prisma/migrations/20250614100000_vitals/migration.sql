@@ -0,0 +1,17 @@
+-- CreateTable
+CREATE TABLE "Vitals" (
+    "id" INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    "userId" INTEGER NOT NULL,
+    "recordedAt" DATETIME NOT NULL,
+    "systolic" INTEGER,
+    "diastolic" INTEGER,
+    "heartRate" INTEGER,
+    "temperature" REAL,
+    "spo2" INTEGER,
+    "glucose" REAL,
+    "weight" REAL,
+    CONSTRAINT "Vitals_userId_fkey" FOREIGN KEY ("userId") REFERENCES "User" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateIndex
+CREATE INDEX "Vitals_userId_recordedAt_idx" ON "Vitals"("userId", "recordedAt");
@@ -0,0 +1,33 @@
+-- CreateTable
+CREATE TABLE "PatientProfile" (
+    "id" INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    "ownerId" INTEGER NOT NULL,
+    "relationship" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "age" INTEGER NOT NULL,
+    "gender" TEXT NOT NULL,
+    "weight" REAL NOT NULL,
+    "height" REAL NOT NULL,
+    "allergies" TEXT NOT NULL DEFAULT '[]',
+    "medications" TEXT NOT NULL DEFAULT '[]',
+    "conditions" TEXT NOT NULL DEFAULT '[]',
+    "surgeries" TEXT NOT NULL DEFAULT '[]',
+    "familyHistory" TEXT NOT NULL DEFAULT '[]',
+    "version" INTEGER NOT NULL DEFAULT 1,
+    CONSTRAINT "PatientProfile_ownerId_fkey" FOREIGN KEY ("ownerId") REFERENCES "User" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateIndex
+CREATE INDEX "PatientProfile_ownerId_idx" ON "PatientProfile"("ownerId");
+
+-- AlterTable
+ALTER TABLE "Conversation" ADD COLUMN "patientId" INTEGER NOT NULL DEFAULT 0;
+
+-- AlterTable
+ALTER TABLE "Vitals" ADD COLUMN "patientId" INTEGER NOT NULL DEFAULT 0;
+
+-- DropIndex
+DROP INDEX "Vitals_userId_recordedAt_idx";
+
+-- CreateIndex
+CREATE INDEX "Vitals_userId_patientId_recordedAt_idx" ON "Vitals"("userId", "patientId", "recordedAt");
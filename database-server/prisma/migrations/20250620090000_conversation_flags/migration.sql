@@ -0,0 +1,15 @@
+-- CreateTable
+CREATE TABLE "ConversationFlag" (
+    "id" INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    "userId" INTEGER NOT NULL,
+    "conversationId" TEXT NOT NULL,
+    "patientId" INTEGER NOT NULL DEFAULT 0,
+    "reason" TEXT NOT NULL,
+    "ruleIds" TEXT NOT NULL DEFAULT '[]',
+    "severity" TEXT NOT NULL,
+    "createdAt" DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "ConversationFlag_userId_fkey" FOREIGN KEY ("userId") REFERENCES "User" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateIndex
+CREATE INDEX "ConversationFlag_conversationId_idx" ON "ConversationFlag"("conversationId");
@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "ChatMessage" ADD COLUMN "truncated" BOOLEAN NOT NULL DEFAULT false;
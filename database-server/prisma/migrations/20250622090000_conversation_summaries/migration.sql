@@ -0,0 +1,10 @@
+-- CreateTable
+CREATE TABLE "ConversationSummary" (
+    "conversationId" TEXT NOT NULL PRIMARY KEY,
+    "userId" INTEGER NOT NULL,
+    "summary" TEXT NOT NULL,
+    "messageCount" INTEGER NOT NULL,
+    "messagesHash" TEXT NOT NULL,
+    "updatedAt" DATETIME NOT NULL,
+    CONSTRAINT "ConversationSummary_userId_fkey" FOREIGN KEY ("userId") REFERENCES "User" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,16 @@
+-- CreateTable
+CREATE TABLE "UsageRecord" (
+    "id" INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    "accountId" INTEGER NOT NULL,
+    "conversationId" TEXT NOT NULL,
+    "model" TEXT NOT NULL,
+    "promptTokens" INTEGER NOT NULL,
+    "completionTokens" INTEGER NOT NULL,
+    "time" DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- CreateIndex
+CREATE INDEX "UsageRecord_accountId_time_idx" ON "UsageRecord"("accountId", "time");
+
+-- CreateIndex
+CREATE INDEX "UsageRecord_time_idx" ON "UsageRecord"("time");
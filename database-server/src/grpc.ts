@@ -1,28 +1,311 @@
 import {
+  Metadata,
   sendUnaryData,
   ServerUnaryCall,
+  ServiceError,
+  status,
   UntypedHandleCall,
 } from "@grpc/grpc-js";
 import { hash, verify } from "argon2";
+import { timingSafeEqual } from "crypto";
 import { JwtPayload, verify as jwtVerify, sign } from "jsonwebtoken";
+import {
+  Patient,
+  PatientProfile as StoredProfile,
+  Prisma,
+  User,
+} from "./generated/prisma";
 import { createModuleLogger } from "./logger";
 import prisma from "./prisma";
 import { IDatabaseServiceServer } from "./proto/database-server_grpc_pb";
 import {
+  Account,
+  Allergy,
+  AuditEvent,
+  ChatMessage,
+  Condition,
+  Conversation,
+  ConversationSummary,
+  CreatePatientRequest,
+  CreatePatientResponse,
+  DeleteAccountRequest,
+  DeleteAccountResponse,
+  DeletePatientRequest,
+  DeletePatientResponse,
+  DiagnosisSummary,
+  ExportAccountRequest,
+  ExportAccountResponse,
+  FamilyHistoryEntry,
+  FlagConversationRequest,
+  FlagConversationResponse,
+  GetAccountRequest,
+  GetAccountResponse,
+  GetConversationSummaryRequest,
+  GetConversationSummaryResponse,
+  GetPatientByIdRequest,
+  GetPatientByIdResponse,
   GetPatientRequest,
   GetPatientResponse,
+  ListAuditEventsRequest,
+  ListAuditEventsResponse,
+  ListPatientsRequest,
+  ListPatientsResponse,
+  ListUsageRequest,
+  ListUsageResponse,
+  ListVitalsRequest,
+  ListVitalsResponse,
   LoginRequest,
   LoginResponse,
+  Medication,
   PatientInfo,
+  PatientProfile,
+  RecordAuditEventRequest,
+  RecordAuditEventResponse,
+  RecordUsageRequest,
+  RecordUsageResponse,
+  RecordVitalsRequest,
+  RecordVitalsResponse,
   RegisterRequest,
   RegisterResponse,
+  SaveConversationRequest,
+  SaveConversationResponse,
+  SaveConversationSummaryRequest,
+  SaveConversationSummaryResponse,
   SavePatientInfoRequest,
   SavePatientInfoResponse,
+  Surgery,
+  UpdatePatientRequest,
+  UpdatePatientResponse,
+  UsageTotal,
+  VitalSigns,
 } from "./proto/database-server_pb";
 
 // Create module logger
 const logger = createModuleLogger("grpc");
 
+// RpcError is a failure reported to the client with its gRPC status code
+class RpcError extends Error implements ServiceError {
+  details: string;
+  metadata = new Metadata();
+
+  constructor(public code: status, message: string) {
+    super(message);
+    this.details = message;
+  }
+}
+
+// Reports a failure to the client, hiding unexpected errors behind an internal one
+function fail<T>(method: string, error: unknown, callback: sendUnaryData<T>) {
+  if (error instanceof RpcError) {
+    logger.warn(`${method} failed: ${error.message}`);
+    callback(error, null);
+    return;
+  }
+  logger.error(`${method} error: ${(error as Error).message}`, { error });
+  callback(new RpcError(status.INTERNAL, "Internal server error"), null);
+}
+
+// Returns the user a token was issued to
+async function authenticate(token: string): Promise<User> {
+  let userId: string | undefined;
+  try {
+    userId = (jwtVerify(token, process.env.JWT_SECRET!) as JwtPayload).sub;
+  } catch {
+    throw new RpcError(status.UNAUTHENTICATED, "Invalid token");
+  }
+  if (userId === undefined) {
+    throw new RpcError(status.UNAUTHENTICATED, "Invalid token");
+  }
+
+  const user = await prisma.user.findUnique({
+    where: { id: parseInt(userId) },
+  });
+  if (!user) {
+    throw new RpcError(status.UNAUTHENTICATED, "User not found");
+  }
+  return user;
+}
+
+// Checks the token the web server authenticates its own calls with, such as the audit RPCs
+function authenticateService(token: string) {
+  const expected = Buffer.from(process.env.SERVICE_TOKEN ?? "");
+  const given = Buffer.from(token);
+  if (
+    expected.length === 0 ||
+    given.length !== expected.length ||
+    !timingSafeEqual(given, expected)
+  ) {
+    throw new RpcError(status.UNAUTHENTICATED, "Invalid service token");
+  }
+}
+
+// Records an audit event written by the database server itself and returns its ID
+async function recordAudit(
+  db: Prisma.TransactionClient,
+  user: User,
+  action: string,
+  detail: string
+): Promise<string> {
+  const event = await db.auditEvent.create({
+    data: {
+      actor: `user:${user.id}`,
+      action,
+      resource: "account",
+      outcome: "success",
+      detail,
+    },
+  });
+  return event.id;
+}
+
+function toAccount(user: User): Account {
+  const account = new Account();
+  account.setId(user.id);
+  account.setUsername(user.username);
+  account.setRole(user.role);
+  return account;
+}
+
+// Returns a managed patient profile, failing unless it belongs to the user
+async function ownedPatient(
+  user: User,
+  patientId: number
+): Promise<StoredProfile> {
+  const profile = await prisma.patientProfile.findUnique({
+    where: { id: patientId },
+  });
+  if (!profile) {
+    throw new RpcError(status.NOT_FOUND, "Patient not found");
+  }
+  if (profile.ownerId !== user.id) {
+    throw new RpcError(
+      status.PERMISSION_DENIED,
+      "Patient belongs to another account"
+    );
+  }
+  return profile;
+}
+
+// Checks that a patient profile other than the account's own belongs to the user
+async function checkPatient(user: User, patientId: number): Promise<void> {
+  if (patientId !== 0) {
+    await ownedPatient(user, patientId);
+  }
+}
+
+// Checks that a conversation, when it is already saved, belongs to the user
+async function checkConversation(
+  user: User,
+  conversationId: string
+): Promise<void> {
+  if (!conversationId) {
+    throw new RpcError(status.INVALID_ARGUMENT, "Conversation ID is required");
+  }
+  const conversation = await prisma.conversation.findUnique({
+    where: { id: conversationId },
+  });
+  if (conversation && conversation.userId !== user.id) {
+    throw new RpcError(
+      status.PERMISSION_DENIED,
+      "Conversation belongs to another account"
+    );
+  }
+}
+
+function toPatientProfile(profile: StoredProfile): PatientProfile {
+  const patientProfile = new PatientProfile();
+  patientProfile.setId(profile.id);
+  patientProfile.setOwnerId(profile.ownerId);
+  patientProfile.setRelationship(profile.relationship);
+  patientProfile.setPatientInfo(toPatientInfo(profile));
+  patientProfile.setVersion(profile.version);
+  return patientProfile;
+}
+
+// Columns of a stored patient profile
+type PatientColumns = Pick<
+  Patient,
+  | "name"
+  | "age"
+  | "gender"
+  | "weight"
+  | "height"
+  | "allergies"
+  | "medications"
+  | "conditions"
+  | "surgeries"
+  | "familyHistory"
+>;
+
+function patientColumns(patientInfo: PatientInfo): PatientColumns {
+  const toJson = (list: { toObject(): object }[]) =>
+    JSON.stringify(list.map((item) => item.toObject()));
+
+  return {
+    name: patientInfo.getName(),
+    age: patientInfo.getAge(),
+    gender: patientInfo.getGender(),
+    weight: patientInfo.getWeight(),
+    height: patientInfo.getHeight(),
+    allergies: toJson(patientInfo.getAllergiesList()),
+    medications: toJson(patientInfo.getMedicationsList()),
+    conditions: toJson(patientInfo.getConditionsList()),
+    surgeries: toJson(patientInfo.getSurgeriesList()),
+    familyHistory: toJson(patientInfo.getFamilyHistoryList()),
+  };
+}
+
+function toPatientInfo(patient: PatientColumns): PatientInfo {
+  const patientInfo = new PatientInfo();
+  patientInfo.setName(patient.name);
+  patientInfo.setAge(patient.age);
+  patientInfo.setGender(patient.gender);
+  patientInfo.setWeight(patient.weight);
+  patientInfo.setHeight(patient.height);
+  patientInfo.setAllergiesList(
+    (JSON.parse(patient.allergies) as Allergy.AsObject[]).map((allergy) =>
+      new Allergy()
+        .setSubstance(allergy.substance)
+        .setReaction(allergy.reaction)
+        .setSeverity(allergy.severity)
+    )
+  );
+  patientInfo.setMedicationsList(
+    (JSON.parse(patient.medications) as Medication.AsObject[]).map(
+      (medication) =>
+        new Medication()
+          .setName(medication.name)
+          .setDosage(medication.dosage)
+          .setFrequency(medication.frequency)
+    )
+  );
+  patientInfo.setConditionsList(
+    (JSON.parse(patient.conditions) as Condition.AsObject[]).map((condition) =>
+      new Condition()
+        .setName(condition.name)
+        .setDiagnosedYear(condition.diagnosedYear)
+        .setNotes(condition.notes)
+    )
+  );
+  patientInfo.setSurgeriesList(
+    (JSON.parse(patient.surgeries) as Surgery.AsObject[]).map((surgery) =>
+      new Surgery()
+        .setProcedure(surgery.procedure)
+        .setYear(surgery.year)
+        .setNotes(surgery.notes)
+    )
+  );
+  patientInfo.setFamilyHistoryList(
+    (JSON.parse(patient.familyHistory) as FamilyHistoryEntry.AsObject[]).map(
+      (entry) =>
+        new FamilyHistoryEntry()
+          .setRelative(entry.relative)
+          .setCondition(entry.condition)
+    )
+  );
+  return patientInfo;
+}
+
 // Implementation of the DatabaseService
 export default class DatabaseServiceImpl implements IDatabaseServiceServer {
   [method: string]: UntypedHandleCall;
@@ -132,132 +415,830 @@ export default class DatabaseServiceImpl implements IDatabaseServiceServer {
     callback: sendUnaryData<SavePatientInfoResponse>
   ): Promise<void> {
     try {
-      const token = call.request.getToken();
+      const user = await authenticate(call.request.getToken());
       const patientInfo = call.request.getPatientInfo();
 
       if (!patientInfo) {
-        logger.warn("SavePatientInfo failed: Patient info is required");
-        const error = new Error("Patient info is required");
-        callback(error, null);
-        return;
+        throw new RpcError(status.INVALID_ARGUMENT, "Patient info is required");
       }
 
       logger.debug(`Saving patient info: ${patientInfo.getName()}`);
 
-      const decoded = jwtVerify(token, process.env.JWT_SECRET!);
-      const userId = (decoded as JwtPayload).sub;
+      const columns = patientColumns(patientInfo);
+      const expectedVersion = call.request.getExpectedVersion();
+      let version: number;
 
-      if (userId === undefined) {
-        logger.warn("SavePatientInfo failed: Invalid token");
-        const error = new Error("Invalid token");
-        callback(error, null);
-        return;
+      if (expectedVersion !== 0) {
+        // Only a profile still at the expected version is replaced
+        const updated = await prisma.patient.updateMany({
+          where: { userId: user.id, version: expectedVersion },
+          data: { ...columns, version: { increment: 1 } },
+        });
+        if (updated.count === 0) {
+          throw new RpcError(
+            status.FAILED_PRECONDITION,
+            `Expected version ${expectedVersion}, the stored version differs`
+          );
+        }
+        version = expectedVersion + 1;
+      } else {
+        const patient = await prisma.patient.upsert({
+          where: { userId: user.id },
+          create: { ...columns, userId: user.id },
+          update: { ...columns, version: { increment: 1 } },
+        });
+        version = patient.version;
       }
 
-      const user = await prisma.user.findUnique({
-        where: { id: parseInt(userId) },
+      logger.info(
+        `Patient info saved successfully for user ID ${user.id} at version ${version}`
+      );
+
+      const response = new SavePatientInfoResponse();
+      response.setSuccess(true);
+      response.setVersion(version);
+
+      callback(null, response);
+    } catch (error) {
+      fail("SavePatientInfo", error, callback);
+    }
+  }
+
+  // GetPatient method implementation
+  async getPatient(
+    call: ServerUnaryCall<GetPatientRequest, GetPatientResponse>,
+    callback: sendUnaryData<GetPatientResponse>
+  ): Promise<void> {
+    try {
+      logger.debug("Processing getPatient request");
+
+      const user = await authenticate(call.request.getToken());
+
+      const patient = await prisma.patient.findUnique({
+        where: { userId: user.id },
       });
 
-      if (!user) {
-        logger.warn(`SavePatientInfo failed: User not found for ID ${userId}`);
-        const error = new Error("User not found");
-        callback(error, null);
-        return;
+      if (!patient) {
+        throw new RpcError(status.NOT_FOUND, "Patient not found");
+      }
+
+      const response = new GetPatientResponse();
+      response.setPatientInfo(toPatientInfo(patient));
+      response.setVersion(patient.version);
+
+      logger.info(`Patient info retrieved successfully for user ID ${user.id}`);
+
+      callback(null, response);
+    } catch (error) {
+      fail("GetPatient", error, callback);
+    }
+  }
+
+  // GetAccount method implementation
+  async getAccount(
+    call: ServerUnaryCall<GetAccountRequest, GetAccountResponse>,
+    callback: sendUnaryData<GetAccountResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+
+      const response = new GetAccountResponse();
+      response.setAccount(toAccount(user));
+
+      callback(null, response);
+    } catch (error) {
+      fail("GetAccount", error, callback);
+    }
+  }
+
+  // SaveConversation method implementation
+  async saveConversation(
+    call: ServerUnaryCall<SaveConversationRequest, SaveConversationResponse>,
+    callback: sendUnaryData<SaveConversationResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+      const conversationId = call.request.getConversationId();
+
+      if (!conversationId) {
+        throw new RpcError(
+          status.INVALID_ARGUMENT,
+          "Conversation ID is required"
+        );
       }
 
-      await prisma.patient.create({
+      const conversation = await prisma.conversation.findUnique({
+        where: { id: conversationId },
+      });
+
+      if (conversation && conversation.userId !== user.id) {
+        throw new RpcError(
+          status.PERMISSION_DENIED,
+          "Conversation belongs to another account"
+        );
+      }
+      if (!conversation) {
+        await checkPatient(user, call.request.getPatientId());
+      }
+
+      const messages = call.request.getMessagesList().map((message) => {
+        const summary = message.getSummary();
+        return {
+          role: message.getRole(),
+          content: message.getContent(),
+          createdAt: message.getCreatedAt()
+            ? new Date(message.getCreatedAt())
+            : new Date(),
+          summary: summary ? Buffer.from(summary.serializeBinary()) : null,
+          truncated: message.getTruncated(),
+        };
+      });
+
+      await prisma.conversation.upsert({
+        where: { id: conversationId },
+        create: {
+          id: conversationId,
+          userId: user.id,
+          patientId: call.request.getPatientId(),
+          messages: { create: messages },
+        },
+        update: {
+          messages: { create: messages },
+        },
+      });
+
+      logger.info(
+        `Saved ${messages.length} messages to conversation ${conversationId} for user ID ${user.id}`
+      );
+
+      const response = new SaveConversationResponse();
+      response.setSuccess(true);
+
+      callback(null, response);
+    } catch (error) {
+      fail("SaveConversation", error, callback);
+    }
+  }
+
+  // ExportAccount method implementation
+  async exportAccount(
+    call: ServerUnaryCall<ExportAccountRequest, ExportAccountResponse>,
+    callback: sendUnaryData<ExportAccountResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+
+      const patient = await prisma.patient.findUnique({
+        where: { userId: user.id },
+      });
+
+      const patients = await prisma.patientProfile.findMany({
+        where: { ownerId: user.id },
+        orderBy: { id: "asc" },
+      });
+
+      const conversations = await prisma.conversation.findMany({
+        where: { userId: user.id },
+        include: { messages: { orderBy: { id: "asc" } } },
+        orderBy: { createdAt: "asc" },
+      });
+
+      const auditId = await recordAudit(
+        prisma,
+        user,
+        "account.export",
+        `${conversations.length} conversations exported`
+      );
+
+      const response = new ExportAccountResponse();
+      response.setAccount(toAccount(user));
+      if (patient) {
+        response.setPatientInfo(toPatientInfo(patient));
+      }
+      response.setConversationsList(
+        conversations.map((stored) => {
+          const conversation = new Conversation();
+          conversation.setId(stored.id);
+          conversation.setCreatedAt(stored.createdAt.getTime());
+          conversation.setPatientId(stored.patientId);
+          conversation.setMessagesList(
+            stored.messages.map((storedMessage) => {
+              const message = new ChatMessage();
+              message.setRole(storedMessage.role);
+              message.setContent(storedMessage.content);
+              message.setCreatedAt(storedMessage.createdAt.getTime());
+              if (storedMessage.summary) {
+                message.setSummary(
+                  DiagnosisSummary.deserializeBinary(
+                    new Uint8Array(storedMessage.summary)
+                  )
+                );
+              }
+              message.setTruncated(storedMessage.truncated);
+              return message;
+            })
+          );
+          return conversation;
+        })
+      );
+      response.setAuditId(auditId);
+      response.setPatientsList(patients.map(toPatientProfile));
+
+      logger.info(`Account exported for user ID ${user.id}`);
+
+      callback(null, response);
+    } catch (error) {
+      fail("ExportAccount", error, callback);
+    }
+  }
+
+  // DeleteAccount method implementation
+  async deleteAccount(
+    call: ServerUnaryCall<DeleteAccountRequest, DeleteAccountResponse>,
+    callback: sendUnaryData<DeleteAccountResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+
+      const isPasswordValid = await verify(
+        user.password,
+        call.request.getPassword()
+      );
+
+      if (!isPasswordValid) {
+        throw new RpcError(status.UNAUTHENTICATED, "Invalid password");
+      }
+
+      // The audit record is written first and kept, the user's own rows cascade
+      const auditId = await prisma.$transaction(async (tx) => {
+        const id = await recordAudit(
+          tx,
+          user,
+          "account.delete",
+          "account erased"
+        );
+        await tx.user.delete({ where: { id: user.id } });
+        return id;
+      });
+
+      logger.info(`Account deleted for user ID ${user.id}`);
+
+      const response = new DeleteAccountResponse();
+      response.setSuccess(true);
+      response.setAuditId(auditId);
+
+      callback(null, response);
+    } catch (error) {
+      fail("DeleteAccount", error, callback);
+    }
+  }
+
+  // RecordAuditEvent method implementation
+  async recordAuditEvent(
+    call: ServerUnaryCall<RecordAuditEventRequest, RecordAuditEventResponse>,
+    callback: sendUnaryData<RecordAuditEventResponse>
+  ): Promise<void> {
+    try {
+      authenticateService(call.request.getServiceToken());
+      const event = call.request.getEvent();
+
+      if (!event) {
+        throw new RpcError(status.INVALID_ARGUMENT, "Event is required");
+      }
+
+      const stored = await prisma.auditEvent.create({
         data: {
-          name: patientInfo.getName(),
-          age: patientInfo.getAge(),
-          gender: patientInfo.getGender(),
-          weight: patientInfo.getWeight(),
-          height: patientInfo.getHeight(),
-          user: {
-            connect: {
-              id: parseInt(userId),
-            },
+          time: event.getTime() ? new Date(event.getTime()) : new Date(),
+          actor: event.getActor(),
+          action: event.getAction(),
+          resource: event.getResource(),
+          outcome: event.getOutcome(),
+          requestId: event.getRequestId(),
+          clientIp: event.getClientIp(),
+          detail: event.getDetail(),
+        },
+      });
+
+      logger.debug(`Recorded audit event ${stored.id}: ${stored.action}`);
+
+      const response = new RecordAuditEventResponse();
+      response.setId(stored.id);
+
+      callback(null, response);
+    } catch (error) {
+      fail("RecordAuditEvent", error, callback);
+    }
+  }
+
+  // ListAuditEvents method implementation
+  async listAuditEvents(
+    call: ServerUnaryCall<ListAuditEventsRequest, ListAuditEventsResponse>,
+    callback: sendUnaryData<ListAuditEventsResponse>
+  ): Promise<void> {
+    try {
+      const request = call.request;
+      authenticateService(request.getServiceToken());
+      const since = request.getSince();
+      const until = request.getUntil();
+      const limit = request.getLimit();
+
+      // Empty filters and zero bounds match every event
+      const events = await prisma.auditEvent.findMany({
+        where: {
+          actor: request.getActor() || undefined,
+          action: request.getAction() || undefined,
+          resource: request.getResource() || undefined,
+          time: {
+            gte: since ? new Date(since) : undefined,
+            lte: until ? new Date(until) : undefined,
           },
         },
+        orderBy: { time: "desc" },
+        take: limit > 0 ? limit : undefined,
       });
 
-      logger.info(`Patient info saved successfully for user ID ${userId}`);
+      const response = new ListAuditEventsResponse();
+      response.setEventsList(
+        events.map((stored) => {
+          const event = new AuditEvent();
+          event.setId(stored.id);
+          event.setTime(stored.time.getTime());
+          event.setActor(stored.actor);
+          event.setAction(stored.action);
+          event.setResource(stored.resource);
+          event.setOutcome(stored.outcome);
+          event.setRequestId(stored.requestId);
+          event.setClientIp(stored.clientIp);
+          event.setDetail(stored.detail);
+          return event;
+        })
+      );
 
-      const response = new SavePatientInfoResponse();
-      response.setSuccess(true);
+      callback(null, response);
+    } catch (error) {
+      fail("ListAuditEvents", error, callback);
+    }
+  }
+
+  // RecordVitals method implementation
+  async recordVitals(
+    call: ServerUnaryCall<RecordVitalsRequest, RecordVitalsResponse>,
+    callback: sendUnaryData<RecordVitalsResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+      const vitals = call.request.getVitals();
+      const patientId = call.request.getPatientId();
+
+      if (!vitals) {
+        throw new RpcError(status.INVALID_ARGUMENT, "Vitals are required");
+      }
+      await checkPatient(user, patientId);
+
+      const stored = await prisma.vitals.create({
+        data: {
+          userId: user.id,
+          patientId,
+          recordedAt: vitals.getRecordedAt()
+            ? new Date(vitals.getRecordedAt())
+            : new Date(),
+          systolic: vitals.getSystolic(),
+          diastolic: vitals.getDiastolic(),
+          heartRate: vitals.getHeartRate(),
+          temperature: vitals.getTemperature(),
+          spo2: vitals.getSpo2(),
+          glucose: vitals.getGlucose(),
+          weight: vitals.getWeight(),
+        },
+      });
+
+      logger.info(`Vitals recorded for user ID ${user.id}`);
+
+      const response = new RecordVitalsResponse();
+      response.setId(stored.id.toString());
 
       callback(null, response);
     } catch (error) {
-      logger.error(`SavePatientInfo error: ${(error as Error).message}`, {
-        error,
+      fail("RecordVitals", error, callback);
+    }
+  }
+
+  // ListVitals method implementation
+  async listVitals(
+    call: ServerUnaryCall<ListVitalsRequest, ListVitalsResponse>,
+    callback: sendUnaryData<ListVitalsResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+      const since = call.request.getSince();
+      const until = call.request.getUntil();
+      const limit = call.request.getLimit();
+
+      await checkPatient(user, call.request.getPatientId());
+
+      const stored = await prisma.vitals.findMany({
+        where: {
+          userId: user.id,
+          patientId: call.request.getPatientId(),
+          recordedAt: {
+            gte: since ? new Date(since) : undefined,
+            lte: until ? new Date(until) : undefined,
+          },
+        },
+        orderBy: [{ recordedAt: "desc" }, { id: "desc" }],
+        take: limit > 0 ? limit : undefined,
       });
-      callback(new Error("Internal server error"), null);
+
+      const response = new ListVitalsResponse();
+      response.setVitalsList(
+        stored.map((reading) => {
+          const vitals = new VitalSigns();
+          vitals.setId(reading.id.toString());
+          vitals.setRecordedAt(reading.recordedAt.getTime());
+          if (reading.systolic !== null) vitals.setSystolic(reading.systolic);
+          if (reading.diastolic !== null) vitals.setDiastolic(reading.diastolic);
+          if (reading.heartRate !== null) vitals.setHeartRate(reading.heartRate);
+          if (reading.temperature !== null)
+            vitals.setTemperature(reading.temperature);
+          if (reading.spo2 !== null) vitals.setSpo2(reading.spo2);
+          if (reading.glucose !== null) vitals.setGlucose(reading.glucose);
+          if (reading.weight !== null) vitals.setWeight(reading.weight);
+          return vitals;
+        })
+      );
+
+      callback(null, response);
+    } catch (error) {
+      fail("ListVitals", error, callback);
     }
   }
 
-  // GetPatient method implementation
-  async getPatient(
-    call: ServerUnaryCall<GetPatientRequest, GetPatientResponse>,
-    callback: sendUnaryData<GetPatientResponse>
+  // ListPatients method implementation
+  async listPatients(
+    call: ServerUnaryCall<ListPatientsRequest, ListPatientsResponse>,
+    callback: sendUnaryData<ListPatientsResponse>
   ): Promise<void> {
     try {
-      const token = call.request.getToken();
+      const user = await authenticate(call.request.getToken());
 
-      if (!token) {
-        logger.warn("GetPatient failed: Token is required");
-        const error = new Error("Token is required");
-        callback(error, null);
-        return;
+      const profiles = await prisma.patientProfile.findMany({
+        where: { ownerId: user.id },
+        orderBy: { id: "asc" },
+      });
+
+      const response = new ListPatientsResponse();
+      response.setPatientsList(profiles.map(toPatientProfile));
+
+      callback(null, response);
+    } catch (error) {
+      fail("ListPatients", error, callback);
+    }
+  }
+
+  // CreatePatient method implementation
+  async createPatient(
+    call: ServerUnaryCall<CreatePatientRequest, CreatePatientResponse>,
+    callback: sendUnaryData<CreatePatientResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+      const patientInfo = call.request.getPatientInfo();
+
+      if (!patientInfo) {
+        throw new RpcError(status.INVALID_ARGUMENT, "Patient info is required");
       }
 
-      logger.debug("Processing getPatient request");
+      const profile = await prisma.patientProfile.create({
+        data: {
+          ...patientColumns(patientInfo),
+          ownerId: user.id,
+          relationship: call.request.getRelationship(),
+        },
+      });
 
-      const decoded = jwtVerify(token, process.env.JWT_SECRET!);
-      const userId = (decoded as JwtPayload).sub;
+      logger.info(`Patient ${profile.id} created for user ID ${user.id}`);
 
-      if (userId === undefined) {
-        logger.warn("GetPatient failed: Invalid token");
-        const error = new Error("Invalid token");
-        callback(error, null);
-        return;
+      const response = new CreatePatientResponse();
+      response.setPatient(toPatientProfile(profile));
+
+      callback(null, response);
+    } catch (error) {
+      fail("CreatePatient", error, callback);
+    }
+  }
+
+  // GetPatientById method implementation
+  async getPatientById(
+    call: ServerUnaryCall<GetPatientByIdRequest, GetPatientByIdResponse>,
+    callback: sendUnaryData<GetPatientByIdResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+      const profile = await ownedPatient(user, call.request.getPatientId());
+
+      const response = new GetPatientByIdResponse();
+      response.setPatient(toPatientProfile(profile));
+
+      callback(null, response);
+    } catch (error) {
+      fail("GetPatientById", error, callback);
+    }
+  }
+
+  // UpdatePatient method implementation
+  async updatePatient(
+    call: ServerUnaryCall<UpdatePatientRequest, UpdatePatientResponse>,
+    callback: sendUnaryData<UpdatePatientResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+      const patientId = call.request.getPatientId();
+      const patientInfo = call.request.getPatientInfo();
+
+      if (!patientInfo) {
+        throw new RpcError(status.INVALID_ARGUMENT, "Patient info is required");
       }
 
-      const user = await prisma.user.findUnique({
-        where: { id: parseInt(userId) },
+      const profile = await ownedPatient(user, patientId);
+      const expectedVersion = call.request.getExpectedVersion();
+
+      // Only a profile still at the version read is replaced
+      const updated = await prisma.patientProfile.updateMany({
+        where: {
+          id: patientId,
+          version: expectedVersion !== 0 ? expectedVersion : profile.version,
+        },
+        data: {
+          ...patientColumns(patientInfo),
+          relationship: call.request.getRelationship(),
+          version: { increment: 1 },
+        },
       });
 
-      if (!user) {
-        logger.warn(`GetPatient failed: User not found for ID ${userId}`);
-        const error = new Error("User not found");
-        callback(error, null);
-        return;
+      if (updated.count === 0) {
+        throw new RpcError(
+          status.FAILED_PRECONDITION,
+          `Expected version ${expectedVersion || profile.version}, the stored version differs`
+        );
+      }
+
+      logger.info(`Patient ${patientId} updated for user ID ${user.id}`);
+
+      const response = new UpdatePatientResponse();
+      response.setPatient(
+        toPatientProfile(await ownedPatient(user, patientId))
+      );
+
+      callback(null, response);
+    } catch (error) {
+      fail("UpdatePatient", error, callback);
+    }
+  }
+
+  // DeletePatient method implementation
+  async deletePatient(
+    call: ServerUnaryCall<DeletePatientRequest, DeletePatientResponse>,
+    callback: sendUnaryData<DeletePatientResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+      const patientId = call.request.getPatientId();
+
+      await ownedPatient(user, patientId);
+
+      // The profile's vitals go with it, its conversations stay in the account
+      await prisma.$transaction([
+        prisma.vitals.deleteMany({ where: { userId: user.id, patientId } }),
+        prisma.patientProfile.delete({ where: { id: patientId } }),
+      ]);
+
+      logger.info(`Patient ${patientId} deleted for user ID ${user.id}`);
+
+      const response = new DeletePatientResponse();
+      response.setSuccess(true);
+
+      callback(null, response);
+    } catch (error) {
+      fail("DeletePatient", error, callback);
+    }
+  }
+
+  // FlagConversation method implementation
+  async flagConversation(
+    call: ServerUnaryCall<FlagConversationRequest, FlagConversationResponse>,
+    callback: sendUnaryData<FlagConversationResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+      const conversationId = call.request.getConversationId();
+      const severity = call.request.getSeverity();
+
+      if (severity !== "emergency" && severity !== "urgent") {
+        throw new RpcError(
+          status.INVALID_ARGUMENT,
+          "Severity must be emergency or urgent"
+        );
       }
 
-      const patient = await prisma.patient.findFirst({
-        where: { userId: parseInt(userId) },
+      // The conversation may not be saved yet, but when it is it must be the user's
+      await checkConversation(user, conversationId);
+      await checkPatient(user, call.request.getPatientId());
+
+      await prisma.conversationFlag.create({
+        data: {
+          userId: user.id,
+          conversationId,
+          patientId: call.request.getPatientId(),
+          reason: call.request.getReason(),
+          ruleIds: JSON.stringify(call.request.getRuleIdsList()),
+          severity,
+        },
       });
 
-      if (!patient) {
-        const error = new Error("Patient not found");
-        callback(error, null);
-        return;
+      logger.warn(
+        `Conversation ${conversationId} of user ID ${user.id} flagged for review: ${severity} (${call.request.getRuleIdsList().join(", ")})`
+      );
+
+      const response = new FlagConversationResponse();
+      response.setSuccess(true);
+
+      callback(null, response);
+    } catch (error) {
+      fail("FlagConversation", error, callback);
+    }
+  }
+
+  // GetConversationSummary method implementation
+  async getConversationSummary(
+    call: ServerUnaryCall<
+      GetConversationSummaryRequest,
+      GetConversationSummaryResponse
+    >,
+    callback: sendUnaryData<GetConversationSummaryResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+      const conversationId = call.request.getConversationId();
+
+      await checkConversation(user, conversationId);
+
+      const stored = await prisma.conversationSummary.findUnique({
+        where: { conversationId },
+      });
+
+      // A summary of another account's conversation is left unset, like a missing one
+      const response = new GetConversationSummaryResponse();
+      if (stored && stored.userId === user.id) {
+        const summary = new ConversationSummary();
+        summary.setSummary(stored.summary);
+        summary.setMessageCount(stored.messageCount);
+        summary.setMessagesHash(stored.messagesHash);
+        summary.setUpdatedAt(stored.updatedAt.getTime());
+        response.setSummary(summary);
       }
 
-      const patientInfo = new PatientInfo();
-      patientInfo.setName(patient.name);
-      patientInfo.setAge(patient.age);
-      patientInfo.setGender(patient.gender);
-      patientInfo.setWeight(patient.weight);
-      patientInfo.setHeight(patient.height);
+      callback(null, response);
+    } catch (error) {
+      fail("GetConversationSummary", error, callback);
+    }
+  }
 
-      const response = new GetPatientResponse();
-      response.setPatientInfo(patientInfo);
+  // SaveConversationSummary method implementation
+  async saveConversationSummary(
+    call: ServerUnaryCall<
+      SaveConversationSummaryRequest,
+      SaveConversationSummaryResponse
+    >,
+    callback: sendUnaryData<SaveConversationSummaryResponse>
+  ): Promise<void> {
+    try {
+      const user = await authenticate(call.request.getToken());
+      const conversationId = call.request.getConversationId();
+      const summary = call.request.getSummary();
+
+      if (!summary) {
+        throw new RpcError(status.INVALID_ARGUMENT, "Summary is required");
+      }
+      await checkConversation(user, conversationId);
 
-      logger.info(`Patient info retrieved successfully for user ID ${userId}`);
+      const existing = await prisma.conversationSummary.findUnique({
+        where: { conversationId },
+      });
+      if (existing && existing.userId !== user.id) {
+        throw new RpcError(
+          status.PERMISSION_DENIED,
+          "Conversation belongs to another account"
+        );
+      }
+
+      const data = {
+        summary: summary.getSummary(),
+        messageCount: summary.getMessageCount(),
+        messagesHash: summary.getMessagesHash(),
+        updatedAt: summary.getUpdatedAt()
+          ? new Date(summary.getUpdatedAt())
+          : new Date(),
+      };
+      await prisma.conversationSummary.upsert({
+        where: { conversationId },
+        create: { conversationId, userId: user.id, ...data },
+        update: data,
+      });
+
+      logger.info(
+        `Summary of conversation ${conversationId} saved for user ID ${user.id}`
+      );
+
+      const response = new SaveConversationSummaryResponse();
+      response.setSuccess(true);
 
       callback(null, response);
     } catch (error) {
-      logger.error(`GetPatient error: ${(error as Error).message}`, { error });
-      callback(new Error("Internal server error"), null);
+      fail("SaveConversationSummary", error, callback);
+    }
+  }
+
+  // RecordUsage method implementation
+  async recordUsage(
+    call: ServerUnaryCall<RecordUsageRequest, RecordUsageResponse>,
+    callback: sendUnaryData<RecordUsageResponse>
+  ): Promise<void> {
+    try {
+      authenticateService(call.request.getServiceToken());
+      const record = call.request.getRecord();
+
+      if (!record || !record.getAccountId()) {
+        throw new RpcError(
+          status.INVALID_ARGUMENT,
+          "Usage record with an account ID is required"
+        );
+      }
+
+      await prisma.usageRecord.create({
+        data: {
+          accountId: record.getAccountId(),
+          conversationId: record.getConversationId(),
+          model: record.getModel(),
+          promptTokens: record.getPromptTokens(),
+          completionTokens: record.getCompletionTokens(),
+          time: record.getTime() ? new Date(record.getTime()) : new Date(),
+        },
+      });
+
+      logger.debug(
+        `Recorded usage of user ID ${record.getAccountId()} with model ${record.getModel()}`
+      );
+
+      const response = new RecordUsageResponse();
+      response.setSuccess(true);
+
+      callback(null, response);
+    } catch (error) {
+      fail("RecordUsage", error, callback);
+    }
+  }
+
+  // ListUsage method implementation
+  async listUsage(
+    call: ServerUnaryCall<ListUsageRequest, ListUsageResponse>,
+    callback: sendUnaryData<ListUsageResponse>
+  ): Promise<void> {
+    try {
+      authenticateService(call.request.getServiceToken());
+      const accountId = call.request.getAccountId();
+      const since = call.request.getSince();
+      const until = call.request.getUntil();
+
+      const groups = await prisma.usageRecord.groupBy({
+        by: ["accountId", "conversationId", "model"],
+        where: {
+          accountId: accountId || undefined,
+          time: {
+            gte: since ? new Date(since) : undefined,
+            lt: until ? new Date(until) : undefined,
+          },
+        },
+        _sum: { promptTokens: true, completionTokens: true },
+        _count: { _all: true },
+      });
+
+      const response = new ListUsageResponse();
+      response.setTotalsList(
+        groups.map((group) => {
+          const total = new UsageTotal();
+          total.setAccountId(group.accountId);
+          total.setConversationId(group.conversationId);
+          total.setModel(group.model);
+          total.setPromptTokens(group._sum.promptTokens ?? 0);
+          total.setCompletionTokens(group._sum.completionTokens ?? 0);
+          total.setRequests(group._count._all);
+          return total;
+        })
+      );
+
+      callback(null, response);
+    } catch (error) {
+      fail("ListUsage", error, callback);
     }
   }
 }
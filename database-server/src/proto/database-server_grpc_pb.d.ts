@@ -12,6 +12,24 @@ interface IDatabaseServiceService extends grpc.ServiceDefinition<grpc.UntypedSer
     register: IDatabaseServiceService_IRegister;
     savePatientInfo: IDatabaseServiceService_ISavePatientInfo;
     getPatient: IDatabaseServiceService_IGetPatient;
+    saveConversation: IDatabaseServiceService_ISaveConversation;
+    exportAccount: IDatabaseServiceService_IExportAccount;
+    deleteAccount: IDatabaseServiceService_IDeleteAccount;
+    getAccount: IDatabaseServiceService_IGetAccount;
+    recordAuditEvent: IDatabaseServiceService_IRecordAuditEvent;
+    listAuditEvents: IDatabaseServiceService_IListAuditEvents;
+    recordVitals: IDatabaseServiceService_IRecordVitals;
+    listVitals: IDatabaseServiceService_IListVitals;
+    listPatients: IDatabaseServiceService_IListPatients;
+    createPatient: IDatabaseServiceService_ICreatePatient;
+    getPatientById: IDatabaseServiceService_IGetPatientById;
+    updatePatient: IDatabaseServiceService_IUpdatePatient;
+    deletePatient: IDatabaseServiceService_IDeletePatient;
+    flagConversation: IDatabaseServiceService_IFlagConversation;
+    getConversationSummary: IDatabaseServiceService_IGetConversationSummary;
+    saveConversationSummary: IDatabaseServiceService_ISaveConversationSummary;
+    recordUsage: IDatabaseServiceService_IRecordUsage;
+    listUsage: IDatabaseServiceService_IListUsage;
 }
 
 interface IDatabaseServiceService_ILogin extends grpc.MethodDefinition<database_server_pb.LoginRequest, database_server_pb.LoginResponse> {
@@ -50,6 +68,168 @@ interface IDatabaseServiceService_IGetPatient extends grpc.MethodDefinition<data
     responseSerialize: grpc.serialize<database_server_pb.GetPatientResponse>;
     responseDeserialize: grpc.deserialize<database_server_pb.GetPatientResponse>;
 }
+interface IDatabaseServiceService_ISaveConversation extends grpc.MethodDefinition<database_server_pb.SaveConversationRequest, database_server_pb.SaveConversationResponse> {
+    path: "/database.DatabaseService/SaveConversation";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.SaveConversationRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.SaveConversationRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.SaveConversationResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.SaveConversationResponse>;
+}
+interface IDatabaseServiceService_IExportAccount extends grpc.MethodDefinition<database_server_pb.ExportAccountRequest, database_server_pb.ExportAccountResponse> {
+    path: "/database.DatabaseService/ExportAccount";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.ExportAccountRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.ExportAccountRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.ExportAccountResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.ExportAccountResponse>;
+}
+interface IDatabaseServiceService_IDeleteAccount extends grpc.MethodDefinition<database_server_pb.DeleteAccountRequest, database_server_pb.DeleteAccountResponse> {
+    path: "/database.DatabaseService/DeleteAccount";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.DeleteAccountRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.DeleteAccountRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.DeleteAccountResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.DeleteAccountResponse>;
+}
+interface IDatabaseServiceService_IGetAccount extends grpc.MethodDefinition<database_server_pb.GetAccountRequest, database_server_pb.GetAccountResponse> {
+    path: "/database.DatabaseService/GetAccount";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.GetAccountRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.GetAccountRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.GetAccountResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.GetAccountResponse>;
+}
+interface IDatabaseServiceService_IRecordAuditEvent extends grpc.MethodDefinition<database_server_pb.RecordAuditEventRequest, database_server_pb.RecordAuditEventResponse> {
+    path: "/database.DatabaseService/RecordAuditEvent";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.RecordAuditEventRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.RecordAuditEventRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.RecordAuditEventResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.RecordAuditEventResponse>;
+}
+interface IDatabaseServiceService_IListAuditEvents extends grpc.MethodDefinition<database_server_pb.ListAuditEventsRequest, database_server_pb.ListAuditEventsResponse> {
+    path: "/database.DatabaseService/ListAuditEvents";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.ListAuditEventsRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.ListAuditEventsRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.ListAuditEventsResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.ListAuditEventsResponse>;
+}
+interface IDatabaseServiceService_IRecordVitals extends grpc.MethodDefinition<database_server_pb.RecordVitalsRequest, database_server_pb.RecordVitalsResponse> {
+    path: "/database.DatabaseService/RecordVitals";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.RecordVitalsRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.RecordVitalsRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.RecordVitalsResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.RecordVitalsResponse>;
+}
+interface IDatabaseServiceService_IListVitals extends grpc.MethodDefinition<database_server_pb.ListVitalsRequest, database_server_pb.ListVitalsResponse> {
+    path: "/database.DatabaseService/ListVitals";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.ListVitalsRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.ListVitalsRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.ListVitalsResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.ListVitalsResponse>;
+}
+interface IDatabaseServiceService_IListPatients extends grpc.MethodDefinition<database_server_pb.ListPatientsRequest, database_server_pb.ListPatientsResponse> {
+    path: "/database.DatabaseService/ListPatients";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.ListPatientsRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.ListPatientsRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.ListPatientsResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.ListPatientsResponse>;
+}
+interface IDatabaseServiceService_ICreatePatient extends grpc.MethodDefinition<database_server_pb.CreatePatientRequest, database_server_pb.CreatePatientResponse> {
+    path: "/database.DatabaseService/CreatePatient";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.CreatePatientRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.CreatePatientRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.CreatePatientResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.CreatePatientResponse>;
+}
+interface IDatabaseServiceService_IGetPatientById extends grpc.MethodDefinition<database_server_pb.GetPatientByIdRequest, database_server_pb.GetPatientByIdResponse> {
+    path: "/database.DatabaseService/GetPatientById";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.GetPatientByIdRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.GetPatientByIdRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.GetPatientByIdResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.GetPatientByIdResponse>;
+}
+interface IDatabaseServiceService_IUpdatePatient extends grpc.MethodDefinition<database_server_pb.UpdatePatientRequest, database_server_pb.UpdatePatientResponse> {
+    path: "/database.DatabaseService/UpdatePatient";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.UpdatePatientRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.UpdatePatientRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.UpdatePatientResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.UpdatePatientResponse>;
+}
+interface IDatabaseServiceService_IDeletePatient extends grpc.MethodDefinition<database_server_pb.DeletePatientRequest, database_server_pb.DeletePatientResponse> {
+    path: "/database.DatabaseService/DeletePatient";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.DeletePatientRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.DeletePatientRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.DeletePatientResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.DeletePatientResponse>;
+}
+interface IDatabaseServiceService_IFlagConversation extends grpc.MethodDefinition<database_server_pb.FlagConversationRequest, database_server_pb.FlagConversationResponse> {
+    path: "/database.DatabaseService/FlagConversation";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.FlagConversationRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.FlagConversationRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.FlagConversationResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.FlagConversationResponse>;
+}
+interface IDatabaseServiceService_IGetConversationSummary extends grpc.MethodDefinition<database_server_pb.GetConversationSummaryRequest, database_server_pb.GetConversationSummaryResponse> {
+    path: "/database.DatabaseService/GetConversationSummary";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.GetConversationSummaryRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.GetConversationSummaryRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.GetConversationSummaryResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.GetConversationSummaryResponse>;
+}
+interface IDatabaseServiceService_ISaveConversationSummary extends grpc.MethodDefinition<database_server_pb.SaveConversationSummaryRequest, database_server_pb.SaveConversationSummaryResponse> {
+    path: "/database.DatabaseService/SaveConversationSummary";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.SaveConversationSummaryRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.SaveConversationSummaryRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.SaveConversationSummaryResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.SaveConversationSummaryResponse>;
+}
+interface IDatabaseServiceService_IRecordUsage extends grpc.MethodDefinition<database_server_pb.RecordUsageRequest, database_server_pb.RecordUsageResponse> {
+    path: "/database.DatabaseService/RecordUsage";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.RecordUsageRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.RecordUsageRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.RecordUsageResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.RecordUsageResponse>;
+}
+interface IDatabaseServiceService_IListUsage extends grpc.MethodDefinition<database_server_pb.ListUsageRequest, database_server_pb.ListUsageResponse> {
+    path: "/database.DatabaseService/ListUsage";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<database_server_pb.ListUsageRequest>;
+    requestDeserialize: grpc.deserialize<database_server_pb.ListUsageRequest>;
+    responseSerialize: grpc.serialize<database_server_pb.ListUsageResponse>;
+    responseDeserialize: grpc.deserialize<database_server_pb.ListUsageResponse>;
+}
 
 export const DatabaseServiceService: IDatabaseServiceService;
 
@@ -58,6 +238,24 @@ export interface IDatabaseServiceServer {
     register: grpc.handleUnaryCall<database_server_pb.RegisterRequest, database_server_pb.RegisterResponse>;
     savePatientInfo: grpc.handleUnaryCall<database_server_pb.SavePatientInfoRequest, database_server_pb.SavePatientInfoResponse>;
     getPatient: grpc.handleUnaryCall<database_server_pb.GetPatientRequest, database_server_pb.GetPatientResponse>;
+    saveConversation: grpc.handleUnaryCall<database_server_pb.SaveConversationRequest, database_server_pb.SaveConversationResponse>;
+    exportAccount: grpc.handleUnaryCall<database_server_pb.ExportAccountRequest, database_server_pb.ExportAccountResponse>;
+    deleteAccount: grpc.handleUnaryCall<database_server_pb.DeleteAccountRequest, database_server_pb.DeleteAccountResponse>;
+    getAccount: grpc.handleUnaryCall<database_server_pb.GetAccountRequest, database_server_pb.GetAccountResponse>;
+    recordAuditEvent: grpc.handleUnaryCall<database_server_pb.RecordAuditEventRequest, database_server_pb.RecordAuditEventResponse>;
+    listAuditEvents: grpc.handleUnaryCall<database_server_pb.ListAuditEventsRequest, database_server_pb.ListAuditEventsResponse>;
+    recordVitals: grpc.handleUnaryCall<database_server_pb.RecordVitalsRequest, database_server_pb.RecordVitalsResponse>;
+    listVitals: grpc.handleUnaryCall<database_server_pb.ListVitalsRequest, database_server_pb.ListVitalsResponse>;
+    listPatients: grpc.handleUnaryCall<database_server_pb.ListPatientsRequest, database_server_pb.ListPatientsResponse>;
+    createPatient: grpc.handleUnaryCall<database_server_pb.CreatePatientRequest, database_server_pb.CreatePatientResponse>;
+    getPatientById: grpc.handleUnaryCall<database_server_pb.GetPatientByIdRequest, database_server_pb.GetPatientByIdResponse>;
+    updatePatient: grpc.handleUnaryCall<database_server_pb.UpdatePatientRequest, database_server_pb.UpdatePatientResponse>;
+    deletePatient: grpc.handleUnaryCall<database_server_pb.DeletePatientRequest, database_server_pb.DeletePatientResponse>;
+    flagConversation: grpc.handleUnaryCall<database_server_pb.FlagConversationRequest, database_server_pb.FlagConversationResponse>;
+    getConversationSummary: grpc.handleUnaryCall<database_server_pb.GetConversationSummaryRequest, database_server_pb.GetConversationSummaryResponse>;
+    saveConversationSummary: grpc.handleUnaryCall<database_server_pb.SaveConversationSummaryRequest, database_server_pb.SaveConversationSummaryResponse>;
+    recordUsage: grpc.handleUnaryCall<database_server_pb.RecordUsageRequest, database_server_pb.RecordUsageResponse>;
+    listUsage: grpc.handleUnaryCall<database_server_pb.ListUsageRequest, database_server_pb.ListUsageResponse>;
 }
 
 export interface IDatabaseServiceClient {
@@ -73,6 +271,60 @@ export interface IDatabaseServiceClient {
     getPatient(request: database_server_pb.GetPatientRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientResponse) => void): grpc.ClientUnaryCall;
     getPatient(request: database_server_pb.GetPatientRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientResponse) => void): grpc.ClientUnaryCall;
     getPatient(request: database_server_pb.GetPatientRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientResponse) => void): grpc.ClientUnaryCall;
+    saveConversation(request: database_server_pb.SaveConversationRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationResponse) => void): grpc.ClientUnaryCall;
+    saveConversation(request: database_server_pb.SaveConversationRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationResponse) => void): grpc.ClientUnaryCall;
+    saveConversation(request: database_server_pb.SaveConversationRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationResponse) => void): grpc.ClientUnaryCall;
+    exportAccount(request: database_server_pb.ExportAccountRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.ExportAccountResponse) => void): grpc.ClientUnaryCall;
+    exportAccount(request: database_server_pb.ExportAccountRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.ExportAccountResponse) => void): grpc.ClientUnaryCall;
+    exportAccount(request: database_server_pb.ExportAccountRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.ExportAccountResponse) => void): grpc.ClientUnaryCall;
+    deleteAccount(request: database_server_pb.DeleteAccountRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeleteAccountResponse) => void): grpc.ClientUnaryCall;
+    deleteAccount(request: database_server_pb.DeleteAccountRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeleteAccountResponse) => void): grpc.ClientUnaryCall;
+    deleteAccount(request: database_server_pb.DeleteAccountRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeleteAccountResponse) => void): grpc.ClientUnaryCall;
+    getAccount(request: database_server_pb.GetAccountRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetAccountResponse) => void): grpc.ClientUnaryCall;
+    getAccount(request: database_server_pb.GetAccountRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetAccountResponse) => void): grpc.ClientUnaryCall;
+    getAccount(request: database_server_pb.GetAccountRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetAccountResponse) => void): grpc.ClientUnaryCall;
+    recordAuditEvent(request: database_server_pb.RecordAuditEventRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordAuditEventResponse) => void): grpc.ClientUnaryCall;
+    recordAuditEvent(request: database_server_pb.RecordAuditEventRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordAuditEventResponse) => void): grpc.ClientUnaryCall;
+    recordAuditEvent(request: database_server_pb.RecordAuditEventRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordAuditEventResponse) => void): grpc.ClientUnaryCall;
+    listAuditEvents(request: database_server_pb.ListAuditEventsRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListAuditEventsResponse) => void): grpc.ClientUnaryCall;
+    listAuditEvents(request: database_server_pb.ListAuditEventsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListAuditEventsResponse) => void): grpc.ClientUnaryCall;
+    listAuditEvents(request: database_server_pb.ListAuditEventsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListAuditEventsResponse) => void): grpc.ClientUnaryCall;
+    recordVitals(request: database_server_pb.RecordVitalsRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordVitalsResponse) => void): grpc.ClientUnaryCall;
+    recordVitals(request: database_server_pb.RecordVitalsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordVitalsResponse) => void): grpc.ClientUnaryCall;
+    recordVitals(request: database_server_pb.RecordVitalsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordVitalsResponse) => void): grpc.ClientUnaryCall;
+    listVitals(request: database_server_pb.ListVitalsRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListVitalsResponse) => void): grpc.ClientUnaryCall;
+    listVitals(request: database_server_pb.ListVitalsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListVitalsResponse) => void): grpc.ClientUnaryCall;
+    listVitals(request: database_server_pb.ListVitalsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListVitalsResponse) => void): grpc.ClientUnaryCall;
+    listPatients(request: database_server_pb.ListPatientsRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListPatientsResponse) => void): grpc.ClientUnaryCall;
+    listPatients(request: database_server_pb.ListPatientsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListPatientsResponse) => void): grpc.ClientUnaryCall;
+    listPatients(request: database_server_pb.ListPatientsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListPatientsResponse) => void): grpc.ClientUnaryCall;
+    createPatient(request: database_server_pb.CreatePatientRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.CreatePatientResponse) => void): grpc.ClientUnaryCall;
+    createPatient(request: database_server_pb.CreatePatientRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.CreatePatientResponse) => void): grpc.ClientUnaryCall;
+    createPatient(request: database_server_pb.CreatePatientRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.CreatePatientResponse) => void): grpc.ClientUnaryCall;
+    getPatientById(request: database_server_pb.GetPatientByIdRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientByIdResponse) => void): grpc.ClientUnaryCall;
+    getPatientById(request: database_server_pb.GetPatientByIdRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientByIdResponse) => void): grpc.ClientUnaryCall;
+    getPatientById(request: database_server_pb.GetPatientByIdRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientByIdResponse) => void): grpc.ClientUnaryCall;
+    updatePatient(request: database_server_pb.UpdatePatientRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.UpdatePatientResponse) => void): grpc.ClientUnaryCall;
+    updatePatient(request: database_server_pb.UpdatePatientRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.UpdatePatientResponse) => void): grpc.ClientUnaryCall;
+    updatePatient(request: database_server_pb.UpdatePatientRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.UpdatePatientResponse) => void): grpc.ClientUnaryCall;
+    deletePatient(request: database_server_pb.DeletePatientRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeletePatientResponse) => void): grpc.ClientUnaryCall;
+    deletePatient(request: database_server_pb.DeletePatientRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeletePatientResponse) => void): grpc.ClientUnaryCall;
+    deletePatient(request: database_server_pb.DeletePatientRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeletePatientResponse) => void): grpc.ClientUnaryCall;
+    flagConversation(request: database_server_pb.FlagConversationRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.FlagConversationResponse) => void): grpc.ClientUnaryCall;
+    flagConversation(request: database_server_pb.FlagConversationRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.FlagConversationResponse) => void): grpc.ClientUnaryCall;
+    flagConversation(request: database_server_pb.FlagConversationRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.FlagConversationResponse) => void): grpc.ClientUnaryCall;
+    getConversationSummary(request: database_server_pb.GetConversationSummaryRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    getConversationSummary(request: database_server_pb.GetConversationSummaryRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    getConversationSummary(request: database_server_pb.GetConversationSummaryRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    saveConversationSummary(request: database_server_pb.SaveConversationSummaryRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    saveConversationSummary(request: database_server_pb.SaveConversationSummaryRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    saveConversationSummary(request: database_server_pb.SaveConversationSummaryRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    recordUsage(request: database_server_pb.RecordUsageRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordUsageResponse) => void): grpc.ClientUnaryCall;
+    recordUsage(request: database_server_pb.RecordUsageRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordUsageResponse) => void): grpc.ClientUnaryCall;
+    recordUsage(request: database_server_pb.RecordUsageRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordUsageResponse) => void): grpc.ClientUnaryCall;
+    listUsage(request: database_server_pb.ListUsageRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListUsageResponse) => void): grpc.ClientUnaryCall;
+    listUsage(request: database_server_pb.ListUsageRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListUsageResponse) => void): grpc.ClientUnaryCall;
+    listUsage(request: database_server_pb.ListUsageRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListUsageResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class DatabaseServiceClient extends grpc.Client implements IDatabaseServiceClient {
@@ -89,4 +341,58 @@ export class DatabaseServiceClient extends grpc.Client implements IDatabaseServi
     public getPatient(request: database_server_pb.GetPatientRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientResponse) => void): grpc.ClientUnaryCall;
     public getPatient(request: database_server_pb.GetPatientRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientResponse) => void): grpc.ClientUnaryCall;
     public getPatient(request: database_server_pb.GetPatientRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientResponse) => void): grpc.ClientUnaryCall;
+    public saveConversation(request: database_server_pb.SaveConversationRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationResponse) => void): grpc.ClientUnaryCall;
+    public saveConversation(request: database_server_pb.SaveConversationRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationResponse) => void): grpc.ClientUnaryCall;
+    public saveConversation(request: database_server_pb.SaveConversationRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationResponse) => void): grpc.ClientUnaryCall;
+    public exportAccount(request: database_server_pb.ExportAccountRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.ExportAccountResponse) => void): grpc.ClientUnaryCall;
+    public exportAccount(request: database_server_pb.ExportAccountRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.ExportAccountResponse) => void): grpc.ClientUnaryCall;
+    public exportAccount(request: database_server_pb.ExportAccountRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.ExportAccountResponse) => void): grpc.ClientUnaryCall;
+    public deleteAccount(request: database_server_pb.DeleteAccountRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeleteAccountResponse) => void): grpc.ClientUnaryCall;
+    public deleteAccount(request: database_server_pb.DeleteAccountRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeleteAccountResponse) => void): grpc.ClientUnaryCall;
+    public deleteAccount(request: database_server_pb.DeleteAccountRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeleteAccountResponse) => void): grpc.ClientUnaryCall;
+    public getAccount(request: database_server_pb.GetAccountRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetAccountResponse) => void): grpc.ClientUnaryCall;
+    public getAccount(request: database_server_pb.GetAccountRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetAccountResponse) => void): grpc.ClientUnaryCall;
+    public getAccount(request: database_server_pb.GetAccountRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetAccountResponse) => void): grpc.ClientUnaryCall;
+    public recordAuditEvent(request: database_server_pb.RecordAuditEventRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordAuditEventResponse) => void): grpc.ClientUnaryCall;
+    public recordAuditEvent(request: database_server_pb.RecordAuditEventRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordAuditEventResponse) => void): grpc.ClientUnaryCall;
+    public recordAuditEvent(request: database_server_pb.RecordAuditEventRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordAuditEventResponse) => void): grpc.ClientUnaryCall;
+    public listAuditEvents(request: database_server_pb.ListAuditEventsRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListAuditEventsResponse) => void): grpc.ClientUnaryCall;
+    public listAuditEvents(request: database_server_pb.ListAuditEventsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListAuditEventsResponse) => void): grpc.ClientUnaryCall;
+    public listAuditEvents(request: database_server_pb.ListAuditEventsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListAuditEventsResponse) => void): grpc.ClientUnaryCall;
+    public recordVitals(request: database_server_pb.RecordVitalsRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordVitalsResponse) => void): grpc.ClientUnaryCall;
+    public recordVitals(request: database_server_pb.RecordVitalsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordVitalsResponse) => void): grpc.ClientUnaryCall;
+    public recordVitals(request: database_server_pb.RecordVitalsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordVitalsResponse) => void): grpc.ClientUnaryCall;
+    public listVitals(request: database_server_pb.ListVitalsRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListVitalsResponse) => void): grpc.ClientUnaryCall;
+    public listVitals(request: database_server_pb.ListVitalsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListVitalsResponse) => void): grpc.ClientUnaryCall;
+    public listVitals(request: database_server_pb.ListVitalsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListVitalsResponse) => void): grpc.ClientUnaryCall;
+    public listPatients(request: database_server_pb.ListPatientsRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListPatientsResponse) => void): grpc.ClientUnaryCall;
+    public listPatients(request: database_server_pb.ListPatientsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListPatientsResponse) => void): grpc.ClientUnaryCall;
+    public listPatients(request: database_server_pb.ListPatientsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListPatientsResponse) => void): grpc.ClientUnaryCall;
+    public createPatient(request: database_server_pb.CreatePatientRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.CreatePatientResponse) => void): grpc.ClientUnaryCall;
+    public createPatient(request: database_server_pb.CreatePatientRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.CreatePatientResponse) => void): grpc.ClientUnaryCall;
+    public createPatient(request: database_server_pb.CreatePatientRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.CreatePatientResponse) => void): grpc.ClientUnaryCall;
+    public getPatientById(request: database_server_pb.GetPatientByIdRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientByIdResponse) => void): grpc.ClientUnaryCall;
+    public getPatientById(request: database_server_pb.GetPatientByIdRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientByIdResponse) => void): grpc.ClientUnaryCall;
+    public getPatientById(request: database_server_pb.GetPatientByIdRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetPatientByIdResponse) => void): grpc.ClientUnaryCall;
+    public updatePatient(request: database_server_pb.UpdatePatientRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.UpdatePatientResponse) => void): grpc.ClientUnaryCall;
+    public updatePatient(request: database_server_pb.UpdatePatientRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.UpdatePatientResponse) => void): grpc.ClientUnaryCall;
+    public updatePatient(request: database_server_pb.UpdatePatientRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.UpdatePatientResponse) => void): grpc.ClientUnaryCall;
+    public deletePatient(request: database_server_pb.DeletePatientRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeletePatientResponse) => void): grpc.ClientUnaryCall;
+    public deletePatient(request: database_server_pb.DeletePatientRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeletePatientResponse) => void): grpc.ClientUnaryCall;
+    public deletePatient(request: database_server_pb.DeletePatientRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.DeletePatientResponse) => void): grpc.ClientUnaryCall;
+    public flagConversation(request: database_server_pb.FlagConversationRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.FlagConversationResponse) => void): grpc.ClientUnaryCall;
+    public flagConversation(request: database_server_pb.FlagConversationRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.FlagConversationResponse) => void): grpc.ClientUnaryCall;
+    public flagConversation(request: database_server_pb.FlagConversationRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.FlagConversationResponse) => void): grpc.ClientUnaryCall;
+    public getConversationSummary(request: database_server_pb.GetConversationSummaryRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    public getConversationSummary(request: database_server_pb.GetConversationSummaryRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    public getConversationSummary(request: database_server_pb.GetConversationSummaryRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.GetConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    public saveConversationSummary(request: database_server_pb.SaveConversationSummaryRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    public saveConversationSummary(request: database_server_pb.SaveConversationSummaryRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    public saveConversationSummary(request: database_server_pb.SaveConversationSummaryRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.SaveConversationSummaryResponse) => void): grpc.ClientUnaryCall;
+    public recordUsage(request: database_server_pb.RecordUsageRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordUsageResponse) => void): grpc.ClientUnaryCall;
+    public recordUsage(request: database_server_pb.RecordUsageRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordUsageResponse) => void): grpc.ClientUnaryCall;
+    public recordUsage(request: database_server_pb.RecordUsageRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.RecordUsageResponse) => void): grpc.ClientUnaryCall;
+    public listUsage(request: database_server_pb.ListUsageRequest, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListUsageResponse) => void): grpc.ClientUnaryCall;
+    public listUsage(request: database_server_pb.ListUsageRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListUsageResponse) => void): grpc.ClientUnaryCall;
+    public listUsage(request: database_server_pb.ListUsageRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: database_server_pb.ListUsageResponse) => void): grpc.ClientUnaryCall;
 }
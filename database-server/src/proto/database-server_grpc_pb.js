@@ -4,6 +4,182 @@
 var grpc = require('@grpc/grpc-js');
 var database$server_pb = require('./database-server_pb.js');
 
+function serialize_database_CreatePatientRequest(arg) {
+  if (!(arg instanceof database$server_pb.CreatePatientRequest)) {
+    throw new Error('Expected argument of type database.CreatePatientRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_CreatePatientRequest(buffer_arg) {
+  return database$server_pb.CreatePatientRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_CreatePatientResponse(arg) {
+  if (!(arg instanceof database$server_pb.CreatePatientResponse)) {
+    throw new Error('Expected argument of type database.CreatePatientResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_CreatePatientResponse(buffer_arg) {
+  return database$server_pb.CreatePatientResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_DeleteAccountRequest(arg) {
+  if (!(arg instanceof database$server_pb.DeleteAccountRequest)) {
+    throw new Error('Expected argument of type database.DeleteAccountRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_DeleteAccountRequest(buffer_arg) {
+  return database$server_pb.DeleteAccountRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_DeleteAccountResponse(arg) {
+  if (!(arg instanceof database$server_pb.DeleteAccountResponse)) {
+    throw new Error('Expected argument of type database.DeleteAccountResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_DeleteAccountResponse(buffer_arg) {
+  return database$server_pb.DeleteAccountResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_DeletePatientRequest(arg) {
+  if (!(arg instanceof database$server_pb.DeletePatientRequest)) {
+    throw new Error('Expected argument of type database.DeletePatientRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_DeletePatientRequest(buffer_arg) {
+  return database$server_pb.DeletePatientRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_DeletePatientResponse(arg) {
+  if (!(arg instanceof database$server_pb.DeletePatientResponse)) {
+    throw new Error('Expected argument of type database.DeletePatientResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_DeletePatientResponse(buffer_arg) {
+  return database$server_pb.DeletePatientResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_ExportAccountRequest(arg) {
+  if (!(arg instanceof database$server_pb.ExportAccountRequest)) {
+    throw new Error('Expected argument of type database.ExportAccountRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_ExportAccountRequest(buffer_arg) {
+  return database$server_pb.ExportAccountRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_ExportAccountResponse(arg) {
+  if (!(arg instanceof database$server_pb.ExportAccountResponse)) {
+    throw new Error('Expected argument of type database.ExportAccountResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_ExportAccountResponse(buffer_arg) {
+  return database$server_pb.ExportAccountResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_FlagConversationRequest(arg) {
+  if (!(arg instanceof database$server_pb.FlagConversationRequest)) {
+    throw new Error('Expected argument of type database.FlagConversationRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_FlagConversationRequest(buffer_arg) {
+  return database$server_pb.FlagConversationRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_FlagConversationResponse(arg) {
+  if (!(arg instanceof database$server_pb.FlagConversationResponse)) {
+    throw new Error('Expected argument of type database.FlagConversationResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_FlagConversationResponse(buffer_arg) {
+  return database$server_pb.FlagConversationResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_GetAccountRequest(arg) {
+  if (!(arg instanceof database$server_pb.GetAccountRequest)) {
+    throw new Error('Expected argument of type database.GetAccountRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_GetAccountRequest(buffer_arg) {
+  return database$server_pb.GetAccountRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_GetAccountResponse(arg) {
+  if (!(arg instanceof database$server_pb.GetAccountResponse)) {
+    throw new Error('Expected argument of type database.GetAccountResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_GetAccountResponse(buffer_arg) {
+  return database$server_pb.GetAccountResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_GetConversationSummaryRequest(arg) {
+  if (!(arg instanceof database$server_pb.GetConversationSummaryRequest)) {
+    throw new Error('Expected argument of type database.GetConversationSummaryRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_GetConversationSummaryRequest(buffer_arg) {
+  return database$server_pb.GetConversationSummaryRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_GetConversationSummaryResponse(arg) {
+  if (!(arg instanceof database$server_pb.GetConversationSummaryResponse)) {
+    throw new Error('Expected argument of type database.GetConversationSummaryResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_GetConversationSummaryResponse(buffer_arg) {
+  return database$server_pb.GetConversationSummaryResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_GetPatientByIdRequest(arg) {
+  if (!(arg instanceof database$server_pb.GetPatientByIdRequest)) {
+    throw new Error('Expected argument of type database.GetPatientByIdRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_GetPatientByIdRequest(buffer_arg) {
+  return database$server_pb.GetPatientByIdRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_GetPatientByIdResponse(arg) {
+  if (!(arg instanceof database$server_pb.GetPatientByIdResponse)) {
+    throw new Error('Expected argument of type database.GetPatientByIdResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_GetPatientByIdResponse(buffer_arg) {
+  return database$server_pb.GetPatientByIdResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_database_GetPatientRequest(arg) {
   if (!(arg instanceof database$server_pb.GetPatientRequest)) {
     throw new Error('Expected argument of type database.GetPatientRequest');
@@ -26,6 +202,94 @@ function deserialize_database_GetPatientResponse(buffer_arg) {
   return database$server_pb.GetPatientResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_database_ListAuditEventsRequest(arg) {
+  if (!(arg instanceof database$server_pb.ListAuditEventsRequest)) {
+    throw new Error('Expected argument of type database.ListAuditEventsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_ListAuditEventsRequest(buffer_arg) {
+  return database$server_pb.ListAuditEventsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_ListAuditEventsResponse(arg) {
+  if (!(arg instanceof database$server_pb.ListAuditEventsResponse)) {
+    throw new Error('Expected argument of type database.ListAuditEventsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_ListAuditEventsResponse(buffer_arg) {
+  return database$server_pb.ListAuditEventsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_ListPatientsRequest(arg) {
+  if (!(arg instanceof database$server_pb.ListPatientsRequest)) {
+    throw new Error('Expected argument of type database.ListPatientsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_ListPatientsRequest(buffer_arg) {
+  return database$server_pb.ListPatientsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_ListPatientsResponse(arg) {
+  if (!(arg instanceof database$server_pb.ListPatientsResponse)) {
+    throw new Error('Expected argument of type database.ListPatientsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_ListPatientsResponse(buffer_arg) {
+  return database$server_pb.ListPatientsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_ListUsageRequest(arg) {
+  if (!(arg instanceof database$server_pb.ListUsageRequest)) {
+    throw new Error('Expected argument of type database.ListUsageRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_ListUsageRequest(buffer_arg) {
+  return database$server_pb.ListUsageRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_ListUsageResponse(arg) {
+  if (!(arg instanceof database$server_pb.ListUsageResponse)) {
+    throw new Error('Expected argument of type database.ListUsageResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_ListUsageResponse(buffer_arg) {
+  return database$server_pb.ListUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_ListVitalsRequest(arg) {
+  if (!(arg instanceof database$server_pb.ListVitalsRequest)) {
+    throw new Error('Expected argument of type database.ListVitalsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_ListVitalsRequest(buffer_arg) {
+  return database$server_pb.ListVitalsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_ListVitalsResponse(arg) {
+  if (!(arg instanceof database$server_pb.ListVitalsResponse)) {
+    throw new Error('Expected argument of type database.ListVitalsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_ListVitalsResponse(buffer_arg) {
+  return database$server_pb.ListVitalsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_database_LoginRequest(arg) {
   if (!(arg instanceof database$server_pb.LoginRequest)) {
     throw new Error('Expected argument of type database.LoginRequest');
@@ -48,6 +312,72 @@ function deserialize_database_LoginResponse(buffer_arg) {
   return database$server_pb.LoginResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_database_RecordAuditEventRequest(arg) {
+  if (!(arg instanceof database$server_pb.RecordAuditEventRequest)) {
+    throw new Error('Expected argument of type database.RecordAuditEventRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_RecordAuditEventRequest(buffer_arg) {
+  return database$server_pb.RecordAuditEventRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_RecordAuditEventResponse(arg) {
+  if (!(arg instanceof database$server_pb.RecordAuditEventResponse)) {
+    throw new Error('Expected argument of type database.RecordAuditEventResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_RecordAuditEventResponse(buffer_arg) {
+  return database$server_pb.RecordAuditEventResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_RecordUsageRequest(arg) {
+  if (!(arg instanceof database$server_pb.RecordUsageRequest)) {
+    throw new Error('Expected argument of type database.RecordUsageRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_RecordUsageRequest(buffer_arg) {
+  return database$server_pb.RecordUsageRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_RecordUsageResponse(arg) {
+  if (!(arg instanceof database$server_pb.RecordUsageResponse)) {
+    throw new Error('Expected argument of type database.RecordUsageResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_RecordUsageResponse(buffer_arg) {
+  return database$server_pb.RecordUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_RecordVitalsRequest(arg) {
+  if (!(arg instanceof database$server_pb.RecordVitalsRequest)) {
+    throw new Error('Expected argument of type database.RecordVitalsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_RecordVitalsRequest(buffer_arg) {
+  return database$server_pb.RecordVitalsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_RecordVitalsResponse(arg) {
+  if (!(arg instanceof database$server_pb.RecordVitalsResponse)) {
+    throw new Error('Expected argument of type database.RecordVitalsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_RecordVitalsResponse(buffer_arg) {
+  return database$server_pb.RecordVitalsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_database_RegisterRequest(arg) {
   if (!(arg instanceof database$server_pb.RegisterRequest)) {
     throw new Error('Expected argument of type database.RegisterRequest');
@@ -70,6 +400,50 @@ function deserialize_database_RegisterResponse(buffer_arg) {
   return database$server_pb.RegisterResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_database_SaveConversationRequest(arg) {
+  if (!(arg instanceof database$server_pb.SaveConversationRequest)) {
+    throw new Error('Expected argument of type database.SaveConversationRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_SaveConversationRequest(buffer_arg) {
+  return database$server_pb.SaveConversationRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_SaveConversationResponse(arg) {
+  if (!(arg instanceof database$server_pb.SaveConversationResponse)) {
+    throw new Error('Expected argument of type database.SaveConversationResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_SaveConversationResponse(buffer_arg) {
+  return database$server_pb.SaveConversationResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_SaveConversationSummaryRequest(arg) {
+  if (!(arg instanceof database$server_pb.SaveConversationSummaryRequest)) {
+    throw new Error('Expected argument of type database.SaveConversationSummaryRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_SaveConversationSummaryRequest(buffer_arg) {
+  return database$server_pb.SaveConversationSummaryRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_SaveConversationSummaryResponse(arg) {
+  if (!(arg instanceof database$server_pb.SaveConversationSummaryResponse)) {
+    throw new Error('Expected argument of type database.SaveConversationSummaryResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_SaveConversationSummaryResponse(buffer_arg) {
+  return database$server_pb.SaveConversationSummaryResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_database_SavePatientInfoRequest(arg) {
   if (!(arg instanceof database$server_pb.SavePatientInfoRequest)) {
     throw new Error('Expected argument of type database.SavePatientInfoRequest');
@@ -92,6 +466,28 @@ function deserialize_database_SavePatientInfoResponse(buffer_arg) {
   return database$server_pb.SavePatientInfoResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_database_UpdatePatientRequest(arg) {
+  if (!(arg instanceof database$server_pb.UpdatePatientRequest)) {
+    throw new Error('Expected argument of type database.UpdatePatientRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_UpdatePatientRequest(buffer_arg) {
+  return database$server_pb.UpdatePatientRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_database_UpdatePatientResponse(arg) {
+  if (!(arg instanceof database$server_pb.UpdatePatientResponse)) {
+    throw new Error('Expected argument of type database.UpdatePatientResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_database_UpdatePatientResponse(buffer_arg) {
+  return database$server_pb.UpdatePatientResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 var DatabaseServiceService = exports.DatabaseServiceService = {
   login: {
@@ -138,6 +534,204 @@ var DatabaseServiceService = exports.DatabaseServiceService = {
     responseSerialize: serialize_database_GetPatientResponse,
     responseDeserialize: deserialize_database_GetPatientResponse,
   },
+  saveConversation: {
+    path: '/database.DatabaseService/SaveConversation',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.SaveConversationRequest,
+    responseType: database$server_pb.SaveConversationResponse,
+    requestSerialize: serialize_database_SaveConversationRequest,
+    requestDeserialize: deserialize_database_SaveConversationRequest,
+    responseSerialize: serialize_database_SaveConversationResponse,
+    responseDeserialize: deserialize_database_SaveConversationResponse,
+  },
+  exportAccount: {
+    path: '/database.DatabaseService/ExportAccount',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.ExportAccountRequest,
+    responseType: database$server_pb.ExportAccountResponse,
+    requestSerialize: serialize_database_ExportAccountRequest,
+    requestDeserialize: deserialize_database_ExportAccountRequest,
+    responseSerialize: serialize_database_ExportAccountResponse,
+    responseDeserialize: deserialize_database_ExportAccountResponse,
+  },
+  deleteAccount: {
+    path: '/database.DatabaseService/DeleteAccount',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.DeleteAccountRequest,
+    responseType: database$server_pb.DeleteAccountResponse,
+    requestSerialize: serialize_database_DeleteAccountRequest,
+    requestDeserialize: deserialize_database_DeleteAccountRequest,
+    responseSerialize: serialize_database_DeleteAccountResponse,
+    responseDeserialize: deserialize_database_DeleteAccountResponse,
+  },
+  getAccount: {
+    path: '/database.DatabaseService/GetAccount',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.GetAccountRequest,
+    responseType: database$server_pb.GetAccountResponse,
+    requestSerialize: serialize_database_GetAccountRequest,
+    requestDeserialize: deserialize_database_GetAccountRequest,
+    responseSerialize: serialize_database_GetAccountResponse,
+    responseDeserialize: deserialize_database_GetAccountResponse,
+  },
+  recordAuditEvent: {
+    path: '/database.DatabaseService/RecordAuditEvent',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.RecordAuditEventRequest,
+    responseType: database$server_pb.RecordAuditEventResponse,
+    requestSerialize: serialize_database_RecordAuditEventRequest,
+    requestDeserialize: deserialize_database_RecordAuditEventRequest,
+    responseSerialize: serialize_database_RecordAuditEventResponse,
+    responseDeserialize: deserialize_database_RecordAuditEventResponse,
+  },
+  listAuditEvents: {
+    path: '/database.DatabaseService/ListAuditEvents',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.ListAuditEventsRequest,
+    responseType: database$server_pb.ListAuditEventsResponse,
+    requestSerialize: serialize_database_ListAuditEventsRequest,
+    requestDeserialize: deserialize_database_ListAuditEventsRequest,
+    responseSerialize: serialize_database_ListAuditEventsResponse,
+    responseDeserialize: deserialize_database_ListAuditEventsResponse,
+  },
+  recordVitals: {
+    path: '/database.DatabaseService/RecordVitals',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.RecordVitalsRequest,
+    responseType: database$server_pb.RecordVitalsResponse,
+    requestSerialize: serialize_database_RecordVitalsRequest,
+    requestDeserialize: deserialize_database_RecordVitalsRequest,
+    responseSerialize: serialize_database_RecordVitalsResponse,
+    responseDeserialize: deserialize_database_RecordVitalsResponse,
+  },
+  listVitals: {
+    path: '/database.DatabaseService/ListVitals',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.ListVitalsRequest,
+    responseType: database$server_pb.ListVitalsResponse,
+    requestSerialize: serialize_database_ListVitalsRequest,
+    requestDeserialize: deserialize_database_ListVitalsRequest,
+    responseSerialize: serialize_database_ListVitalsResponse,
+    responseDeserialize: deserialize_database_ListVitalsResponse,
+  },
+  listPatients: {
+    path: '/database.DatabaseService/ListPatients',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.ListPatientsRequest,
+    responseType: database$server_pb.ListPatientsResponse,
+    requestSerialize: serialize_database_ListPatientsRequest,
+    requestDeserialize: deserialize_database_ListPatientsRequest,
+    responseSerialize: serialize_database_ListPatientsResponse,
+    responseDeserialize: deserialize_database_ListPatientsResponse,
+  },
+  createPatient: {
+    path: '/database.DatabaseService/CreatePatient',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.CreatePatientRequest,
+    responseType: database$server_pb.CreatePatientResponse,
+    requestSerialize: serialize_database_CreatePatientRequest,
+    requestDeserialize: deserialize_database_CreatePatientRequest,
+    responseSerialize: serialize_database_CreatePatientResponse,
+    responseDeserialize: deserialize_database_CreatePatientResponse,
+  },
+  getPatientById: {
+    path: '/database.DatabaseService/GetPatientById',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.GetPatientByIdRequest,
+    responseType: database$server_pb.GetPatientByIdResponse,
+    requestSerialize: serialize_database_GetPatientByIdRequest,
+    requestDeserialize: deserialize_database_GetPatientByIdRequest,
+    responseSerialize: serialize_database_GetPatientByIdResponse,
+    responseDeserialize: deserialize_database_GetPatientByIdResponse,
+  },
+  updatePatient: {
+    path: '/database.DatabaseService/UpdatePatient',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.UpdatePatientRequest,
+    responseType: database$server_pb.UpdatePatientResponse,
+    requestSerialize: serialize_database_UpdatePatientRequest,
+    requestDeserialize: deserialize_database_UpdatePatientRequest,
+    responseSerialize: serialize_database_UpdatePatientResponse,
+    responseDeserialize: deserialize_database_UpdatePatientResponse,
+  },
+  deletePatient: {
+    path: '/database.DatabaseService/DeletePatient',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.DeletePatientRequest,
+    responseType: database$server_pb.DeletePatientResponse,
+    requestSerialize: serialize_database_DeletePatientRequest,
+    requestDeserialize: deserialize_database_DeletePatientRequest,
+    responseSerialize: serialize_database_DeletePatientResponse,
+    responseDeserialize: deserialize_database_DeletePatientResponse,
+  },
+  flagConversation: {
+    path: '/database.DatabaseService/FlagConversation',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.FlagConversationRequest,
+    responseType: database$server_pb.FlagConversationResponse,
+    requestSerialize: serialize_database_FlagConversationRequest,
+    requestDeserialize: deserialize_database_FlagConversationRequest,
+    responseSerialize: serialize_database_FlagConversationResponse,
+    responseDeserialize: deserialize_database_FlagConversationResponse,
+  },
+  getConversationSummary: {
+    path: '/database.DatabaseService/GetConversationSummary',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.GetConversationSummaryRequest,
+    responseType: database$server_pb.GetConversationSummaryResponse,
+    requestSerialize: serialize_database_GetConversationSummaryRequest,
+    requestDeserialize: deserialize_database_GetConversationSummaryRequest,
+    responseSerialize: serialize_database_GetConversationSummaryResponse,
+    responseDeserialize: deserialize_database_GetConversationSummaryResponse,
+  },
+  saveConversationSummary: {
+    path: '/database.DatabaseService/SaveConversationSummary',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.SaveConversationSummaryRequest,
+    responseType: database$server_pb.SaveConversationSummaryResponse,
+    requestSerialize: serialize_database_SaveConversationSummaryRequest,
+    requestDeserialize: deserialize_database_SaveConversationSummaryRequest,
+    responseSerialize: serialize_database_SaveConversationSummaryResponse,
+    responseDeserialize: deserialize_database_SaveConversationSummaryResponse,
+  },
+  recordUsage: {
+    path: '/database.DatabaseService/RecordUsage',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.RecordUsageRequest,
+    responseType: database$server_pb.RecordUsageResponse,
+    requestSerialize: serialize_database_RecordUsageRequest,
+    requestDeserialize: deserialize_database_RecordUsageRequest,
+    responseSerialize: serialize_database_RecordUsageResponse,
+    responseDeserialize: deserialize_database_RecordUsageResponse,
+  },
+  listUsage: {
+    path: '/database.DatabaseService/ListUsage',
+    requestStream: false,
+    responseStream: false,
+    requestType: database$server_pb.ListUsageRequest,
+    responseType: database$server_pb.ListUsageResponse,
+    requestSerialize: serialize_database_ListUsageRequest,
+    requestDeserialize: deserialize_database_ListUsageRequest,
+    responseSerialize: serialize_database_ListUsageResponse,
+    responseDeserialize: deserialize_database_ListUsageResponse,
+  },
 };
 
 exports.DatabaseServiceClient = grpc.makeGenericClientConstructor(DatabaseServiceService, 'DatabaseService');
@@ -100,6 +100,8 @@ export class SavePatientInfoRequest extends jspb.Message {
     clearPatientInfo(): void;
     getPatientInfo(): PatientInfo | undefined;
     setPatientInfo(value?: PatientInfo): SavePatientInfoRequest;
+    getExpectedVersion(): number;
+    setExpectedVersion(value: number): SavePatientInfoRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): SavePatientInfoRequest.AsObject;
@@ -115,12 +117,15 @@ export namespace SavePatientInfoRequest {
     export type AsObject = {
         token: string,
         patientInfo?: PatientInfo.AsObject,
+        expectedVersion: number,
     }
 }
 
 export class SavePatientInfoResponse extends jspb.Message { 
     getSuccess(): boolean;
     setSuccess(value: boolean): SavePatientInfoResponse;
+    getVersion(): number;
+    setVersion(value: number): SavePatientInfoResponse;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): SavePatientInfoResponse.AsObject;
@@ -135,6 +140,7 @@ export class SavePatientInfoResponse extends jspb.Message {
 export namespace SavePatientInfoResponse {
     export type AsObject = {
         success: boolean,
+        version: number,
     }
 }
 
@@ -164,6 +170,8 @@ export class GetPatientResponse extends jspb.Message {
     clearPatientInfo(): void;
     getPatientInfo(): PatientInfo | undefined;
     setPatientInfo(value?: PatientInfo): GetPatientResponse;
+    getVersion(): number;
+    setVersion(value: number): GetPatientResponse;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GetPatientResponse.AsObject;
@@ -178,6 +186,7 @@ export class GetPatientResponse extends jspb.Message {
 export namespace GetPatientResponse {
     export type AsObject = {
         patientInfo?: PatientInfo.AsObject,
+        version: number,
     }
 }
 
@@ -192,6 +201,26 @@ export class PatientInfo extends jspb.Message {
     setWeight(value: number): PatientInfo;
     getHeight(): number;
     setHeight(value: number): PatientInfo;
+    clearAllergiesList(): void;
+    getAllergiesList(): Array<Allergy>;
+    setAllergiesList(value: Array<Allergy>): PatientInfo;
+    addAllergies(value?: Allergy, index?: number): Allergy;
+    clearMedicationsList(): void;
+    getMedicationsList(): Array<Medication>;
+    setMedicationsList(value: Array<Medication>): PatientInfo;
+    addMedications(value?: Medication, index?: number): Medication;
+    clearConditionsList(): void;
+    getConditionsList(): Array<Condition>;
+    setConditionsList(value: Array<Condition>): PatientInfo;
+    addConditions(value?: Condition, index?: number): Condition;
+    clearSurgeriesList(): void;
+    getSurgeriesList(): Array<Surgery>;
+    setSurgeriesList(value: Array<Surgery>): PatientInfo;
+    addSurgeries(value?: Surgery, index?: number): Surgery;
+    clearFamilyHistoryList(): void;
+    getFamilyHistoryList(): Array<FamilyHistoryEntry>;
+    setFamilyHistoryList(value: Array<FamilyHistoryEntry>): PatientInfo;
+    addFamilyHistory(value?: FamilyHistoryEntry, index?: number): FamilyHistoryEntry;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): PatientInfo.AsObject;
@@ -210,5 +239,1444 @@ export namespace PatientInfo {
         gender: string,
         weight: number,
         height: number,
+        allergiesList: Array<Allergy.AsObject>,
+        medicationsList: Array<Medication.AsObject>,
+        conditionsList: Array<Condition.AsObject>,
+        surgeriesList: Array<Surgery.AsObject>,
+        familyHistoryList: Array<FamilyHistoryEntry.AsObject>,
+    }
+}
+
+export class Allergy extends jspb.Message { 
+    getSubstance(): string;
+    setSubstance(value: string): Allergy;
+    getReaction(): string;
+    setReaction(value: string): Allergy;
+    getSeverity(): string;
+    setSeverity(value: string): Allergy;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Allergy.AsObject;
+    static toObject(includeInstance: boolean, msg: Allergy): Allergy.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Allergy, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Allergy;
+    static deserializeBinaryFromReader(message: Allergy, reader: jspb.BinaryReader): Allergy;
+}
+
+export namespace Allergy {
+    export type AsObject = {
+        substance: string,
+        reaction: string,
+        severity: string,
+    }
+}
+
+export class Medication extends jspb.Message { 
+    getName(): string;
+    setName(value: string): Medication;
+    getDosage(): string;
+    setDosage(value: string): Medication;
+    getFrequency(): string;
+    setFrequency(value: string): Medication;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Medication.AsObject;
+    static toObject(includeInstance: boolean, msg: Medication): Medication.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Medication, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Medication;
+    static deserializeBinaryFromReader(message: Medication, reader: jspb.BinaryReader): Medication;
+}
+
+export namespace Medication {
+    export type AsObject = {
+        name: string,
+        dosage: string,
+        frequency: string,
+    }
+}
+
+export class Condition extends jspb.Message { 
+    getName(): string;
+    setName(value: string): Condition;
+    getDiagnosedYear(): number;
+    setDiagnosedYear(value: number): Condition;
+    getNotes(): string;
+    setNotes(value: string): Condition;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Condition.AsObject;
+    static toObject(includeInstance: boolean, msg: Condition): Condition.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Condition, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Condition;
+    static deserializeBinaryFromReader(message: Condition, reader: jspb.BinaryReader): Condition;
+}
+
+export namespace Condition {
+    export type AsObject = {
+        name: string,
+        diagnosedYear: number,
+        notes: string,
+    }
+}
+
+export class Surgery extends jspb.Message { 
+    getProcedure(): string;
+    setProcedure(value: string): Surgery;
+    getYear(): number;
+    setYear(value: number): Surgery;
+    getNotes(): string;
+    setNotes(value: string): Surgery;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Surgery.AsObject;
+    static toObject(includeInstance: boolean, msg: Surgery): Surgery.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Surgery, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Surgery;
+    static deserializeBinaryFromReader(message: Surgery, reader: jspb.BinaryReader): Surgery;
+}
+
+export namespace Surgery {
+    export type AsObject = {
+        procedure: string,
+        year: number,
+        notes: string,
+    }
+}
+
+export class FamilyHistoryEntry extends jspb.Message { 
+    getRelative(): string;
+    setRelative(value: string): FamilyHistoryEntry;
+    getCondition(): string;
+    setCondition(value: string): FamilyHistoryEntry;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): FamilyHistoryEntry.AsObject;
+    static toObject(includeInstance: boolean, msg: FamilyHistoryEntry): FamilyHistoryEntry.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: FamilyHistoryEntry, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): FamilyHistoryEntry;
+    static deserializeBinaryFromReader(message: FamilyHistoryEntry, reader: jspb.BinaryReader): FamilyHistoryEntry;
+}
+
+export namespace FamilyHistoryEntry {
+    export type AsObject = {
+        relative: string,
+        condition: string,
+    }
+}
+
+export class SaveConversationRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): SaveConversationRequest;
+    getConversationId(): string;
+    setConversationId(value: string): SaveConversationRequest;
+    clearMessagesList(): void;
+    getMessagesList(): Array<ChatMessage>;
+    setMessagesList(value: Array<ChatMessage>): SaveConversationRequest;
+    addMessages(value?: ChatMessage, index?: number): ChatMessage;
+    getPatientId(): number;
+    setPatientId(value: number): SaveConversationRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SaveConversationRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: SaveConversationRequest): SaveConversationRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SaveConversationRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SaveConversationRequest;
+    static deserializeBinaryFromReader(message: SaveConversationRequest, reader: jspb.BinaryReader): SaveConversationRequest;
+}
+
+export namespace SaveConversationRequest {
+    export type AsObject = {
+        token: string,
+        conversationId: string,
+        messagesList: Array<ChatMessage.AsObject>,
+        patientId: number,
+    }
+}
+
+export class SaveConversationResponse extends jspb.Message { 
+    getSuccess(): boolean;
+    setSuccess(value: boolean): SaveConversationResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SaveConversationResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: SaveConversationResponse): SaveConversationResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SaveConversationResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SaveConversationResponse;
+    static deserializeBinaryFromReader(message: SaveConversationResponse, reader: jspb.BinaryReader): SaveConversationResponse;
+}
+
+export namespace SaveConversationResponse {
+    export type AsObject = {
+        success: boolean,
+    }
+}
+
+export class ExportAccountRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): ExportAccountRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ExportAccountRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ExportAccountRequest): ExportAccountRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ExportAccountRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ExportAccountRequest;
+    static deserializeBinaryFromReader(message: ExportAccountRequest, reader: jspb.BinaryReader): ExportAccountRequest;
+}
+
+export namespace ExportAccountRequest {
+    export type AsObject = {
+        token: string,
+    }
+}
+
+export class ExportAccountResponse extends jspb.Message { 
+
+    hasAccount(): boolean;
+    clearAccount(): void;
+    getAccount(): Account | undefined;
+    setAccount(value?: Account): ExportAccountResponse;
+
+    hasPatientInfo(): boolean;
+    clearPatientInfo(): void;
+    getPatientInfo(): PatientInfo | undefined;
+    setPatientInfo(value?: PatientInfo): ExportAccountResponse;
+    clearConversationsList(): void;
+    getConversationsList(): Array<Conversation>;
+    setConversationsList(value: Array<Conversation>): ExportAccountResponse;
+    addConversations(value?: Conversation, index?: number): Conversation;
+    getAuditId(): string;
+    setAuditId(value: string): ExportAccountResponse;
+    clearPatientsList(): void;
+    getPatientsList(): Array<PatientProfile>;
+    setPatientsList(value: Array<PatientProfile>): ExportAccountResponse;
+    addPatients(value?: PatientProfile, index?: number): PatientProfile;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ExportAccountResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ExportAccountResponse): ExportAccountResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ExportAccountResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ExportAccountResponse;
+    static deserializeBinaryFromReader(message: ExportAccountResponse, reader: jspb.BinaryReader): ExportAccountResponse;
+}
+
+export namespace ExportAccountResponse {
+    export type AsObject = {
+        account?: Account.AsObject,
+        patientInfo?: PatientInfo.AsObject,
+        conversationsList: Array<Conversation.AsObject>,
+        auditId: string,
+        patientsList: Array<PatientProfile.AsObject>,
+    }
+}
+
+export class DeleteAccountRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): DeleteAccountRequest;
+    getPassword(): string;
+    setPassword(value: string): DeleteAccountRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DeleteAccountRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: DeleteAccountRequest): DeleteAccountRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DeleteAccountRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DeleteAccountRequest;
+    static deserializeBinaryFromReader(message: DeleteAccountRequest, reader: jspb.BinaryReader): DeleteAccountRequest;
+}
+
+export namespace DeleteAccountRequest {
+    export type AsObject = {
+        token: string,
+        password: string,
+    }
+}
+
+export class DeleteAccountResponse extends jspb.Message { 
+    getSuccess(): boolean;
+    setSuccess(value: boolean): DeleteAccountResponse;
+    getAuditId(): string;
+    setAuditId(value: string): DeleteAccountResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DeleteAccountResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: DeleteAccountResponse): DeleteAccountResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DeleteAccountResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DeleteAccountResponse;
+    static deserializeBinaryFromReader(message: DeleteAccountResponse, reader: jspb.BinaryReader): DeleteAccountResponse;
+}
+
+export namespace DeleteAccountResponse {
+    export type AsObject = {
+        success: boolean,
+        auditId: string,
+    }
+}
+
+export class GetAccountRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): GetAccountRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetAccountRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GetAccountRequest): GetAccountRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetAccountRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetAccountRequest;
+    static deserializeBinaryFromReader(message: GetAccountRequest, reader: jspb.BinaryReader): GetAccountRequest;
+}
+
+export namespace GetAccountRequest {
+    export type AsObject = {
+        token: string,
+    }
+}
+
+export class GetAccountResponse extends jspb.Message { 
+
+    hasAccount(): boolean;
+    clearAccount(): void;
+    getAccount(): Account | undefined;
+    setAccount(value?: Account): GetAccountResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetAccountResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GetAccountResponse): GetAccountResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetAccountResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetAccountResponse;
+    static deserializeBinaryFromReader(message: GetAccountResponse, reader: jspb.BinaryReader): GetAccountResponse;
+}
+
+export namespace GetAccountResponse {
+    export type AsObject = {
+        account?: Account.AsObject,
+    }
+}
+
+export class Account extends jspb.Message { 
+    getId(): number;
+    setId(value: number): Account;
+    getUsername(): string;
+    setUsername(value: string): Account;
+    getRole(): string;
+    setRole(value: string): Account;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Account.AsObject;
+    static toObject(includeInstance: boolean, msg: Account): Account.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Account, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Account;
+    static deserializeBinaryFromReader(message: Account, reader: jspb.BinaryReader): Account;
+}
+
+export namespace Account {
+    export type AsObject = {
+        id: number,
+        username: string,
+        role: string,
+    }
+}
+
+export class Conversation extends jspb.Message { 
+    getId(): string;
+    setId(value: string): Conversation;
+    clearMessagesList(): void;
+    getMessagesList(): Array<ChatMessage>;
+    setMessagesList(value: Array<ChatMessage>): Conversation;
+    addMessages(value?: ChatMessage, index?: number): ChatMessage;
+    getCreatedAt(): number;
+    setCreatedAt(value: number): Conversation;
+    getPatientId(): number;
+    setPatientId(value: number): Conversation;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Conversation.AsObject;
+    static toObject(includeInstance: boolean, msg: Conversation): Conversation.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Conversation, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Conversation;
+    static deserializeBinaryFromReader(message: Conversation, reader: jspb.BinaryReader): Conversation;
+}
+
+export namespace Conversation {
+    export type AsObject = {
+        id: string,
+        messagesList: Array<ChatMessage.AsObject>,
+        createdAt: number,
+        patientId: number,
+    }
+}
+
+export class ChatMessage extends jspb.Message { 
+    getRole(): string;
+    setRole(value: string): ChatMessage;
+    getContent(): string;
+    setContent(value: string): ChatMessage;
+    getCreatedAt(): number;
+    setCreatedAt(value: number): ChatMessage;
+
+    hasSummary(): boolean;
+    clearSummary(): void;
+    getSummary(): DiagnosisSummary | undefined;
+    setSummary(value?: DiagnosisSummary): ChatMessage;
+    getTruncated(): boolean;
+    setTruncated(value: boolean): ChatMessage;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ChatMessage.AsObject;
+    static toObject(includeInstance: boolean, msg: ChatMessage): ChatMessage.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ChatMessage, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ChatMessage;
+    static deserializeBinaryFromReader(message: ChatMessage, reader: jspb.BinaryReader): ChatMessage;
+}
+
+export namespace ChatMessage {
+    export type AsObject = {
+        role: string,
+        content: string,
+        createdAt: number,
+        summary?: DiagnosisSummary.AsObject,
+        truncated: boolean,
+    }
+}
+
+export class DiagnosisSummary extends jspb.Message { 
+    clearDifferentialsList(): void;
+    getDifferentialsList(): Array<DiagnosisDifferential>;
+    setDifferentialsList(value: Array<DiagnosisDifferential>): DiagnosisSummary;
+    addDifferentials(value?: DiagnosisDifferential, index?: number): DiagnosisDifferential;
+    getTriageLevel(): string;
+    setTriageLevel(value: string): DiagnosisSummary;
+    clearSpecialtiesList(): void;
+    getSpecialtiesList(): Array<string>;
+    setSpecialtiesList(value: Array<string>): DiagnosisSummary;
+    addSpecialties(value: string, index?: number): string;
+    clearRedFlagsList(): void;
+    getRedFlagsList(): Array<string>;
+    setRedFlagsList(value: Array<string>): DiagnosisSummary;
+    addRedFlags(value: string, index?: number): string;
+    clearDisclaimersList(): void;
+    getDisclaimersList(): Array<string>;
+    setDisclaimersList(value: Array<string>): DiagnosisSummary;
+    addDisclaimers(value: string, index?: number): string;
+    clearNextStepsList(): void;
+    getNextStepsList(): Array<string>;
+    setNextStepsList(value: Array<string>): DiagnosisSummary;
+    addNextSteps(value: string, index?: number): string;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DiagnosisSummary.AsObject;
+    static toObject(includeInstance: boolean, msg: DiagnosisSummary): DiagnosisSummary.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DiagnosisSummary, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DiagnosisSummary;
+    static deserializeBinaryFromReader(message: DiagnosisSummary, reader: jspb.BinaryReader): DiagnosisSummary;
+}
+
+export namespace DiagnosisSummary {
+    export type AsObject = {
+        differentialsList: Array<DiagnosisDifferential.AsObject>,
+        triageLevel: string,
+        specialtiesList: Array<string>,
+        redFlagsList: Array<string>,
+        disclaimersList: Array<string>,
+        nextStepsList: Array<string>,
+    }
+}
+
+export class DiagnosisDifferential extends jspb.Message { 
+    getCondition(): string;
+    setCondition(value: string): DiagnosisDifferential;
+    getLikelihood(): number;
+    setLikelihood(value: number): DiagnosisDifferential;
+    getRationale(): string;
+    setRationale(value: string): DiagnosisDifferential;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DiagnosisDifferential.AsObject;
+    static toObject(includeInstance: boolean, msg: DiagnosisDifferential): DiagnosisDifferential.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DiagnosisDifferential, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DiagnosisDifferential;
+    static deserializeBinaryFromReader(message: DiagnosisDifferential, reader: jspb.BinaryReader): DiagnosisDifferential;
+}
+
+export namespace DiagnosisDifferential {
+    export type AsObject = {
+        condition: string,
+        likelihood: number,
+        rationale: string,
+    }
+}
+
+export class RecordAuditEventRequest extends jspb.Message { 
+
+    hasEvent(): boolean;
+    clearEvent(): void;
+    getEvent(): AuditEvent | undefined;
+    setEvent(value?: AuditEvent): RecordAuditEventRequest;
+    getServiceToken(): string;
+    setServiceToken(value: string): RecordAuditEventRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RecordAuditEventRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RecordAuditEventRequest): RecordAuditEventRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RecordAuditEventRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RecordAuditEventRequest;
+    static deserializeBinaryFromReader(message: RecordAuditEventRequest, reader: jspb.BinaryReader): RecordAuditEventRequest;
+}
+
+export namespace RecordAuditEventRequest {
+    export type AsObject = {
+        event?: AuditEvent.AsObject,
+        serviceToken: string,
+    }
+}
+
+export class RecordAuditEventResponse extends jspb.Message { 
+    getId(): string;
+    setId(value: string): RecordAuditEventResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RecordAuditEventResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RecordAuditEventResponse): RecordAuditEventResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RecordAuditEventResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RecordAuditEventResponse;
+    static deserializeBinaryFromReader(message: RecordAuditEventResponse, reader: jspb.BinaryReader): RecordAuditEventResponse;
+}
+
+export namespace RecordAuditEventResponse {
+    export type AsObject = {
+        id: string,
+    }
+}
+
+export class ListAuditEventsRequest extends jspb.Message { 
+    getActor(): string;
+    setActor(value: string): ListAuditEventsRequest;
+    getAction(): string;
+    setAction(value: string): ListAuditEventsRequest;
+    getResource(): string;
+    setResource(value: string): ListAuditEventsRequest;
+    getSince(): number;
+    setSince(value: number): ListAuditEventsRequest;
+    getUntil(): number;
+    setUntil(value: number): ListAuditEventsRequest;
+    getLimit(): number;
+    setLimit(value: number): ListAuditEventsRequest;
+    getServiceToken(): string;
+    setServiceToken(value: string): ListAuditEventsRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListAuditEventsRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ListAuditEventsRequest): ListAuditEventsRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListAuditEventsRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListAuditEventsRequest;
+    static deserializeBinaryFromReader(message: ListAuditEventsRequest, reader: jspb.BinaryReader): ListAuditEventsRequest;
+}
+
+export namespace ListAuditEventsRequest {
+    export type AsObject = {
+        actor: string,
+        action: string,
+        resource: string,
+        since: number,
+        until: number,
+        limit: number,
+        serviceToken: string,
+    }
+}
+
+export class ListAuditEventsResponse extends jspb.Message { 
+    clearEventsList(): void;
+    getEventsList(): Array<AuditEvent>;
+    setEventsList(value: Array<AuditEvent>): ListAuditEventsResponse;
+    addEvents(value?: AuditEvent, index?: number): AuditEvent;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListAuditEventsResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ListAuditEventsResponse): ListAuditEventsResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListAuditEventsResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListAuditEventsResponse;
+    static deserializeBinaryFromReader(message: ListAuditEventsResponse, reader: jspb.BinaryReader): ListAuditEventsResponse;
+}
+
+export namespace ListAuditEventsResponse {
+    export type AsObject = {
+        eventsList: Array<AuditEvent.AsObject>,
+    }
+}
+
+export class AuditEvent extends jspb.Message { 
+    getId(): string;
+    setId(value: string): AuditEvent;
+    getTime(): number;
+    setTime(value: number): AuditEvent;
+    getActor(): string;
+    setActor(value: string): AuditEvent;
+    getAction(): string;
+    setAction(value: string): AuditEvent;
+    getResource(): string;
+    setResource(value: string): AuditEvent;
+    getOutcome(): string;
+    setOutcome(value: string): AuditEvent;
+    getRequestId(): string;
+    setRequestId(value: string): AuditEvent;
+    getClientIp(): string;
+    setClientIp(value: string): AuditEvent;
+    getDetail(): string;
+    setDetail(value: string): AuditEvent;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): AuditEvent.AsObject;
+    static toObject(includeInstance: boolean, msg: AuditEvent): AuditEvent.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: AuditEvent, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): AuditEvent;
+    static deserializeBinaryFromReader(message: AuditEvent, reader: jspb.BinaryReader): AuditEvent;
+}
+
+export namespace AuditEvent {
+    export type AsObject = {
+        id: string,
+        time: number,
+        actor: string,
+        action: string,
+        resource: string,
+        outcome: string,
+        requestId: string,
+        clientIp: string,
+        detail: string,
+    }
+}
+
+export class RecordVitalsRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): RecordVitalsRequest;
+
+    hasVitals(): boolean;
+    clearVitals(): void;
+    getVitals(): VitalSigns | undefined;
+    setVitals(value?: VitalSigns): RecordVitalsRequest;
+    getPatientId(): number;
+    setPatientId(value: number): RecordVitalsRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RecordVitalsRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RecordVitalsRequest): RecordVitalsRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RecordVitalsRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RecordVitalsRequest;
+    static deserializeBinaryFromReader(message: RecordVitalsRequest, reader: jspb.BinaryReader): RecordVitalsRequest;
+}
+
+export namespace RecordVitalsRequest {
+    export type AsObject = {
+        token: string,
+        vitals?: VitalSigns.AsObject,
+        patientId: number,
+    }
+}
+
+export class RecordVitalsResponse extends jspb.Message { 
+    getId(): string;
+    setId(value: string): RecordVitalsResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RecordVitalsResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RecordVitalsResponse): RecordVitalsResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RecordVitalsResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RecordVitalsResponse;
+    static deserializeBinaryFromReader(message: RecordVitalsResponse, reader: jspb.BinaryReader): RecordVitalsResponse;
+}
+
+export namespace RecordVitalsResponse {
+    export type AsObject = {
+        id: string,
+    }
+}
+
+export class ListVitalsRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): ListVitalsRequest;
+    getSince(): number;
+    setSince(value: number): ListVitalsRequest;
+    getUntil(): number;
+    setUntil(value: number): ListVitalsRequest;
+    getLimit(): number;
+    setLimit(value: number): ListVitalsRequest;
+    getPatientId(): number;
+    setPatientId(value: number): ListVitalsRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListVitalsRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ListVitalsRequest): ListVitalsRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListVitalsRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListVitalsRequest;
+    static deserializeBinaryFromReader(message: ListVitalsRequest, reader: jspb.BinaryReader): ListVitalsRequest;
+}
+
+export namespace ListVitalsRequest {
+    export type AsObject = {
+        token: string,
+        since: number,
+        until: number,
+        limit: number,
+        patientId: number,
+    }
+}
+
+export class ListVitalsResponse extends jspb.Message { 
+    clearVitalsList(): void;
+    getVitalsList(): Array<VitalSigns>;
+    setVitalsList(value: Array<VitalSigns>): ListVitalsResponse;
+    addVitals(value?: VitalSigns, index?: number): VitalSigns;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListVitalsResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ListVitalsResponse): ListVitalsResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListVitalsResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListVitalsResponse;
+    static deserializeBinaryFromReader(message: ListVitalsResponse, reader: jspb.BinaryReader): ListVitalsResponse;
+}
+
+export namespace ListVitalsResponse {
+    export type AsObject = {
+        vitalsList: Array<VitalSigns.AsObject>,
+    }
+}
+
+export class VitalSigns extends jspb.Message { 
+    getId(): string;
+    setId(value: string): VitalSigns;
+    getRecordedAt(): number;
+    setRecordedAt(value: number): VitalSigns;
+
+    hasSystolic(): boolean;
+    clearSystolic(): void;
+    getSystolic(): number | undefined;
+    setSystolic(value: number): VitalSigns;
+
+    hasDiastolic(): boolean;
+    clearDiastolic(): void;
+    getDiastolic(): number | undefined;
+    setDiastolic(value: number): VitalSigns;
+
+    hasHeartRate(): boolean;
+    clearHeartRate(): void;
+    getHeartRate(): number | undefined;
+    setHeartRate(value: number): VitalSigns;
+
+    hasTemperature(): boolean;
+    clearTemperature(): void;
+    getTemperature(): number | undefined;
+    setTemperature(value: number): VitalSigns;
+
+    hasSpo2(): boolean;
+    clearSpo2(): void;
+    getSpo2(): number | undefined;
+    setSpo2(value: number): VitalSigns;
+
+    hasGlucose(): boolean;
+    clearGlucose(): void;
+    getGlucose(): number | undefined;
+    setGlucose(value: number): VitalSigns;
+
+    hasWeight(): boolean;
+    clearWeight(): void;
+    getWeight(): number | undefined;
+    setWeight(value: number): VitalSigns;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): VitalSigns.AsObject;
+    static toObject(includeInstance: boolean, msg: VitalSigns): VitalSigns.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: VitalSigns, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): VitalSigns;
+    static deserializeBinaryFromReader(message: VitalSigns, reader: jspb.BinaryReader): VitalSigns;
+}
+
+export namespace VitalSigns {
+    export type AsObject = {
+        id: string,
+        recordedAt: number,
+        systolic?: number,
+        diastolic?: number,
+        heartRate?: number,
+        temperature?: number,
+        spo2?: number,
+        glucose?: number,
+        weight?: number,
+    }
+}
+
+export class PatientProfile extends jspb.Message { 
+    getId(): number;
+    setId(value: number): PatientProfile;
+    getOwnerId(): number;
+    setOwnerId(value: number): PatientProfile;
+    getRelationship(): string;
+    setRelationship(value: string): PatientProfile;
+
+    hasPatientInfo(): boolean;
+    clearPatientInfo(): void;
+    getPatientInfo(): PatientInfo | undefined;
+    setPatientInfo(value?: PatientInfo): PatientProfile;
+    getVersion(): number;
+    setVersion(value: number): PatientProfile;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): PatientProfile.AsObject;
+    static toObject(includeInstance: boolean, msg: PatientProfile): PatientProfile.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: PatientProfile, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): PatientProfile;
+    static deserializeBinaryFromReader(message: PatientProfile, reader: jspb.BinaryReader): PatientProfile;
+}
+
+export namespace PatientProfile {
+    export type AsObject = {
+        id: number,
+        ownerId: number,
+        relationship: string,
+        patientInfo?: PatientInfo.AsObject,
+        version: number,
+    }
+}
+
+export class ListPatientsRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): ListPatientsRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListPatientsRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ListPatientsRequest): ListPatientsRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListPatientsRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListPatientsRequest;
+    static deserializeBinaryFromReader(message: ListPatientsRequest, reader: jspb.BinaryReader): ListPatientsRequest;
+}
+
+export namespace ListPatientsRequest {
+    export type AsObject = {
+        token: string,
+    }
+}
+
+export class ListPatientsResponse extends jspb.Message { 
+    clearPatientsList(): void;
+    getPatientsList(): Array<PatientProfile>;
+    setPatientsList(value: Array<PatientProfile>): ListPatientsResponse;
+    addPatients(value?: PatientProfile, index?: number): PatientProfile;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListPatientsResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ListPatientsResponse): ListPatientsResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListPatientsResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListPatientsResponse;
+    static deserializeBinaryFromReader(message: ListPatientsResponse, reader: jspb.BinaryReader): ListPatientsResponse;
+}
+
+export namespace ListPatientsResponse {
+    export type AsObject = {
+        patientsList: Array<PatientProfile.AsObject>,
+    }
+}
+
+export class CreatePatientRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): CreatePatientRequest;
+    getRelationship(): string;
+    setRelationship(value: string): CreatePatientRequest;
+
+    hasPatientInfo(): boolean;
+    clearPatientInfo(): void;
+    getPatientInfo(): PatientInfo | undefined;
+    setPatientInfo(value?: PatientInfo): CreatePatientRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): CreatePatientRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: CreatePatientRequest): CreatePatientRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: CreatePatientRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): CreatePatientRequest;
+    static deserializeBinaryFromReader(message: CreatePatientRequest, reader: jspb.BinaryReader): CreatePatientRequest;
+}
+
+export namespace CreatePatientRequest {
+    export type AsObject = {
+        token: string,
+        relationship: string,
+        patientInfo?: PatientInfo.AsObject,
+    }
+}
+
+export class CreatePatientResponse extends jspb.Message { 
+
+    hasPatient(): boolean;
+    clearPatient(): void;
+    getPatient(): PatientProfile | undefined;
+    setPatient(value?: PatientProfile): CreatePatientResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): CreatePatientResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: CreatePatientResponse): CreatePatientResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: CreatePatientResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): CreatePatientResponse;
+    static deserializeBinaryFromReader(message: CreatePatientResponse, reader: jspb.BinaryReader): CreatePatientResponse;
+}
+
+export namespace CreatePatientResponse {
+    export type AsObject = {
+        patient?: PatientProfile.AsObject,
+    }
+}
+
+export class GetPatientByIdRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): GetPatientByIdRequest;
+    getPatientId(): number;
+    setPatientId(value: number): GetPatientByIdRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetPatientByIdRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GetPatientByIdRequest): GetPatientByIdRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetPatientByIdRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetPatientByIdRequest;
+    static deserializeBinaryFromReader(message: GetPatientByIdRequest, reader: jspb.BinaryReader): GetPatientByIdRequest;
+}
+
+export namespace GetPatientByIdRequest {
+    export type AsObject = {
+        token: string,
+        patientId: number,
+    }
+}
+
+export class GetPatientByIdResponse extends jspb.Message { 
+
+    hasPatient(): boolean;
+    clearPatient(): void;
+    getPatient(): PatientProfile | undefined;
+    setPatient(value?: PatientProfile): GetPatientByIdResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetPatientByIdResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GetPatientByIdResponse): GetPatientByIdResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetPatientByIdResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetPatientByIdResponse;
+    static deserializeBinaryFromReader(message: GetPatientByIdResponse, reader: jspb.BinaryReader): GetPatientByIdResponse;
+}
+
+export namespace GetPatientByIdResponse {
+    export type AsObject = {
+        patient?: PatientProfile.AsObject,
+    }
+}
+
+export class UpdatePatientRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): UpdatePatientRequest;
+    getPatientId(): number;
+    setPatientId(value: number): UpdatePatientRequest;
+    getRelationship(): string;
+    setRelationship(value: string): UpdatePatientRequest;
+
+    hasPatientInfo(): boolean;
+    clearPatientInfo(): void;
+    getPatientInfo(): PatientInfo | undefined;
+    setPatientInfo(value?: PatientInfo): UpdatePatientRequest;
+    getExpectedVersion(): number;
+    setExpectedVersion(value: number): UpdatePatientRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): UpdatePatientRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: UpdatePatientRequest): UpdatePatientRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: UpdatePatientRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): UpdatePatientRequest;
+    static deserializeBinaryFromReader(message: UpdatePatientRequest, reader: jspb.BinaryReader): UpdatePatientRequest;
+}
+
+export namespace UpdatePatientRequest {
+    export type AsObject = {
+        token: string,
+        patientId: number,
+        relationship: string,
+        patientInfo?: PatientInfo.AsObject,
+        expectedVersion: number,
+    }
+}
+
+export class UpdatePatientResponse extends jspb.Message { 
+
+    hasPatient(): boolean;
+    clearPatient(): void;
+    getPatient(): PatientProfile | undefined;
+    setPatient(value?: PatientProfile): UpdatePatientResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): UpdatePatientResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: UpdatePatientResponse): UpdatePatientResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: UpdatePatientResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): UpdatePatientResponse;
+    static deserializeBinaryFromReader(message: UpdatePatientResponse, reader: jspb.BinaryReader): UpdatePatientResponse;
+}
+
+export namespace UpdatePatientResponse {
+    export type AsObject = {
+        patient?: PatientProfile.AsObject,
+    }
+}
+
+export class DeletePatientRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): DeletePatientRequest;
+    getPatientId(): number;
+    setPatientId(value: number): DeletePatientRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DeletePatientRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: DeletePatientRequest): DeletePatientRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DeletePatientRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DeletePatientRequest;
+    static deserializeBinaryFromReader(message: DeletePatientRequest, reader: jspb.BinaryReader): DeletePatientRequest;
+}
+
+export namespace DeletePatientRequest {
+    export type AsObject = {
+        token: string,
+        patientId: number,
+    }
+}
+
+export class DeletePatientResponse extends jspb.Message { 
+    getSuccess(): boolean;
+    setSuccess(value: boolean): DeletePatientResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DeletePatientResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: DeletePatientResponse): DeletePatientResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DeletePatientResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DeletePatientResponse;
+    static deserializeBinaryFromReader(message: DeletePatientResponse, reader: jspb.BinaryReader): DeletePatientResponse;
+}
+
+export namespace DeletePatientResponse {
+    export type AsObject = {
+        success: boolean,
+    }
+}
+
+export class FlagConversationRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): FlagConversationRequest;
+    getConversationId(): string;
+    setConversationId(value: string): FlagConversationRequest;
+    getPatientId(): number;
+    setPatientId(value: number): FlagConversationRequest;
+    getReason(): string;
+    setReason(value: string): FlagConversationRequest;
+    clearRuleIdsList(): void;
+    getRuleIdsList(): Array<string>;
+    setRuleIdsList(value: Array<string>): FlagConversationRequest;
+    addRuleIds(value: string, index?: number): string;
+    getSeverity(): string;
+    setSeverity(value: string): FlagConversationRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): FlagConversationRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: FlagConversationRequest): FlagConversationRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: FlagConversationRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): FlagConversationRequest;
+    static deserializeBinaryFromReader(message: FlagConversationRequest, reader: jspb.BinaryReader): FlagConversationRequest;
+}
+
+export namespace FlagConversationRequest {
+    export type AsObject = {
+        token: string,
+        conversationId: string,
+        patientId: number,
+        reason: string,
+        ruleIdsList: Array<string>,
+        severity: string,
+    }
+}
+
+export class FlagConversationResponse extends jspb.Message { 
+    getSuccess(): boolean;
+    setSuccess(value: boolean): FlagConversationResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): FlagConversationResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: FlagConversationResponse): FlagConversationResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: FlagConversationResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): FlagConversationResponse;
+    static deserializeBinaryFromReader(message: FlagConversationResponse, reader: jspb.BinaryReader): FlagConversationResponse;
+}
+
+export namespace FlagConversationResponse {
+    export type AsObject = {
+        success: boolean,
+    }
+}
+
+export class ConversationSummary extends jspb.Message { 
+    getSummary(): string;
+    setSummary(value: string): ConversationSummary;
+    getMessageCount(): number;
+    setMessageCount(value: number): ConversationSummary;
+    getMessagesHash(): string;
+    setMessagesHash(value: string): ConversationSummary;
+    getUpdatedAt(): number;
+    setUpdatedAt(value: number): ConversationSummary;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ConversationSummary.AsObject;
+    static toObject(includeInstance: boolean, msg: ConversationSummary): ConversationSummary.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ConversationSummary, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ConversationSummary;
+    static deserializeBinaryFromReader(message: ConversationSummary, reader: jspb.BinaryReader): ConversationSummary;
+}
+
+export namespace ConversationSummary {
+    export type AsObject = {
+        summary: string,
+        messageCount: number,
+        messagesHash: string,
+        updatedAt: number,
+    }
+}
+
+export class GetConversationSummaryRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): GetConversationSummaryRequest;
+    getConversationId(): string;
+    setConversationId(value: string): GetConversationSummaryRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetConversationSummaryRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GetConversationSummaryRequest): GetConversationSummaryRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetConversationSummaryRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetConversationSummaryRequest;
+    static deserializeBinaryFromReader(message: GetConversationSummaryRequest, reader: jspb.BinaryReader): GetConversationSummaryRequest;
+}
+
+export namespace GetConversationSummaryRequest {
+    export type AsObject = {
+        token: string,
+        conversationId: string,
+    }
+}
+
+export class GetConversationSummaryResponse extends jspb.Message { 
+
+    hasSummary(): boolean;
+    clearSummary(): void;
+    getSummary(): ConversationSummary | undefined;
+    setSummary(value?: ConversationSummary): GetConversationSummaryResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetConversationSummaryResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GetConversationSummaryResponse): GetConversationSummaryResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetConversationSummaryResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetConversationSummaryResponse;
+    static deserializeBinaryFromReader(message: GetConversationSummaryResponse, reader: jspb.BinaryReader): GetConversationSummaryResponse;
+}
+
+export namespace GetConversationSummaryResponse {
+    export type AsObject = {
+        summary?: ConversationSummary.AsObject,
+    }
+}
+
+export class SaveConversationSummaryRequest extends jspb.Message { 
+    getToken(): string;
+    setToken(value: string): SaveConversationSummaryRequest;
+    getConversationId(): string;
+    setConversationId(value: string): SaveConversationSummaryRequest;
+
+    hasSummary(): boolean;
+    clearSummary(): void;
+    getSummary(): ConversationSummary | undefined;
+    setSummary(value?: ConversationSummary): SaveConversationSummaryRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SaveConversationSummaryRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: SaveConversationSummaryRequest): SaveConversationSummaryRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SaveConversationSummaryRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SaveConversationSummaryRequest;
+    static deserializeBinaryFromReader(message: SaveConversationSummaryRequest, reader: jspb.BinaryReader): SaveConversationSummaryRequest;
+}
+
+export namespace SaveConversationSummaryRequest {
+    export type AsObject = {
+        token: string,
+        conversationId: string,
+        summary?: ConversationSummary.AsObject,
+    }
+}
+
+export class SaveConversationSummaryResponse extends jspb.Message { 
+    getSuccess(): boolean;
+    setSuccess(value: boolean): SaveConversationSummaryResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SaveConversationSummaryResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: SaveConversationSummaryResponse): SaveConversationSummaryResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SaveConversationSummaryResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SaveConversationSummaryResponse;
+    static deserializeBinaryFromReader(message: SaveConversationSummaryResponse, reader: jspb.BinaryReader): SaveConversationSummaryResponse;
+}
+
+export namespace SaveConversationSummaryResponse {
+    export type AsObject = {
+        success: boolean,
+    }
+}
+
+export class UsageRecord extends jspb.Message { 
+    getAccountId(): number;
+    setAccountId(value: number): UsageRecord;
+    getConversationId(): string;
+    setConversationId(value: string): UsageRecord;
+    getModel(): string;
+    setModel(value: string): UsageRecord;
+    getPromptTokens(): number;
+    setPromptTokens(value: number): UsageRecord;
+    getCompletionTokens(): number;
+    setCompletionTokens(value: number): UsageRecord;
+    getTime(): number;
+    setTime(value: number): UsageRecord;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): UsageRecord.AsObject;
+    static toObject(includeInstance: boolean, msg: UsageRecord): UsageRecord.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: UsageRecord, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): UsageRecord;
+    static deserializeBinaryFromReader(message: UsageRecord, reader: jspb.BinaryReader): UsageRecord;
+}
+
+export namespace UsageRecord {
+    export type AsObject = {
+        accountId: number,
+        conversationId: string,
+        model: string,
+        promptTokens: number,
+        completionTokens: number,
+        time: number,
+    }
+}
+
+export class RecordUsageRequest extends jspb.Message { 
+
+    hasRecord(): boolean;
+    clearRecord(): void;
+    getRecord(): UsageRecord | undefined;
+    setRecord(value?: UsageRecord): RecordUsageRequest;
+    getServiceToken(): string;
+    setServiceToken(value: string): RecordUsageRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RecordUsageRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RecordUsageRequest): RecordUsageRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RecordUsageRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RecordUsageRequest;
+    static deserializeBinaryFromReader(message: RecordUsageRequest, reader: jspb.BinaryReader): RecordUsageRequest;
+}
+
+export namespace RecordUsageRequest {
+    export type AsObject = {
+        record?: UsageRecord.AsObject,
+        serviceToken: string,
+    }
+}
+
+export class RecordUsageResponse extends jspb.Message { 
+    getSuccess(): boolean;
+    setSuccess(value: boolean): RecordUsageResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RecordUsageResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RecordUsageResponse): RecordUsageResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RecordUsageResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RecordUsageResponse;
+    static deserializeBinaryFromReader(message: RecordUsageResponse, reader: jspb.BinaryReader): RecordUsageResponse;
+}
+
+export namespace RecordUsageResponse {
+    export type AsObject = {
+        success: boolean,
+    }
+}
+
+export class ListUsageRequest extends jspb.Message { 
+    getAccountId(): number;
+    setAccountId(value: number): ListUsageRequest;
+    getSince(): number;
+    setSince(value: number): ListUsageRequest;
+    getUntil(): number;
+    setUntil(value: number): ListUsageRequest;
+    getServiceToken(): string;
+    setServiceToken(value: string): ListUsageRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListUsageRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ListUsageRequest): ListUsageRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListUsageRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListUsageRequest;
+    static deserializeBinaryFromReader(message: ListUsageRequest, reader: jspb.BinaryReader): ListUsageRequest;
+}
+
+export namespace ListUsageRequest {
+    export type AsObject = {
+        accountId: number,
+        since: number,
+        until: number,
+        serviceToken: string,
+    }
+}
+
+export class ListUsageResponse extends jspb.Message { 
+    clearTotalsList(): void;
+    getTotalsList(): Array<UsageTotal>;
+    setTotalsList(value: Array<UsageTotal>): ListUsageResponse;
+    addTotals(value?: UsageTotal, index?: number): UsageTotal;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListUsageResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ListUsageResponse): ListUsageResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListUsageResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListUsageResponse;
+    static deserializeBinaryFromReader(message: ListUsageResponse, reader: jspb.BinaryReader): ListUsageResponse;
+}
+
+export namespace ListUsageResponse {
+    export type AsObject = {
+        totalsList: Array<UsageTotal.AsObject>,
+    }
+}
+
+export class UsageTotal extends jspb.Message { 
+    getAccountId(): number;
+    setAccountId(value: number): UsageTotal;
+    getConversationId(): string;
+    setConversationId(value: string): UsageTotal;
+    getModel(): string;
+    setModel(value: string): UsageTotal;
+    getPromptTokens(): number;
+    setPromptTokens(value: number): UsageTotal;
+    getCompletionTokens(): number;
+    setCompletionTokens(value: number): UsageTotal;
+    getRequests(): number;
+    setRequests(value: number): UsageTotal;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): UsageTotal.AsObject;
+    static toObject(includeInstance: boolean, msg: UsageTotal): UsageTotal.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: UsageTotal, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): UsageTotal;
+    static deserializeBinaryFromReader(message: UsageTotal, reader: jspb.BinaryReader): UsageTotal;
+}
+
+export namespace UsageTotal {
+    export type AsObject = {
+        accountId: number,
+        conversationId: string,
+        model: string,
+        promptTokens: number,
+        completionTokens: number,
+        requests: number,
     }
 }
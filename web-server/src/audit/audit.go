@@ -0,0 +1,122 @@
+package audit
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+)
+
+// Outcome represents the result of an audited action
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+	OutcomeDenied  Outcome = "denied"
+)
+
+// Event represents a single audited action
+type Event struct {
+	ID        string    `json:"id,omitempty"`
+	Time      time.Time `json:"time"`
+	Actor     string    `json:"actor"`
+	Action    string    `json:"action"`
+	Resource  string    `json:"resource"`
+	Outcome   Outcome   `json:"outcome"`
+	RequestID string    `json:"request_id"`
+	ClientIP  string    `json:"client_ip"`
+	Detail    string    `json:"detail,omitempty"`
+}
+
+// Filter selects events when querying a sink. Zero values match everything.
+type Filter struct {
+	Actor    string
+	Action   string
+	Resource string
+	Since    time.Time
+	Until    time.Time
+	Limit    int
+}
+
+// Matches reports whether an event satisfies the filter
+func (f Filter) Matches(event Event) bool {
+	if f.Actor != "" && event.Actor != f.Actor {
+		return false
+	}
+	if f.Action != "" && event.Action != f.Action {
+		return false
+	}
+	if f.Resource != "" && event.Resource != f.Resource {
+		return false
+	}
+	if !f.Since.IsZero() && event.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && event.Time.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+// Sink stores audit events
+type Sink interface {
+	// Write appends an event to the sink
+	Write(ctx context.Context, event Event) error
+	// Query returns the events matching the filter, newest first
+	Query(ctx context.Context, filter Filter) ([]Event, error)
+	// Close releases the resources held by the sink
+	Close() error
+}
+
+// Logger writes audit events to every configured sink
+type Logger struct {
+	sinks       []Sink
+	errorLogger *log.Logger
+}
+
+// NewLogger creates a new audit logger. Queries are served by the first sink.
+func NewLogger(sinks ...Sink) *Logger {
+	return &Logger{
+		sinks:       sinks,
+		errorLogger: log.New(os.Stderr, "[AUDIT-ERROR] ", log.LstdFlags),
+	}
+}
+
+// Record writes an event to every sink.
+// A failing sink does not stop the others, the first error is returned.
+func (l *Logger) Record(ctx context.Context, event Event) error {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+
+	var firstErr error
+	for _, sink := range l.sinks {
+		if err := sink.Write(ctx, event); err != nil {
+			l.errorLogger.Printf("Failed to write %s event for %s: %v", event.Action, event.Actor, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// Query returns the events matching the filter from the first sink
+func (l *Logger) Query(ctx context.Context, filter Filter) ([]Event, error) {
+	if len(l.sinks) == 0 {
+		return []Event{}, nil
+	}
+	return l.sinks[0].Query(ctx, filter)
+}
+
+// Close closes every sink
+func (l *Logger) Close() error {
+	var firstErr error
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
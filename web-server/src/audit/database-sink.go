@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"context"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// EventStore is the part of the database client the sink uses
+type EventStore interface {
+	RecordAuditEvent(ctx context.Context, input grpc.RecordAuditEventInput) (*grpc.RecordAuditEventOutput, error)
+	ListAuditEvents(ctx context.Context, input grpc.ListAuditEventsInput) (*grpc.ListAuditEventsOutput, error)
+}
+
+// DatabaseSink stores events through the database server's audit RPCs
+type DatabaseSink struct {
+	client EventStore
+}
+
+// NewDatabaseSink creates a sink backed by the database server
+func NewDatabaseSink(client EventStore) *DatabaseSink {
+	return &DatabaseSink{client: client}
+}
+
+// Write records an event in the database
+func (s *DatabaseSink) Write(ctx context.Context, event Event) error {
+	_, err := s.client.RecordAuditEvent(ctx, grpc.RecordAuditEventInput{
+		Event: grpc.AuditEvent{
+			Time:      event.Time,
+			Actor:     event.Actor,
+			Action:    event.Action,
+			Resource:  event.Resource,
+			Outcome:   string(event.Outcome),
+			RequestID: event.RequestID,
+			ClientIP:  event.ClientIP,
+			Detail:    event.Detail,
+		},
+	})
+	return err
+}
+
+// Query lists the events matching the filter from the database
+func (s *DatabaseSink) Query(ctx context.Context, filter Filter) ([]Event, error) {
+	output, err := s.client.ListAuditEvents(ctx, grpc.ListAuditEventsInput{
+		Actor:    filter.Actor,
+		Action:   filter.Action,
+		Resource: filter.Resource,
+		Since:    filter.Since,
+		Until:    filter.Until,
+		Limit:    int32(filter.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]Event, len(output.Events))
+	for i, event := range output.Events {
+		events[i] = Event{
+			ID:        event.ID,
+			Time:      event.Time,
+			Actor:     event.Actor,
+			Action:    event.Action,
+			Resource:  event.Resource,
+			Outcome:   Outcome(event.Outcome),
+			RequestID: event.RequestID,
+			ClientIP:  event.ClientIP,
+			Detail:    event.Detail,
+		}
+	}
+	return events, nil
+}
+
+// Close is a no-op, the database client is owned by the HTTP server
+func (s *DatabaseSink) Close() error {
+	return nil
+}
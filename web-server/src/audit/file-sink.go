@@ -0,0 +1,347 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+)
+
+// record is a line of the audit file.
+// Hash covers the previous hash and the event, so editing or removing a line breaks the chain.
+type record struct {
+	Event    Event  `json:"event"`
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// head anchors the chain outside the audit file, so removing lines from its end is detected.
+// MAC authenticates the head with the sink's key, when one is configured.
+type head struct {
+	Count int    `json:"count"`
+	Hash  string `json:"hash"`
+	MAC   string `json:"mac,omitempty"`
+}
+
+// FileSink appends hash-chained events to a JSON lines file.
+// The length and last hash of the chain are kept in a head file next to it, at path + ".head".
+type FileSink struct {
+	mu       sync.Mutex
+	path     string
+	key      []byte
+	file     *os.File
+	lastHash string
+	count    int
+}
+
+// NewFileSink opens an audit file, verifying the existing chain before appending to it.
+// With a key the head file is authenticated, so the chain cannot be truncated and re-anchored without it.
+// A torn final line, left by a crash in the middle of a write, is discarded.
+func NewFileSink(path string, key []byte) (*FileSink, error) {
+	sink := &FileSink{path: path, key: key}
+
+	records, size, err := readRecords(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := repairTornLine(path, size); err != nil {
+		return nil, err
+	}
+
+	// Recover the tail of the chain from the existing file
+	lastHash, count, err := sink.verify(records)
+	if err != nil {
+		return nil, err
+	}
+	sink.lastHash = lastHash
+	sink.count = count
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %v", err)
+	}
+	sink.file = file
+
+	// The head may lag one event behind after a crash between the two writes
+	if err := sink.writeHead(count, lastHash); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+// Write appends an event to the file
+func (s *FileSink) Write(ctx context.Context, event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if event.ID == "" {
+		event.ID = fmt.Sprintf("file-%d", s.count+1)
+	}
+	event.Time = event.Time.UTC()
+
+	hash, err := chainHash(s.lastHash, event)
+	if err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(record{
+		Event:    event,
+		PrevHash: s.lastHash,
+		Hash:     hash,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The chain only advances once the line and the head are both written, otherwise the line is removed
+	info, err := s.file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to write audit event: %v", err)
+	}
+	if err := s.appendLine(append(line, '\n'), hash); err != nil {
+		if truncErr := s.file.Truncate(info.Size()); truncErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to remove the unfinished audit event: %v", truncErr))
+		}
+		return err
+	}
+
+	s.lastHash = hash
+	s.count++
+	return nil
+}
+
+// appendLine writes a record to the file and anchors the head on it; the lock must be held
+func (s *FileSink) appendLine(line []byte, hash string) error {
+	if _, err := s.file.Write(line); err != nil {
+		return fmt.Errorf("failed to write audit event: %v", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("failed to write audit event: %v", err)
+	}
+	return s.writeHead(s.count+1, hash)
+}
+
+// Query scans the file for events matching the filter, newest first
+func (s *FileSink) Query(ctx context.Context, filter Filter) ([]Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records, _, err := readRecords(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []Event{}
+	for i := len(records) - 1; i >= 0; i-- {
+		if !filter.Matches(records[i].Event) {
+			continue
+		}
+		events = append(events, records[i].Event)
+		if filter.Limit > 0 && len(events) >= filter.Limit {
+			break
+		}
+	}
+	return events, nil
+}
+
+// Verify checks the whole hash chain against its head and returns the number of intact events
+func (s *FileSink) Verify() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records, _, err := readRecords(s.path)
+	if err != nil {
+		return 0, err
+	}
+	_, count, err := s.verify(records)
+	return count, err
+}
+
+// Close closes the audit file
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// verify walks the chain, checks it ends where the head says and returns its last hash and length.
+// A missing file is an empty chain.
+func (s *FileSink) verify(records []record) (string, int, error) {
+	lastHash := ""
+	hashes := make([]string, len(records))
+	for i, rec := range records {
+		if rec.PrevHash != lastHash {
+			return "", i, fmt.Errorf("audit chain broken at line %d: previous hash mismatch", i+1)
+		}
+		hash, err := chainHash(rec.PrevHash, rec.Event)
+		if err != nil {
+			return "", i, err
+		}
+		if hash != rec.Hash {
+			return "", i, fmt.Errorf("audit chain broken at line %d: event was modified", i+1)
+		}
+		lastHash = rec.Hash
+		hashes[i] = rec.Hash
+	}
+
+	h, err := s.readHead()
+	if err != nil {
+		return "", len(records), err
+	}
+	if h == nil {
+		if len(records) > 0 {
+			return "", len(records), fmt.Errorf("audit head %s is missing", s.headPath())
+		}
+		return lastHash, 0, nil
+	}
+
+	if len(s.key) > 0 && !hmac.Equal([]byte(h.MAC), []byte(s.headMAC(h.Count, h.Hash))) {
+		return "", len(records), fmt.Errorf("audit head %s is not authentic", s.headPath())
+	}
+	// One event past the head is a write interrupted before the head was updated
+	if len(records) < h.Count || len(records) > h.Count+1 {
+		return "", len(records), fmt.Errorf("audit chain has %d events, its head expects %d: the file was truncated or replaced", len(records), h.Count)
+	}
+	if h.Count > 0 && hashes[h.Count-1] != h.Hash {
+		return "", len(records), fmt.Errorf("audit chain broken at line %d: hash does not match the head", h.Count)
+	}
+	return lastHash, len(records), nil
+}
+
+// headPath is the path of the file anchoring the chain
+func (s *FileSink) headPath() string {
+	return s.path + ".head"
+}
+
+// headMAC authenticates the length and last hash of the chain
+func (s *FileSink) headMAC(count int, hash string) string {
+	mac := hmac.New(sha256.New, s.key)
+	fmt.Fprintf(mac, "%d:%s", count, hash)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// readHead reads the head file, returning nil when there is none
+func (s *FileSink) readHead() (*head, error) {
+	data, err := os.ReadFile(s.headPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit head: %v", err)
+	}
+
+	var h head
+	if err := json.Unmarshal(data, &h); err != nil {
+		return nil, fmt.Errorf("audit head corrupted: %v", err)
+	}
+	return &h, nil
+}
+
+// writeHead replaces the head file with the given end of the chain; the lock must be held
+func (s *FileSink) writeHead(count int, hash string) error {
+	h := head{Count: count, Hash: hash}
+	if len(s.key) > 0 {
+		h.MAC = s.headMAC(h.Count, h.Hash)
+	}
+	data, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename it, so the head is never left half written
+	tmp := s.headPath() + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to write audit head: %v", err)
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write audit head: %v", err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write audit head: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write audit head: %v", err)
+	}
+	if err := os.Rename(tmp, s.headPath()); err != nil {
+		return fmt.Errorf("failed to write audit head: %v", err)
+	}
+	return nil
+}
+
+// repairTornLine truncates an audit file to its complete lines
+func repairTornLine(path string, size int64) error {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read audit file: %v", err)
+	}
+	if info.Size() == size {
+		return nil
+	}
+
+	log.Printf("Discarding a torn final line of %d bytes from audit file %s", info.Size()-size, path)
+	if err := os.Truncate(path, size); err != nil {
+		return fmt.Errorf("failed to repair audit file: %v", err)
+	}
+	return nil
+}
+
+// readRecords reads every record of an audit file and the size of its complete lines.
+// A final line without a newline is a torn write and is left out.
+func readRecords(path string) ([]record, int64, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read audit file: %v", err)
+	}
+	defer file.Close()
+
+	var records []record
+	var size int64
+	reader := bufio.NewReader(file)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return records, size, nil
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to read audit file: %v", err)
+		}
+
+		var rec record
+		if err := json.Unmarshal(bytes.TrimSuffix(data, []byte("\n")), &rec); err != nil {
+			return nil, 0, fmt.Errorf("audit file corrupted at line %d: %v", line, err)
+		}
+		records = append(records, rec)
+		size += int64(len(data))
+	}
+}
+
+// chainHash links an event to the hash of the event before it
+func chainHash(prevHash string, event Event) (string, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append([]byte(prevHash), payload...))
+	return hex.EncodeToString(sum[:]), nil
+}
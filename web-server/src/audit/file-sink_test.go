@@ -0,0 +1,241 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestSink opens a sink in a temporary directory and writes events to it
+func newTestSink(t *testing.T, key []byte, events int) (*FileSink, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path, key)
+	if err != nil {
+		t.Fatalf("failed to create sink: %v", err)
+	}
+	t.Cleanup(func() { sink.Close() })
+
+	for i := 0; i < events; i++ {
+		event := Event{
+			Time:     time.Date(2025, 6, 1, 12, i, 0, 0, time.UTC),
+			Actor:    "user:1",
+			Action:   "patient.read",
+			Resource: "patient:user:1",
+			Outcome:  OutcomeSuccess,
+		}
+		if err := sink.Write(context.Background(), event); err != nil {
+			t.Fatalf("failed to write event %d: %v", i+1, err)
+		}
+	}
+	return sink, path
+}
+
+// readLines returns the lines of the audit file, without their newlines
+func readLines(t *testing.T, path string) [][]byte {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read audit file: %v", err)
+	}
+	return bytes.Split(bytes.TrimSuffix(data, []byte("\n")), []byte("\n"))
+}
+
+// writeLines replaces the audit file with the given lines
+func writeLines(t *testing.T, path string, lines [][]byte) {
+	t.Helper()
+
+	data := append(bytes.Join(lines, []byte("\n")), '\n')
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write audit file: %v", err)
+	}
+}
+
+func TestFileSinkVerifiesAndReopensChain(t *testing.T) {
+	sink, path := newTestSink(t, nil, 3)
+
+	count, err := sink.Verify()
+	if err != nil || count != 3 {
+		t.Fatalf("Verify() = %d, %v, want 3 intact events", count, err)
+	}
+	sink.Close()
+
+	reopened, err := NewFileSink(path, nil)
+	if err != nil {
+		t.Fatalf("failed to reopen sink: %v", err)
+	}
+	defer reopened.Close()
+	if err := reopened.Write(context.Background(), Event{Actor: "user:1", Action: "auth.login"}); err != nil {
+		t.Fatalf("failed to append after reopening: %v", err)
+	}
+	if count, err := reopened.Verify(); err != nil || count != 4 {
+		t.Fatalf("Verify() after reopening = %d, %v, want 4 intact events", count, err)
+	}
+}
+
+func TestFileSinkDetectsModifiedEvent(t *testing.T) {
+	sink, path := newTestSink(t, nil, 3)
+
+	lines := readLines(t, path)
+	lines[1] = bytes.Replace(lines[1], []byte(`"actor":"user:1"`), []byte(`"actor":"user:2"`), 1)
+	writeLines(t, path, lines)
+
+	if _, err := sink.Verify(); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("Verify() error = %v, want a broken chain at line 2", err)
+	}
+	if _, err := NewFileSink(path, nil); err == nil {
+		t.Fatal("NewFileSink() accepted a modified chain")
+	}
+}
+
+func TestFileSinkDetectsRemovedEvent(t *testing.T) {
+	sink, path := newTestSink(t, nil, 3)
+
+	lines := readLines(t, path)
+	writeLines(t, path, [][]byte{lines[0], lines[2]})
+
+	if _, err := sink.Verify(); err == nil {
+		t.Fatal("Verify() accepted a chain with a removed event")
+	}
+}
+
+func TestFileSinkDetectsTruncation(t *testing.T) {
+	sink, path := newTestSink(t, nil, 3)
+
+	lines := readLines(t, path)
+	writeLines(t, path, lines[:1])
+
+	if _, err := sink.Verify(); err == nil || !strings.Contains(err.Error(), "truncated") {
+		t.Fatalf("Verify() error = %v, want a truncated chain", err)
+	}
+	sink.Close()
+	if _, err := NewFileSink(path, nil); err == nil {
+		t.Fatal("NewFileSink() accepted a truncated chain")
+	}
+}
+
+func TestFileSinkDetectsRemovedHead(t *testing.T) {
+	sink, path := newTestSink(t, nil, 2)
+
+	if err := os.Remove(path + ".head"); err != nil {
+		t.Fatalf("failed to remove head: %v", err)
+	}
+	if _, err := sink.Verify(); err == nil {
+		t.Fatal("Verify() accepted a chain without its head")
+	}
+}
+
+func TestFileSinkDetectsReanchoredTruncation(t *testing.T) {
+	key := []byte("audit-key")
+	sink, path := newTestSink(t, key, 3)
+	sink.Close()
+
+	// Truncate the chain and rewrite the head as an unkeyed sink would
+	lines := readLines(t, path)
+	writeLines(t, path, lines[:2])
+	os.Remove(path + ".head")
+	forged := &FileSink{path: path}
+	if err := forged.writeHead(2, mustRecordHash(t, lines[1])); err != nil {
+		t.Fatalf("failed to forge head: %v", err)
+	}
+
+	if _, err := NewFileSink(path, key); err == nil || !strings.Contains(err.Error(), "not authentic") {
+		t.Fatalf("NewFileSink() error = %v, want an unauthentic head", err)
+	}
+}
+
+func TestFileSinkRepairsTornLine(t *testing.T) {
+	sink, path := newTestSink(t, nil, 2)
+	sink.Close()
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatalf("failed to open audit file: %v", err)
+	}
+	file.WriteString(`{"event":{"id":"file-3","ti`)
+	file.Close()
+
+	repaired, err := NewFileSink(path, nil)
+	if err != nil {
+		t.Fatalf("NewFileSink() with a torn final line: %v", err)
+	}
+	defer repaired.Close()
+	if err := repaired.Write(context.Background(), Event{Actor: "user:1", Action: "auth.login"}); err != nil {
+		t.Fatalf("failed to append after the repair: %v", err)
+	}
+	if count, err := repaired.Verify(); err != nil || count != 3 {
+		t.Fatalf("Verify() after the repair = %d, %v, want 3 intact events", count, err)
+	}
+}
+
+func TestFileSinkRollsBackFailedWrite(t *testing.T) {
+	sink, path := newTestSink(t, nil, 2)
+	before, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read audit file: %v", err)
+	}
+
+	// A directory in the way of the temporary head makes the head write fail after the line was appended
+	if err := os.Mkdir(path+".head.tmp", 0o700); err != nil {
+		t.Fatalf("failed to block the head: %v", err)
+	}
+	if err := sink.Write(context.Background(), Event{Actor: "user:1", Action: "auth.login"}); err == nil {
+		t.Fatal("Write() succeeded without writing the head")
+	}
+	if after, _ := os.ReadFile(path); !bytes.Equal(after, before) {
+		t.Fatalf("audit file after a failed write = %q, want the line removed", after)
+	}
+
+	// The next event chains onto the last one that was written
+	os.Remove(path + ".head.tmp")
+	if err := sink.Write(context.Background(), Event{Actor: "user:1", Action: "auth.logout"}); err != nil {
+		t.Fatalf("failed to write after a failed write: %v", err)
+	}
+	if count, err := sink.Verify(); err != nil || count != 3 {
+		t.Fatalf("Verify() = %d, %v, want 3 intact events", count, err)
+	}
+}
+
+func TestFileSinkAcceptsHeadOneEventBehind(t *testing.T) {
+	sink, path := newTestSink(t, nil, 1)
+	head, err := os.ReadFile(path + ".head")
+	if err != nil {
+		t.Fatalf("failed to read head: %v", err)
+	}
+	if err := sink.Write(context.Background(), Event{Actor: "user:1", Action: "auth.login"}); err != nil {
+		t.Fatalf("failed to write event: %v", err)
+	}
+	sink.Close()
+
+	// A crash after appending the line but before updating the head
+	if err := os.WriteFile(path+".head", head, 0o600); err != nil {
+		t.Fatalf("failed to restore head: %v", err)
+	}
+
+	reopened, err := NewFileSink(path, nil)
+	if err != nil {
+		t.Fatalf("NewFileSink() with a lagging head: %v", err)
+	}
+	defer reopened.Close()
+	if count, err := reopened.Verify(); err != nil || count != 2 {
+		t.Fatalf("Verify() = %d, %v, want 2 intact events", count, err)
+	}
+}
+
+// mustRecordHash returns the hash stored in an audit line
+func mustRecordHash(t *testing.T, line []byte) string {
+	t.Helper()
+
+	var rec record
+	if err := json.Unmarshal(line, &rec); err != nil {
+		t.Fatalf("failed to parse audit line: %v", err)
+	}
+	return rec.Hash
+}
@@ -0,0 +1,75 @@
+package budget
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"unicode/utf8"
+
+	"unb.br/web-server/src/grpc"
+)
+
+const (
+	// charsPerToken is the average length of a token, close enough for English and Portuguese text
+	charsPerToken = 4
+	// messageOverhead accounts for the role and separators the model adds around each message
+	messageOverhead = 4
+)
+
+// EstimateTokens estimates how many tokens a text takes, rounding up
+func EstimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + charsPerToken - 1) / charsPerToken
+}
+
+// EstimateMessage estimates how many tokens a message takes, including its overhead
+func EstimateMessage(message grpc.Message) int {
+	return EstimateTokens(message.Content) + messageOverhead
+}
+
+// EstimateContext estimates how many tokens the system context of a diagnosis takes:
+// the patient info, the vitals and the conversation summary
+func EstimateContext(input grpc.DiagnoseInput) int {
+	context, err := json.Marshal(struct {
+		PatientInfo  grpc.PatientInfo
+		RecentVitals []grpc.VitalSigns
+		VitalTrends  []grpc.VitalTrend
+	}{input.PatientInfo, input.RecentVitals, input.VitalTrends})
+	if err != nil {
+		// Plain structs always marshal, count nothing rather than fail the diagnosis
+		return 0
+	}
+	return EstimateTokens(string(context)) + EstimateTokens(input.ConversationSummary)
+}
+
+// Split returns the index of the first message to send, so that the system context and the messages
+// from there on fit within limit tokens. The latest message is always kept, even when it does not fit.
+func Split(input grpc.DiagnoseInput, limit int) int {
+	used := EstimateContext(input)
+	start := len(input.Messages)
+	for start > 0 {
+		used += EstimateMessage(input.Messages[start-1])
+		if used > limit && start < len(input.Messages) {
+			break
+		}
+		start--
+	}
+	return start
+}
+
+// Hash identifies a list of messages, so a summary can tell whether it still covers them
+func Hash(messages []grpc.Message) string {
+	hash := sha256.New()
+	for _, message := range messages {
+		// Lengths keep the boundaries between fields unambiguous
+		var length [8]byte
+		for _, field := range []string{message.Role, message.Content} {
+			n := uint64(len(field))
+			for i := range length {
+				length[i] = byte(n >> (8 * i))
+			}
+			hash.Write(length[:])
+			hash.Write([]byte(field))
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
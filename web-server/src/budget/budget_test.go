@@ -0,0 +1,104 @@
+package budget
+
+import (
+	"strings"
+	"testing"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// messages creates messages of the given lengths, alternating between the user and the assistant
+func messages(lengths ...int) []grpc.Message {
+	list := make([]grpc.Message, len(lengths))
+	for i, length := range lengths {
+		role := "user"
+		if i%2 == 1 {
+			role = "assistant"
+		}
+		list[i] = grpc.Message{Role: role, Content: strings.Repeat("a", length)}
+	}
+	return list
+}
+
+func TestEstimateTokens(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		{"abc", 1},
+		{"abcd", 1},
+		{"abcde", 2},
+		// Runes are counted, not bytes
+		{"ção!", 1},
+	}
+
+	for _, tt := range tests {
+		if got := EstimateTokens(tt.text); got != tt.want {
+			t.Errorf("EstimateTokens(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestEstimateContext(t *testing.T) {
+	input := grpc.DiagnoseInput{PatientInfo: grpc.PatientInfo{Name: "Alice"}}
+	base := EstimateContext(input)
+	if base <= 0 {
+		t.Fatalf("EstimateContext() = %d, want the patient info counted", base)
+	}
+
+	input.ConversationSummary = strings.Repeat("a", 40)
+	if got := EstimateContext(input); got != base+10 {
+		t.Errorf("EstimateContext() with a summary = %d, want %d", got, base+10)
+	}
+
+	// The messages are not part of the context
+	input.Messages = messages(400)
+	if got := EstimateContext(input); got != base+10 {
+		t.Errorf("EstimateContext() with messages = %d, want %d", got, base+10)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	context := EstimateContext(grpc.DiagnoseInput{})
+	// Each message of 40 characters takes 10 tokens plus the overhead
+	message := 10 + messageOverhead
+
+	tests := []struct {
+		name     string
+		messages []grpc.Message
+		limit    int
+		want     int
+	}{
+		{"no messages", nil, context, 0},
+		{"everything fits", messages(40, 40, 40), context + 3*message, 0},
+		{"older messages left out", messages(40, 40, 40), context + 2*message, 1},
+		{"only the latest fits", messages(40, 40, 40), context + message, 2},
+		{"latest kept over the limit", messages(40, 40, 400), context + message, 2},
+		{"context alone over the limit", messages(40, 40), 0, 1},
+	}
+
+	for _, tt := range tests {
+		if got := Split(grpc.DiagnoseInput{Messages: tt.messages}, tt.limit); got != tt.want {
+			t.Errorf("%s: Split() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	conversation := messages(10, 20, 30)
+
+	if Hash(conversation) != Hash(messages(10, 20, 30)) {
+		t.Error("Hash() differs for equal messages")
+	}
+	if Hash(conversation[:2]) == Hash(conversation) {
+		t.Error("Hash() of a prefix equals the hash of the whole conversation")
+	}
+
+	// Moving text between the role and the content changes the hash
+	a := []grpc.Message{{Role: "user", Content: "ab"}}
+	b := []grpc.Message{{Role: "usera", Content: "b"}}
+	if Hash(a) == Hash(b) {
+		t.Error("Hash() ignores the boundary between the role and the content")
+	}
+}
@@ -0,0 +1,136 @@
+// Command keytool manages the keyfile used to encrypt patient data before it is sent to the database server.
+//
+// Usage:
+//
+//	keytool generate -keyfile keys.json        add a new key and make it the active one
+//	keytool rewrap -keyfile keys.json < in > out  rewrap every encrypted value in a text stream with the active key
+//	keytool retire -keyfile keys.json -key ID   remove a key that no longer wraps any value
+//	keytool status -keyfile keys.json          list the keys and the active one
+//
+// Rotating keys means generating a new key, restarting the web server, rewrapping a dump of the
+// database (for example from `sqlite3 db .dump`), loading it back and finally retiring the old key.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"unb.br/web-server/src/encryption"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	keyfile := flags.String("keyfile", os.Getenv("ENCRYPTION_KEYFILE"), "path to the keyfile")
+	keyID := flags.String("key", "", "ID of the key to retire")
+	flags.Parse(os.Args[2:])
+
+	if *keyfile == "" {
+		fail("a keyfile is required, use -keyfile or ENCRYPTION_KEYFILE")
+	}
+
+	switch command {
+	case "generate":
+		id, err := encryption.GenerateKey(*keyfile)
+		if err != nil {
+			fail(err.Error())
+		}
+		fmt.Printf("Generated key %s, now active. Restart the web server to use it.\n", id)
+
+	case "rewrap":
+		keyring, err := encryption.LoadKeyring(*keyfile)
+		if err != nil {
+			fail(err.Error())
+		}
+		count, err := rewrap(keyring, os.Stdin, os.Stdout)
+		if err != nil {
+			fail(err.Error())
+		}
+		fmt.Fprintf(os.Stderr, "Rewrapped %d values with key %s\n", count, keyring.ActiveKeyID())
+
+	case "retire":
+		if *keyID == "" {
+			fail("the key to retire is required, use -key")
+		}
+		if err := encryption.RetireKey(*keyfile, *keyID); err != nil {
+			fail(err.Error())
+		}
+		fmt.Printf("Retired key %s\n", *keyID)
+
+	case "status":
+		keyring, err := encryption.LoadKeyring(*keyfile)
+		if err != nil {
+			fail(err.Error())
+		}
+		for _, id := range keyring.KeyIDs() {
+			marker := ""
+			if id == keyring.ActiveKeyID() {
+				marker = " (active)"
+			}
+			fmt.Printf("%s%s\n", id, marker)
+		}
+
+	default:
+		usage()
+	}
+}
+
+// rewrap copies a text stream, rewrapping the data key of every encrypted value found in it.
+// It fails on the first value that cannot be unwrapped, so nothing is silently left behind.
+func rewrap(keyring *encryption.Keyring, in io.Reader, out io.Writer) (int, error) {
+	reader := bufio.NewReader(in)
+	writer := bufio.NewWriter(out)
+	defer writer.Flush()
+
+	count := 0
+	for {
+		line, readErr := reader.ReadString('\n')
+
+		var rewrapErr error
+		line = encryption.EnvelopePattern.ReplaceAllStringFunc(line, func(value string) string {
+			rewrapped, err := keyring.Rewrap(value)
+			if err != nil {
+				if rewrapErr == nil {
+					rewrapErr = err
+				}
+				return value
+			}
+			if rewrapped != value {
+				count++
+			}
+			return rewrapped
+		})
+		if rewrapErr != nil {
+			return count, rewrapErr
+		}
+
+		if _, err := writer.WriteString(line); err != nil {
+			return count, err
+		}
+		if readErr == io.EOF {
+			return count, nil
+		}
+		if readErr != nil {
+			return count, readErr
+		}
+	}
+}
+
+// usage prints the available commands and exits
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: keytool generate|rewrap|retire|status -keyfile PATH [-key ID]")
+	os.Exit(2)
+}
+
+// fail prints an error and exits
+func fail(message string) {
+	fmt.Fprintln(os.Stderr, "keytool: "+message)
+	os.Exit(1)
+}
@@ -0,0 +1,82 @@
+// Command mock-ai-server serves a scripted AI service, to run the web server without the Python AI server
+// and an OpenAI key.
+//
+// Usage:
+//
+//	mock-ai-server -addr :50051                                  canned markdown, 16 characters per chunk
+//	mock-ai-server -script mode=echo,chunk=4,delay=50ms           echo the last message slowly
+//	mock-ai-server -script fail-after=3                           fail every reply after 3 chunks
+//	mock-ai-server -script hang-after=0                           hang every reply until stopped
+//	mock-ai-server -reply reply.md                                use another canned markdown
+//
+// The script keys are mode, chunk, delay, fail-after, hang-after, summary, model and moderation.
+// A chat message can override them for its own reply with a directive such as "[mock fail-after=2]".
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"google.golang.org/grpc"
+	"unb.br/web-server/src/mockai"
+	pb "unb.br/web-server/src/proto"
+)
+
+func main() {
+	addr := flag.String("addr", envOr("MOCK_AI_ADDR", ":50051"), "address to listen on")
+	scriptValue := flag.String("script", os.Getenv("MOCK_AI_SCRIPT"), "script overrides, as key=value,key=value")
+	replyFile := flag.String("reply", os.Getenv("MOCK_AI_REPLY"), "markdown file of the canned reply")
+	flag.Parse()
+
+	script := mockai.DefaultScript()
+	if *replyFile != "" {
+		reply, err := os.ReadFile(*replyFile)
+		if err != nil {
+			fail(err.Error())
+		}
+		script.Reply = string(reply)
+	}
+	script, err := mockai.ParseScript(script, *scriptValue)
+	if err != nil {
+		fail(err.Error())
+	}
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fail(err.Error())
+	}
+	server := grpc.NewServer()
+	pb.RegisterAiServiceServer(server, mockai.NewServer(script))
+
+	// Stop on interrupt, ending the streams in progress
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+		<-quit
+		server.Stop()
+	}()
+
+	log.Printf("Mock AI server listening on %s (mode %s, %d characters per chunk, delay %s)", listener.Addr(), script.Mode, script.ChunkSize, script.Delay)
+	if err := server.Serve(listener); err != nil {
+		log.Fatalf("Mock AI server failed: %v", err)
+	}
+}
+
+// envOr returns an environment variable, or fallback when it is not set
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// fail prints an error and exits
+func fail(message string) {
+	fmt.Fprintln(os.Stderr, message)
+	os.Exit(1)
+}
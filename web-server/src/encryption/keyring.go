@@ -0,0 +1,327 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// envelopePrefix marks values encrypted by a Keyring; anything else is treated as plaintext
+const envelopePrefix = "enc:v1:"
+
+// keySize is the size of key-encryption keys and data keys, selecting AES-256
+const keySize = 32
+
+// keyIDPattern restricts key IDs to characters that cannot clash with the envelope separators
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// EnvelopePattern matches encrypted values inside arbitrary text, such as a database dump
+var EnvelopePattern = regexp.MustCompile(`enc:v1:[A-Za-z0-9_-]+:[A-Za-z0-9_-]+:[A-Za-z0-9_-]+`)
+
+// keyfile is the on-disk format of a keyring
+type keyfile struct {
+	// Active is the ID of the key used to wrap new data keys
+	Active string `json:"active"`
+	// Keys maps key IDs to base64-encoded 256-bit key-encryption keys
+	Keys map[string]string `json:"keys"`
+}
+
+// Keyring encrypts values with envelope encryption.
+// Every value gets a fresh AES-256-GCM data key, which is itself wrapped by the active key-encryption key.
+// Retired keys stay in the keyring so existing values can still be decrypted.
+type Keyring struct {
+	active string
+	keys   map[string]cipher.AEAD
+}
+
+// LoadKeyring reads a keyring from a keyfile
+func LoadKeyring(path string) (*Keyring, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyfile: %v", err)
+	}
+
+	var file keyfile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse keyfile: %v", err)
+	}
+
+	keyring := &Keyring{
+		active: file.Active,
+		keys:   make(map[string]cipher.AEAD, len(file.Keys)),
+	}
+	for id, encoded := range file.Keys {
+		if !keyIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("invalid key ID %q", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != keySize {
+			return nil, fmt.Errorf("key %q must be %d base64-encoded bytes", id, keySize)
+		}
+		if keyring.keys[id], err = newAEAD(key); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := keyring.keys[keyring.active]; !ok {
+		return nil, fmt.Errorf("active key %q is not in the keyfile", keyring.active)
+	}
+	return keyring, nil
+}
+
+// GenerateKey adds a new random key-encryption key to a keyfile and makes it the active one.
+// The keyfile is created when it does not exist. It returns the ID of the new key.
+func GenerateKey(path string) (string, error) {
+	file, err := readKeyfile(path)
+	if err != nil {
+		return "", err
+	}
+
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	id := "k" + time.Now().UTC().Format("20060102150405")
+	if _, exists := file.Keys[id]; exists {
+		return "", fmt.Errorf("key %q already exists, try again in a second", id)
+	}
+	file.Keys[id] = base64.StdEncoding.EncodeToString(key)
+	file.Active = id
+
+	return id, writeKeyfile(path, file)
+}
+
+// RetireKey removes a key from a keyfile. Values still wrapped by it can no longer be decrypted,
+// so it should only be retired after everything has been rewrapped with the active key.
+func RetireKey(path, id string) error {
+	file, err := readKeyfile(path)
+	if err != nil {
+		return err
+	}
+	if _, ok := file.Keys[id]; !ok {
+		return fmt.Errorf("key %q is not in the keyfile", id)
+	}
+	if file.Active == id {
+		return fmt.Errorf("key %q is active, generate a new key first", id)
+	}
+
+	delete(file.Keys, id)
+	return writeKeyfile(path, file)
+}
+
+// ActiveKeyID returns the ID of the key that wraps new data keys
+func (k *Keyring) ActiveKeyID() string {
+	return k.active
+}
+
+// KeyIDs returns the IDs of all keys in the keyring, sorted
+func (k *Keyring) KeyIDs() []string {
+	ids := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Encrypt encrypts a value under a fresh data key. The context, such as the field name,
+// is authenticated so a ciphertext cannot be moved to another field. Empty values are kept empty.
+func (k *Keyring) Encrypt(plaintext, context string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := seal(dataAEAD, []byte(plaintext), []byte(context))
+	if err != nil {
+		return "", err
+	}
+	wrappedKey, err := seal(k.keys[k.active], dataKey, wrapContext(k.active))
+	if err != nil {
+		return "", err
+	}
+
+	return formatEnvelope(k.active, wrappedKey, ciphertext), nil
+}
+
+// Decrypt decrypts a value produced by Encrypt with the same context.
+// Values that are not encrypted are returned unchanged, so plaintext written before encryption was enabled stays readable.
+func (k *Keyring) Decrypt(value, context string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	id, wrappedKey, ciphertext, err := parseEnvelope(value)
+	if err != nil {
+		return "", err
+	}
+	dataKey, err := k.unwrap(id, wrappedKey)
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(dataAEAD, ciphertext, []byte(context))
+	if err != nil {
+		return "", errors.New("failed to decrypt value: wrong context or corrupted data")
+	}
+	return string(plaintext), nil
+}
+
+// Rewrap wraps the data key of an encrypted value with the active key, leaving the encrypted data untouched.
+// Values already wrapped by the active key and plaintext values are returned unchanged.
+func (k *Keyring) Rewrap(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	id, wrappedKey, ciphertext, err := parseEnvelope(value)
+	if err != nil {
+		return "", err
+	}
+	if id == k.active {
+		return value, nil
+	}
+
+	dataKey, err := k.unwrap(id, wrappedKey)
+	if err != nil {
+		return "", err
+	}
+	rewrapped, err := seal(k.keys[k.active], dataKey, wrapContext(k.active))
+	if err != nil {
+		return "", err
+	}
+	return formatEnvelope(k.active, rewrapped, ciphertext), nil
+}
+
+// IsEncrypted reports whether a value was produced by a Keyring
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, envelopePrefix)
+}
+
+// unwrap decrypts a data key with the key-encryption key that wrapped it
+func (k *Keyring) unwrap(id string, wrappedKey []byte) ([]byte, error) {
+	kek, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("key %q is not in the keyring", id)
+	}
+	dataKey, err := open(kek, wrappedKey, wrapContext(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with key %q", id)
+	}
+	return dataKey, nil
+}
+
+// wrapContext binds a wrapped data key to the ID of the key that wrapped it
+func wrapContext(id string) []byte {
+	return []byte("dek:" + id)
+}
+
+// formatEnvelope encodes an encrypted value as enc:v1:<key id>:<wrapped data key>:<nonce and ciphertext>
+func formatEnvelope(id string, wrappedKey, ciphertext []byte) string {
+	return envelopePrefix + id + ":" +
+		base64.RawURLEncoding.EncodeToString(wrappedKey) + ":" +
+		base64.RawURLEncoding.EncodeToString(ciphertext)
+}
+
+// parseEnvelope decodes an encrypted value
+func parseEnvelope(value string) (string, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, envelopePrefix), ":")
+	if len(parts) != 3 {
+		return "", nil, nil, errors.New("malformed encrypted value")
+	}
+	wrappedKey, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, errors.New("malformed wrapped data key")
+	}
+	ciphertext, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, errors.New("malformed ciphertext")
+	}
+	return parts[0], wrappedKey, ciphertext, nil
+}
+
+// newAEAD creates an AES-GCM cipher
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts data with a random nonce, which is prepended to the result
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts data produced by seal
+func open(aead cipher.AEAD, data, additionalData []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+// readKeyfile reads a keyfile, returning an empty one when it does not exist
+func readKeyfile(path string) (keyfile, error) {
+	file := keyfile{Keys: map[string]string{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		return file, fmt.Errorf("failed to read keyfile: %v", err)
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return file, fmt.Errorf("failed to parse keyfile: %v", err)
+	}
+	if file.Keys == nil {
+		file.Keys = map[string]string{}
+	}
+	return file, nil
+}
+
+// writeKeyfile replaces a keyfile atomically, readable by the owner only
+func writeKeyfile(path string, file keyfile) error {
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write keyfile: %v", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to write keyfile: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestKeyfile writes a keyfile with random keys and returns its path
+func writeTestKeyfile(t *testing.T, active string, ids ...string) string {
+	t.Helper()
+
+	file := keyfile{Active: active, Keys: map[string]string{}}
+	for _, id := range ids {
+		key := make([]byte, keySize)
+		rand.Read(key)
+		file.Keys[id] = base64.StdEncoding.EncodeToString(key)
+	}
+
+	path := filepath.Join(t.TempDir(), "keys.json")
+	if err := writeKeyfile(path, file); err != nil {
+		t.Fatalf("failed to write keyfile: %v", err)
+	}
+	return path
+}
+
+// loadTestKeyring loads a keyring, failing the test on error
+func loadTestKeyring(t *testing.T, path string) *Keyring {
+	t.Helper()
+
+	keyring, err := LoadKeyring(path)
+	if err != nil {
+		t.Fatalf("LoadKeyring() = %v", err)
+	}
+	return keyring
+}
+
+// setActive makes another key of a keyfile the active one
+func setActive(t *testing.T, path, id string) {
+	t.Helper()
+
+	file, err := readKeyfile(path)
+	if err != nil {
+		t.Fatalf("failed to read keyfile: %v", err)
+	}
+	file.Active = id
+	if err := writeKeyfile(path, file); err != nil {
+		t.Fatalf("failed to write keyfile: %v", err)
+	}
+}
+
+func TestKeyringEncryptDecrypt(t *testing.T) {
+	keyring := loadTestKeyring(t, writeTestKeyfile(t, "k1", "k1"))
+
+	encrypted, err := keyring.Encrypt("penicilina", "patient_info.allergies.substance")
+	if err != nil {
+		t.Fatalf("Encrypt() = %v", err)
+	}
+	if !IsEncrypted(encrypted) || strings.Contains(encrypted, "penicilina") || !EnvelopePattern.MatchString(encrypted) {
+		t.Fatalf("Encrypt() = %q, want an envelope without the plaintext", encrypted)
+	}
+	if again, _ := keyring.Encrypt("penicilina", "patient_info.allergies.substance"); again == encrypted {
+		t.Errorf("Encrypt() returned the same envelope twice, want a fresh data key and nonce")
+	}
+
+	decrypted, err := keyring.Decrypt(encrypted, "patient_info.allergies.substance")
+	if err != nil || decrypted != "penicilina" {
+		t.Fatalf("Decrypt() = %q, %v, want penicilina", decrypted, err)
+	}
+}
+
+func TestKeyringRejectsWrongContextAndTampering(t *testing.T) {
+	keyring := loadTestKeyring(t, writeTestKeyfile(t, "k1", "k1"))
+	encrypted, _ := keyring.Encrypt("Maria", "patient_info.name")
+
+	if _, err := keyring.Decrypt(encrypted, "chat_message.content"); err == nil {
+		t.Error("Decrypt() accepted a value moved to another field")
+	}
+
+	// Change a character in the middle of the ciphertext, where every bit is significant
+	i := strings.LastIndex(encrypted, ":") + 5
+	replacement := "A"
+	if encrypted[i] == 'A' {
+		replacement = "B"
+	}
+	tampered := encrypted[:i] + replacement + encrypted[i+1:]
+	if _, err := keyring.Decrypt(tampered, "patient_info.name"); err == nil {
+		t.Error("Decrypt() accepted a tampered ciphertext")
+	}
+
+	other := loadTestKeyring(t, writeTestKeyfile(t, "k1", "k1"))
+	if _, err := other.Decrypt(encrypted, "patient_info.name"); err == nil {
+		t.Error("Decrypt() accepted a value wrapped by a different key with the same ID")
+	}
+
+	if _, err := keyring.Decrypt("enc:v1:k1:abc", "patient_info.name"); err == nil {
+		t.Error("Decrypt() accepted a malformed envelope")
+	}
+}
+
+func TestKeyringPassesPlaintextThrough(t *testing.T) {
+	keyring := loadTestKeyring(t, writeTestKeyfile(t, "k1", "k1"))
+
+	if encrypted, err := keyring.Encrypt("", "patient_info.name"); err != nil || encrypted != "" {
+		t.Errorf("Encrypt(\"\") = %q, %v, want an empty value", encrypted, err)
+	}
+	if decrypted, err := keyring.Decrypt("written before encryption", "patient_info.name"); err != nil || decrypted != "written before encryption" {
+		t.Errorf("Decrypt() of plaintext = %q, %v, want it unchanged", decrypted, err)
+	}
+	if rewrapped, err := keyring.Rewrap("plain"); err != nil || rewrapped != "plain" {
+		t.Errorf("Rewrap() of plaintext = %q, %v, want it unchanged", rewrapped, err)
+	}
+}
+
+func TestKeyringRewrapAndRetire(t *testing.T) {
+	path := writeTestKeyfile(t, "k1", "k1", "k2")
+	old := loadTestKeyring(t, path)
+	encrypted, _ := old.Encrypt("asma", "patient_info.conditions.name")
+
+	// Rotate to k2: the old value stays readable and rewrapping moves it to k2
+	setActive(t, path, "k2")
+	keyring := loadTestKeyring(t, path)
+	if decrypted, err := keyring.Decrypt(encrypted, "patient_info.conditions.name"); err != nil || decrypted != "asma" {
+		t.Fatalf("Decrypt() with a rotated key = %q, %v, want asma", decrypted, err)
+	}
+
+	rewrapped, err := keyring.Rewrap(encrypted)
+	if err != nil {
+		t.Fatalf("Rewrap() = %v", err)
+	}
+	if !strings.HasPrefix(rewrapped, envelopePrefix+"k2:") {
+		t.Fatalf("Rewrap() = %q, want it wrapped by k2", rewrapped)
+	}
+	if again, err := keyring.Rewrap(rewrapped); err != nil || again != rewrapped {
+		t.Errorf("Rewrap() of a value wrapped by the active key = %q, %v, want it unchanged", again, err)
+	}
+
+	// The data itself is untouched, only the wrapped data key changes
+	if encrypted[strings.LastIndex(encrypted, ":"):] != rewrapped[strings.LastIndex(rewrapped, ":"):] {
+		t.Error("Rewrap() changed the encrypted data")
+	}
+
+	// Retiring k1 keeps rewrapped values readable and makes the others unreadable
+	if err := RetireKey(path, "k2"); err == nil {
+		t.Error("RetireKey() retired the active key")
+	}
+	if err := RetireKey(path, "k1"); err != nil {
+		t.Fatalf("RetireKey() = %v", err)
+	}
+	retired := loadTestKeyring(t, path)
+	if ids := retired.KeyIDs(); len(ids) != 1 || ids[0] != "k2" {
+		t.Fatalf("KeyIDs() after retiring k1 = %v, want [k2]", ids)
+	}
+	if decrypted, err := retired.Decrypt(rewrapped, "patient_info.conditions.name"); err != nil || decrypted != "asma" {
+		t.Errorf("Decrypt() of a rewrapped value = %q, %v, want asma", decrypted, err)
+	}
+	if _, err := retired.Decrypt(encrypted, "patient_info.conditions.name"); err == nil || !strings.Contains(err.Error(), `"k1"`) {
+		t.Errorf("Decrypt() of a value wrapped by a retired key = %v, want a missing key error", err)
+	}
+	if _, err := retired.Rewrap(encrypted); err == nil {
+		t.Error("Rewrap() unwrapped a value with a retired key")
+	}
+}
+
+func TestLoadKeyringRejectsInvalidKeyfiles(t *testing.T) {
+	tests := []struct {
+		name string
+		file keyfile
+	}{
+		{"missing active key", keyfile{Active: "k2", Keys: map[string]string{"k1": base64.StdEncoding.EncodeToString(make([]byte, keySize))}}},
+		{"short key", keyfile{Active: "k1", Keys: map[string]string{"k1": base64.StdEncoding.EncodeToString(make([]byte, 16))}}},
+		{"invalid key ID", keyfile{Active: "k:1", Keys: map[string]string{"k:1": base64.StdEncoding.EncodeToString(make([]byte, keySize))}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keys.json")
+			if err := writeKeyfile(path, tt.file); err != nil {
+				t.Fatalf("failed to write keyfile: %v", err)
+			}
+			if _, err := LoadKeyring(path); err == nil {
+				t.Fatal("LoadKeyring() accepted an invalid keyfile")
+			}
+		})
+	}
+}
+
+func TestGenerateKeyCreatesActiveKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+
+	id, err := GenerateKey(path)
+	if err != nil {
+		t.Fatalf("GenerateKey() = %v", err)
+	}
+	keyring := loadTestKeyring(t, path)
+	if keyring.ActiveKeyID() != id {
+		t.Errorf("ActiveKeyID() = %s, want the generated key %s", keyring.ActiveKeyID(), id)
+	}
+}
@@ -0,0 +1,104 @@
+package fhir
+
+import (
+	"strconv"
+	"strings"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// severityCodes maps the severities patients type in to FHIR reaction severities
+var severityCodes = map[string]string{
+	"mild":     "mild",
+	"leve":     "mild",
+	"moderate": "moderate",
+	"moderada": "moderate",
+	"moderado": "moderate",
+	"severe":   "severe",
+	"severa":   "severe",
+	"severo":   "severe",
+	"grave":    "severe",
+}
+
+// NewAllergyIntolerance maps a patient allergy to an AllergyIntolerance resource.
+// Severities that are not FHIR codes are kept as a note so no information is lost.
+func NewAllergyIntolerance(id, patientID string, allergy grpc.Allergy) AllergyIntolerance {
+	resource := AllergyIntolerance{
+		ResourceType: "AllergyIntolerance",
+		ID:           id,
+		ClinicalStatus: &CodeableConcept{
+			Coding: []Coding{{System: systemAllergyClinical, Code: "active", Display: "Active"}},
+		},
+		VerificationStatus: &CodeableConcept{
+			Coding: []Coding{{System: systemAllergyVerification, Code: "unconfirmed", Display: "Unconfirmed"}},
+		},
+		Code:    &CodeableConcept{Text: allergy.Substance},
+		Patient: PatientReference(patientID),
+	}
+
+	// A reaction needs a manifestation, so a severity without one is kept as a note instead
+	severity, known := severityCodes[strings.ToLower(strings.TrimSpace(allergy.Severity))]
+	if allergy.Reaction != "" {
+		reaction := AllergyReaction{Manifestation: []CodeableConcept{{Text: allergy.Reaction}}}
+		if known {
+			reaction.Severity = severity
+		}
+		resource.Reaction = []AllergyReaction{reaction}
+	}
+	if allergy.Severity != "" && (!known || allergy.Reaction == "") {
+		resource.Note = []Annotation{{Text: "Severity: " + allergy.Severity}}
+	}
+
+	return resource
+}
+
+// ParseAllergyIntolerance maps an AllergyIntolerance resource to a patient allergy.
+// Only active allergies with a single reaction can be stored.
+func ParseAllergyIntolerance(resource AllergyIntolerance) (grpc.Allergy, error) {
+	errs := &issues{}
+	var allergy grpc.Allergy
+
+	if resource.ClinicalStatus != nil && !hasCoding(resource.ClinicalStatus, systemAllergyClinical, "active") {
+		errs.unsupported("AllergyIntolerance.clinicalStatus", "only active allergies can be stored")
+	}
+	if hasCoding(resource.VerificationStatus, systemAllergyVerification, "refuted") ||
+		hasCoding(resource.VerificationStatus, systemAllergyVerification, "entered-in-error") {
+		errs.unsupported("AllergyIntolerance.verificationStatus", "refuted or erroneous allergies cannot be stored")
+	}
+
+	allergy.Substance = codeableText(resource.Code)
+	if allergy.Substance == "" {
+		errs.invalid("AllergyIntolerance.code", "the substance needs a text or a coding with a display")
+	}
+
+	if len(resource.Note) > 0 {
+		errs.unsupported("AllergyIntolerance.note", "notes cannot be stored")
+	}
+
+	switch len(resource.Reaction) {
+	case 0:
+	case 1:
+		reaction := resource.Reaction[0]
+		manifestations := make([]string, 0, len(reaction.Manifestation))
+		for i := range reaction.Manifestation {
+			text := codeableText(&reaction.Manifestation[i])
+			if text == "" {
+				errs.invalid("AllergyIntolerance.reaction[0].manifestation["+strconv.Itoa(i)+"]", "needs a text or a coding with a display")
+				continue
+			}
+			manifestations = append(manifestations, text)
+		}
+		allergy.Reaction = strings.Join(manifestations, ", ")
+
+		if reaction.Severity != "" {
+			if severityCodes[reaction.Severity] != reaction.Severity {
+				errs.invalid("AllergyIntolerance.reaction[0].severity", "must be mild, moderate or severe")
+			}
+			allergy.Severity = reaction.Severity
+		}
+	default:
+		errs.unsupported("AllergyIntolerance.reaction", "only one reaction can be stored")
+	}
+
+	return allergy, errs.err()
+}
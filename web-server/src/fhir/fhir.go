@@ -0,0 +1,195 @@
+package fhir
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ValidationError reports why a document could not be mapped to the gateway model
+type ValidationError struct {
+	Issues []Issue
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		messages[i] = issue.Diagnostics
+		if len(issue.Expression) > 0 {
+			messages[i] = strings.Join(issue.Expression, ", ") + ": " + issue.Diagnostics
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// issues collects validation problems while mapping a resource
+type issues struct {
+	list []Issue
+}
+
+// invalid records a value that breaks the resource rules
+func (i *issues) invalid(expression, format string, args ...any) {
+	i.add("invalid", expression, format, args...)
+}
+
+// unsupported records a value that is valid FHIR but cannot be mapped to the gateway model
+func (i *issues) unsupported(expression, format string, args ...any) {
+	i.add("not-supported", expression, format, args...)
+}
+
+// add records an issue with the given code
+func (i *issues) add(code, expression, format string, args ...any) {
+	issue := Issue{
+		Severity:    "error",
+		Code:        code,
+		Diagnostics: fmt.Sprintf(format, args...),
+	}
+	if expression != "" {
+		issue.Expression = []string{expression}
+	}
+	i.list = append(i.list, issue)
+}
+
+// err returns the collected issues as an error, or nil when there are none
+func (i *issues) err() error {
+	if len(i.list) == 0 {
+		return nil
+	}
+	return &ValidationError{Issues: i.list}
+}
+
+// Decode parses a resource of the expected type.
+// Elements outside the supported subset are rejected, since they would be silently lost otherwise.
+func Decode(data []byte, resourceType string, v any) error {
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return &ValidationError{Issues: []Issue{{Severity: "error", Code: "structure", Diagnostics: "Body must be a JSON object"}}}
+	}
+	if header.ResourceType != resourceType {
+		return &ValidationError{Issues: []Issue{{
+			Severity:    "error",
+			Code:        "invalid",
+			Diagnostics: fmt.Sprintf("resourceType must be %s", resourceType),
+			Expression:  []string{"resourceType"},
+		}}}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &typeErr):
+			return &ValidationError{Issues: []Issue{{
+				Severity:    "error",
+				Code:        "structure",
+				Diagnostics: fmt.Sprintf("must be of type %s", typeErr.Type),
+				Expression:  []string{resourceType + "." + typeErr.Field},
+			}}}
+		case strings.HasPrefix(err.Error(), "json: unknown field "):
+			field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+			return &ValidationError{Issues: []Issue{{
+				Severity:    "error",
+				Code:        "not-supported",
+				Diagnostics: fmt.Sprintf("element %s cannot be mapped by this server", field),
+				Expression:  []string{field},
+			}}}
+		default:
+			return &ValidationError{Issues: []Issue{{Severity: "error", Code: "structure", Diagnostics: err.Error()}}}
+		}
+	}
+	return nil
+}
+
+// NewOperationOutcome builds an error response with a single issue
+func NewOperationOutcome(code, diagnostics string) OperationOutcome {
+	return OperationOutcome{
+		ResourceType: "OperationOutcome",
+		Issue: []Issue{{
+			Severity:    "error",
+			Code:        code,
+			Diagnostics: diagnostics,
+		}},
+	}
+}
+
+// OutcomeFromError builds an error response from a validation error
+func OutcomeFromError(err error) OperationOutcome {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return OperationOutcome{ResourceType: "OperationOutcome", Issue: validationErr.Issues}
+	}
+	return NewOperationOutcome("exception", err.Error())
+}
+
+// NewBundle packs resources into a bundle of the given type, using baseURL to build each fullUrl
+func NewBundle(bundleType, baseURL string, resources []Resource) Bundle {
+	total := len(resources)
+	bundle := Bundle{
+		ResourceType: "Bundle",
+		Type:         bundleType,
+		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+		Total:        &total,
+		Entry:        make([]BundleEntry, len(resources)),
+	}
+	for i, resource := range resources {
+		bundle.Entry[i] = BundleEntry{
+			FullURL:  fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(baseURL, "/"), resource.resourceType(), resource.resourceID()),
+			Resource: resource,
+		}
+	}
+	return bundle
+}
+
+// PatientReference formats a reference to the patient with the given ID
+func PatientReference(patientID string) Reference {
+	return Reference{Reference: "Patient/" + patientID}
+}
+
+// ParsePatientReference extracts the patient ID from a relative or absolute patient reference
+func ParsePatientReference(ref Reference) (string, bool) {
+	index := strings.LastIndex(ref.Reference, "Patient/")
+	if index < 0 {
+		return "", false
+	}
+	id := ref.Reference[index+len("Patient/"):]
+	if id == "" || strings.Contains(id, "/") {
+		return "", false
+	}
+	return id, true
+}
+
+// codeableText returns the text of a concept, falling back to the display of its first coding
+func codeableText(concept *CodeableConcept) string {
+	if concept == nil {
+		return ""
+	}
+	if text := strings.TrimSpace(concept.Text); text != "" {
+		return text
+	}
+	for _, coding := range concept.Coding {
+		if display := strings.TrimSpace(coding.Display); display != "" {
+			return display
+		}
+	}
+	return ""
+}
+
+// hasCoding reports whether a concept contains the given code of a system
+func hasCoding(concept *CodeableConcept, system, code string) bool {
+	if concept == nil {
+		return false
+	}
+	for _, coding := range concept.Coding {
+		if coding.System == system && coding.Code == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,318 @@
+package fhir
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// testNow is the reference time used when parsing resources
+var testNow = time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+// roundTrip encodes a resource and decodes it back as a client would send it
+func roundTrip[T any](t *testing.T, resource T, resourceType string) T {
+	t.Helper()
+
+	data, err := json.Marshal(resource)
+	if err != nil {
+		t.Fatalf("failed to encode %s: %v", resourceType, err)
+	}
+	var decoded T
+	if err := Decode(data, resourceType, &decoded); err != nil {
+		t.Fatalf("Decode() of an exported %s: %v", resourceType, err)
+	}
+	return decoded
+}
+
+// expectIssue fails the test unless err is a validation error with an issue of the code at the expression
+func expectIssue(t *testing.T, err error, code, expression string) {
+	t.Helper()
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("error = %v, want a validation error", err)
+	}
+	for _, issue := range validationErr.Issues {
+		if issue.Code == code && (expression == "" || (len(issue.Expression) > 0 && issue.Expression[0] == expression)) {
+			return
+		}
+	}
+	t.Fatalf("issues = %+v, want %s at %q", validationErr.Issues, code, expression)
+}
+
+func TestPatientRoundTrip(t *testing.T) {
+	info := grpc.PatientInfo{Name: "Maria Silva", Age: 42, Gender: "feminino", Weight: 70, Height: 165}
+	patient := roundTrip(t, NewPatient("7", info, 3), "Patient")
+
+	if patient.Meta == nil || patient.Meta.VersionID != "3" {
+		t.Errorf("meta = %+v, want version 3", patient.Meta)
+	}
+
+	applied := grpc.PatientInfo{Weight: 70, Height: 165}
+	if err := ApplyPatient(patient, &applied, testNow); err != nil {
+		t.Fatalf("ApplyPatient() = %v", err)
+	}
+	if !reflect.DeepEqual(applied, info) {
+		t.Errorf("ApplyPatient() = %+v, want %+v", applied, info)
+	}
+}
+
+func TestApplyPatientBirthDate(t *testing.T) {
+	tests := []struct {
+		birthDate string
+		age       int32
+	}{
+		{"1980-06-15", 45},
+		{"1980-06-16", 44},
+		{"1980-07", 44},
+		{"1980", 45},
+	}
+
+	for _, tt := range tests {
+		patient := Patient{ResourceType: "Patient", Name: []HumanName{{Given: []string{"João"}, Family: "Souza"}}, Gender: "male", BirthDate: tt.birthDate}
+		var info grpc.PatientInfo
+		if err := ApplyPatient(patient, &info, testNow); err != nil {
+			t.Fatalf("ApplyPatient(%s) = %v", tt.birthDate, err)
+		}
+		if info.Age != tt.age || info.Name != "João Souza" || info.Gender != "masculino" {
+			t.Errorf("ApplyPatient(%s) = %+v, want João Souza, masculino, %d", tt.birthDate, info, tt.age)
+		}
+	}
+}
+
+func TestApplyPatientRejects(t *testing.T) {
+	inactive := false
+	years := 30.0
+	named := []HumanName{{Text: "Maria"}}
+
+	tests := []struct {
+		name       string
+		patient    Patient
+		code       string
+		expression string
+	}{
+		{"inactive", Patient{Active: &inactive, Name: named, BirthDate: "1990"}, "not-supported", "Patient.active"},
+		{"no name", Patient{BirthDate: "1990"}, "invalid", "Patient.name"},
+		{"two names", Patient{Name: []HumanName{{Text: "Maria"}, {Text: "Mari"}}, BirthDate: "1990"}, "not-supported", "Patient.name"},
+		{"unknown gender", Patient{Name: named, Gender: "x", BirthDate: "1990"}, "invalid", "Patient.gender"},
+		{"future birth date", Patient{Name: named, BirthDate: "2030-01-01"}, "invalid", "Patient.birthDate"},
+		{"no age", Patient{Name: named}, "invalid", "Patient.birthDate"},
+		{"age in months", Patient{Name: named, Extension: []Extension{{URL: ageExtensionURL, ValueAge: &Quantity{Value: &years, Code: "mo"}}}}, "not-supported", "Patient.extension[0].valueAge.code"},
+		{"other extension", Patient{Name: named, BirthDate: "1990", Extension: []Extension{{URL: "http://example.org/race"}}}, "not-supported", "Patient.extension[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info grpc.PatientInfo
+			expectIssue(t, ApplyPatient(tt.patient, &info, testNow), tt.code, tt.expression)
+		})
+	}
+}
+
+func TestVitalsObservationsRoundTrip(t *testing.T) {
+	systolic, diastolic, heartRate, spo2 := int32(120), int32(80), int32(72), int32(98)
+	temperature, glucose, weight := float32(36.8), float32(95.5), float32(70.25)
+	vitals := grpc.VitalSigns{
+		ID:          "v1",
+		RecordedAt:  time.Date(2025, 6, 14, 8, 30, 0, 0, time.UTC),
+		Systolic:    &systolic,
+		Diastolic:   &diastolic,
+		HeartRate:   &heartRate,
+		Temperature: &temperature,
+		SpO2:        &spo2,
+		Glucose:     &glucose,
+		Weight:      &weight,
+	}
+
+	observations := VitalsObservations("7", vitals)
+	if len(observations) != 6 {
+		t.Fatalf("VitalsObservations() returned %d observations, want a panel and 5 others", len(observations))
+	}
+
+	merged := grpc.VitalSigns{ID: vitals.ID}
+	for _, observation := range observations {
+		values, err := ParseObservation(roundTrip(t, observation, "Observation"), testNow)
+		if err != nil {
+			t.Fatalf("ParseObservation(%s) = %v", observation.ID, err)
+		}
+		if values.Vitals == nil || values.Height != nil {
+			t.Fatalf("ParseObservation(%s) = %+v, want vital signs", observation.ID, values)
+		}
+		merged.RecordedAt = values.Vitals.RecordedAt
+		for _, code := range observationCodes {
+			if value, ok := code.get(*values.Vitals); ok {
+				code.set(&merged, value)
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(merged, vitals) {
+		t.Errorf("round trip = %+v, want %+v", merged, vitals)
+	}
+}
+
+func TestProfileObservationsRoundTrip(t *testing.T) {
+	observations := ProfileObservations("7", grpc.PatientInfo{Weight: 70, Height: 165})
+	if len(observations) != 2 {
+		t.Fatalf("ProfileObservations() returned %d observations, want weight and height", len(observations))
+	}
+
+	// Height has no effective time in the profile and is still accepted
+	values, err := ParseObservation(roundTrip(t, observations[1], "Observation"), testNow)
+	if err != nil || values.Height == nil || *values.Height != 165 {
+		t.Fatalf("ParseObservation(height) = %+v, %v, want 165 cm", values, err)
+	}
+}
+
+func TestParseObservationConvertsUnits(t *testing.T) {
+	tests := []struct {
+		loinc string
+		value float64
+		unit  string
+		check func(*ObservationValues) bool
+	}{
+		{"8310-5", 98.6, "[degF]", func(v *ObservationValues) bool { return *v.Vitals.Temperature == 37 }},
+		{"2339-0", 5, "mmol/L", func(v *ObservationValues) bool { return *v.Vitals.Glucose == 90.08 }},
+		{"29463-7", 154, "[lb_av]", func(v *ObservationValues) bool { return *v.Vitals.Weight == 69.85 }},
+		{"2708-6", 97, "%", func(v *ObservationValues) bool { return *v.Vitals.SpO2 == 97 }},
+		{loincBodyHeight, 1.7, "m", func(v *ObservationValues) bool { return *v.Height == 170 }},
+	}
+
+	for _, tt := range tests {
+		observation := newVitalSignsObservation("o", PatientReference("7"), tt.loinc, "")
+		observation.EffectiveDateTime = "2025-06-14T08:30:00Z"
+		observation.ValueQuantity = &Quantity{Value: &tt.value, System: systemUCUM, Code: tt.unit}
+
+		values, err := ParseObservation(observation, testNow)
+		if err != nil || !tt.check(values) {
+			t.Errorf("ParseObservation(%s %g %s) = %+v, %v", tt.loinc, tt.value, tt.unit, values, err)
+		}
+	}
+}
+
+func TestParseObservationRejects(t *testing.T) {
+	value := 120.0
+	valid := func() Observation {
+		observation := newVitalSignsObservation("o", PatientReference("7"), "8867-4", "Heart rate")
+		observation.EffectiveDateTime = "2025-06-14T08:30:00Z"
+		observation.ValueQuantity = &Quantity{Value: &value, System: systemUCUM, Code: "/min"}
+		return observation
+	}
+
+	tests := []struct {
+		name       string
+		modify     func(*Observation)
+		code       string
+		expression string
+	}{
+		{"cancelled", func(o *Observation) { o.Status = "cancelled" }, "not-supported", "Observation.status"},
+		{"laboratory category", func(o *Observation) { o.Category[0].Coding[0].Code = "laboratory" }, "not-supported", "Observation.category[0]"},
+		{"unknown LOINC code", func(o *Observation) { o.Code = loincConcept("1234-5", "Cholesterol") }, "not-supported", "Observation.code"},
+		{"SNOMED code", func(o *Observation) { o.Code.Coding[0].System = "http://snomed.info/sct" }, "not-supported", "Observation.code"},
+		{"unsupported unit", func(o *Observation) { o.ValueQuantity.Code = "/s" }, "not-supported", "Observation.valueQuantity.code"},
+		{"non-UCUM unit", func(o *Observation) { o.ValueQuantity.System = "http://example.org/units" }, "not-supported", "Observation.valueQuantity.system"},
+		{"missing value", func(o *Observation) { o.ValueQuantity = nil }, "invalid", "Observation.valueQuantity"},
+		{"missing time", func(o *Observation) { o.EffectiveDateTime = "" }, "invalid", "Observation.effectiveDateTime"},
+		{"time without zone", func(o *Observation) { o.EffectiveDateTime = "2025-06-14T08:30:00" }, "invalid", "Observation.effectiveDateTime"},
+		{"future time", func(o *Observation) { o.EffectiveDateTime = "2025-06-16T08:30:00Z" }, "invalid", "Observation.effectiveDateTime"},
+		{"components", func(o *Observation) {
+			o.Component = []ObservationComponent{{Code: loincConcept("8480-6", "Systolic"), ValueQuantity: o.ValueQuantity}}
+		}, "not-supported", "Observation.component"},
+		{"panel with a heart rate component", func(o *Observation) {
+			o.Code = loincConcept(loincBloodPressurePanel, "Blood pressure")
+			o.Component = []ObservationComponent{{Code: loincConcept("8867-4", "Heart rate"), ValueQuantity: o.ValueQuantity}}
+			o.ValueQuantity = nil
+		}, "not-supported", "Observation.component[0].code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			observation := valid()
+			tt.modify(&observation)
+			_, err := ParseObservation(observation, testNow)
+			expectIssue(t, err, tt.code, tt.expression)
+		})
+	}
+}
+
+func TestAllergyIntoleranceRoundTrip(t *testing.T) {
+	allergy := grpc.Allergy{Substance: "Penicilina", Reaction: "Urticária", Severity: "moderate"}
+	resource := roundTrip(t, NewAllergyIntolerance("a1", "7", allergy), "AllergyIntolerance")
+
+	if id, ok := ParsePatientReference(resource.Patient); !ok || id != "7" {
+		t.Errorf("patient reference = %+v, want Patient/7", resource.Patient)
+	}
+	parsed, err := ParseAllergyIntolerance(resource)
+	if err != nil {
+		t.Fatalf("ParseAllergyIntolerance() = %v", err)
+	}
+	if parsed != allergy {
+		t.Errorf("ParseAllergyIntolerance() = %+v, want %+v", parsed, allergy)
+	}
+}
+
+func TestNewAllergyIntoleranceKeepsUnknownSeverity(t *testing.T) {
+	resource := NewAllergyIntolerance("a1", "7", grpc.Allergy{Substance: "Dipirona", Reaction: "Falta de ar", Severity: "forte"})
+
+	if len(resource.Reaction) != 1 || resource.Reaction[0].Severity != "" {
+		t.Errorf("reaction = %+v, want one reaction without a FHIR severity", resource.Reaction)
+	}
+	if len(resource.Note) != 1 || resource.Note[0].Text != "Severity: forte" {
+		t.Errorf("note = %+v, want the severity kept as a note", resource.Note)
+	}
+}
+
+func TestParseAllergyIntoleranceRejects(t *testing.T) {
+	valid := func() AllergyIntolerance {
+		return NewAllergyIntolerance("a1", "7", grpc.Allergy{Substance: "Penicilina", Reaction: "Urticária", Severity: "mild"})
+	}
+
+	tests := []struct {
+		name       string
+		modify     func(*AllergyIntolerance)
+		code       string
+		expression string
+	}{
+		{"resolved", func(a *AllergyIntolerance) { a.ClinicalStatus.Coding[0].Code = "resolved" }, "not-supported", "AllergyIntolerance.clinicalStatus"},
+		{"refuted", func(a *AllergyIntolerance) { a.VerificationStatus.Coding[0].Code = "refuted" }, "not-supported", "AllergyIntolerance.verificationStatus"},
+		{"no substance", func(a *AllergyIntolerance) { a.Code = nil }, "invalid", "AllergyIntolerance.code"},
+		{"note", func(a *AllergyIntolerance) { a.Note = []Annotation{{Text: "since childhood"}} }, "not-supported", "AllergyIntolerance.note"},
+		{"two reactions", func(a *AllergyIntolerance) { a.Reaction = append(a.Reaction, a.Reaction[0]) }, "not-supported", "AllergyIntolerance.reaction"},
+		{"empty manifestation", func(a *AllergyIntolerance) { a.Reaction[0].Manifestation[0].Text = "" }, "invalid", "AllergyIntolerance.reaction[0].manifestation[0]"},
+		{"translated severity", func(a *AllergyIntolerance) { a.Reaction[0].Severity = "grave" }, "invalid", "AllergyIntolerance.reaction[0].severity"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := valid()
+			tt.modify(&resource)
+			_, err := ParseAllergyIntolerance(resource)
+			expectIssue(t, err, tt.code, tt.expression)
+		})
+	}
+}
+
+func TestDecodeRejects(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		code       string
+		expression string
+	}{
+		{"not an object", `[]`, "structure", ""},
+		{"wrong resource type", `{"resourceType":"Observation","status":"final"}`, "invalid", "resourceType"},
+		{"unmapped element", `{"resourceType":"Patient","telecom":[{"system":"phone","value":"555"}]}`, "not-supported", "telecom"},
+		{"wrong type", `{"resourceType":"Patient","gender":1}`, "structure", "Patient.gender"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patient Patient
+			expectIssue(t, Decode([]byte(tt.body), "Patient", &patient), tt.code, tt.expression)
+		})
+	}
+}
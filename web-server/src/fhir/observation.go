@@ -0,0 +1,340 @@
+package fhir
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// LOINC codes that do not map to a single vital sign
+const (
+	loincBloodPressurePanel = "85354-9"
+	loincBodyHeight         = "8302-2"
+)
+
+// observationCode describes how a vital sign maps to a LOINC-coded observation
+type observationCode struct {
+	Metric  string
+	LOINC   string
+	Display string
+	// Unit is the UCUM code the gateway stores the value in
+	Unit string
+	// aliases are other LOINC codes accepted on import
+	aliases []string
+	// conversions convert values given in other UCUM units to Unit
+	conversions map[string]func(float64) float64
+	get         func(v grpc.VitalSigns) (float64, bool)
+	set         func(v *grpc.VitalSigns, value float64)
+}
+
+// observationCodes lists the vital signs in the same order as vitals.Metrics
+var observationCodes = []observationCode{
+	{
+		Metric: "systolic", LOINC: "8480-6", Display: "Systolic blood pressure", Unit: "mm[Hg]",
+		get: func(v grpc.VitalSigns) (float64, bool) { return int32Value(v.Systolic) },
+		set: func(v *grpc.VitalSigns, value float64) { v.Systolic = int32Pointer(value) },
+	},
+	{
+		Metric: "diastolic", LOINC: "8462-4", Display: "Diastolic blood pressure", Unit: "mm[Hg]",
+		get: func(v grpc.VitalSigns) (float64, bool) { return int32Value(v.Diastolic) },
+		set: func(v *grpc.VitalSigns, value float64) { v.Diastolic = int32Pointer(value) },
+	},
+	{
+		Metric: "heart_rate", LOINC: "8867-4", Display: "Heart rate", Unit: "/min",
+		get: func(v grpc.VitalSigns) (float64, bool) { return int32Value(v.HeartRate) },
+		set: func(v *grpc.VitalSigns, value float64) { v.HeartRate = int32Pointer(value) },
+	},
+	{
+		Metric: "temperature", LOINC: "8310-5", Display: "Body temperature", Unit: "Cel",
+		conversions: map[string]func(float64) float64{"[degF]": func(f float64) float64 { return (f - 32) * 5 / 9 }},
+		get:         func(v grpc.VitalSigns) (float64, bool) { return float32Value(v.Temperature) },
+		set:         func(v *grpc.VitalSigns, value float64) { v.Temperature = float32Pointer(value) },
+	},
+	{
+		Metric: "spo2", LOINC: "59408-5", Display: "Oxygen saturation in Arterial blood by Pulse oximetry", Unit: "%",
+		aliases: []string{"2708-6"},
+		get:     func(v grpc.VitalSigns) (float64, bool) { return int32Value(v.SpO2) },
+		set:     func(v *grpc.VitalSigns, value float64) { v.SpO2 = int32Pointer(value) },
+	},
+	{
+		Metric: "glucose", LOINC: "2339-0", Display: "Glucose [Mass/volume] in Blood", Unit: "mg/dL",
+		conversions: map[string]func(float64) float64{"mmol/L": func(mmol float64) float64 { return mmol * 18.016 }},
+		get:         func(v grpc.VitalSigns) (float64, bool) { return float32Value(v.Glucose) },
+		set:         func(v *grpc.VitalSigns, value float64) { v.Glucose = float32Pointer(value) },
+	},
+	{
+		Metric: "weight", LOINC: "29463-7", Display: "Body weight", Unit: "kg",
+		conversions: map[string]func(float64) float64{"[lb_av]": func(lb float64) float64 { return lb * 0.45359237 }, "g": func(g float64) float64 { return g / 1000 }},
+		get:         func(v grpc.VitalSigns) (float64, bool) { return float32Value(v.Weight) },
+		set:         func(v *grpc.VitalSigns, value float64) { v.Weight = float32Pointer(value) },
+	},
+}
+
+// heightCode maps body height observations to the patient profile
+var heightCode = observationCode{
+	Metric: "height", LOINC: loincBodyHeight, Display: "Body height", Unit: "cm",
+	conversions: map[string]func(float64) float64{"[in_i]": func(in float64) float64 { return in * 2.54 }, "m": func(m float64) float64 { return m * 100 }},
+}
+
+// importStatuses lists the observation statuses that describe a usable measurement
+var importStatuses = map[string]bool{
+	"preliminary": true,
+	"final":       true,
+	"amended":     true,
+	"corrected":   true,
+}
+
+// ObservationValues represents what an imported observation maps to
+type ObservationValues struct {
+	// Vitals holds the vital signs reading, when the observation is one
+	Vitals *grpc.VitalSigns
+	// Height holds the body height in cm, which is stored in the patient profile
+	Height *float32
+}
+
+// VitalsObservations maps a vital signs reading to observations, one per measurement.
+// Systolic and diastolic pressure taken together become a single blood pressure panel.
+func VitalsObservations(patientID string, v grpc.VitalSigns) []Observation {
+	subject := PatientReference(patientID)
+	effective := v.RecordedAt.UTC().Format(time.RFC3339)
+	observations := []Observation{}
+
+	_, hasSystolic := int32Value(v.Systolic)
+	_, hasDiastolic := int32Value(v.Diastolic)
+	panel := hasSystolic && hasDiastolic
+	if panel {
+		observation := newVitalSignsObservation(v.ID+"-"+loincBloodPressurePanel, subject, loincBloodPressurePanel, "Blood pressure panel with all children optional")
+		observation.EffectiveDateTime = effective
+		for _, code := range observationCodes[:2] {
+			value, _ := code.get(v)
+			observation.Component = append(observation.Component, ObservationComponent{
+				Code:          loincConcept(code.LOINC, code.Display),
+				ValueQuantity: ucumQuantity(value, code.Unit),
+			})
+		}
+		observations = append(observations, observation)
+	}
+
+	for i, code := range observationCodes {
+		value, ok := code.get(v)
+		if !ok || (panel && i < 2) {
+			continue
+		}
+		observation := newVitalSignsObservation(v.ID+"-"+code.LOINC, subject, code.LOINC, code.Display)
+		observation.EffectiveDateTime = effective
+		observation.ValueQuantity = ucumQuantity(value, code.Unit)
+		observations = append(observations, observation)
+	}
+
+	return observations
+}
+
+// ProfileObservations maps the weight and height stored in the patient profile to observations
+func ProfileObservations(patientID string, info grpc.PatientInfo) []Observation {
+	subject := PatientReference(patientID)
+	observations := []Observation{}
+
+	if info.Weight > 0 {
+		weight := observationCodes[len(observationCodes)-1]
+		observation := newVitalSignsObservation("profile-"+patientID+"-"+weight.LOINC, subject, weight.LOINC, weight.Display)
+		observation.ValueQuantity = ucumQuantity(float64(info.Weight), weight.Unit)
+		observations = append(observations, observation)
+	}
+	if info.Height > 0 {
+		observation := newVitalSignsObservation("profile-"+patientID+"-"+heightCode.LOINC, subject, heightCode.LOINC, heightCode.Display)
+		observation.ValueQuantity = ucumQuantity(float64(info.Height), heightCode.Unit)
+		observations = append(observations, observation)
+	}
+
+	return observations
+}
+
+// ParseObservation maps an observation to a vital signs reading or a body height.
+// Only LOINC-coded vital signs with UCUM quantities are accepted.
+func ParseObservation(observation Observation, now time.Time) (*ObservationValues, error) {
+	errs := &issues{}
+
+	if !importStatuses[observation.Status] {
+		errs.unsupported("Observation.status", "must be preliminary, final, amended or corrected")
+	}
+	for i, category := range observation.Category {
+		if !hasCoding(&category, systemObservationCategory, "vital-signs") {
+			errs.unsupported("Observation.category["+strconv.Itoa(i)+"]", "only the vital-signs category is supported")
+		}
+	}
+
+	codeValue, ok := observationLOINC(observation.Code)
+	if !ok {
+		errs.unsupported("Observation.code", "must be a supported LOINC vital sign or body height code")
+		return nil, errs.err()
+	}
+
+	// Body height updates the patient profile instead of the vitals history
+	if codeValue == loincBodyHeight {
+		if len(observation.Component) > 0 {
+			errs.unsupported("Observation.component", "body height does not have components")
+		}
+		height, ok := quantityValue(observation.ValueQuantity, heightCode, "Observation.valueQuantity", errs)
+		if err := errs.err(); err != nil || !ok {
+			return nil, err
+		}
+		value := float32(height)
+		return &ObservationValues{Height: &value}, nil
+	}
+
+	vitals := &grpc.VitalSigns{}
+	if observation.EffectiveDateTime == "" {
+		errs.invalid("Observation.effectiveDateTime", "is required for vital signs")
+	} else if recordedAt, err := time.Parse(time.RFC3339, observation.EffectiveDateTime); err != nil {
+		errs.invalid("Observation.effectiveDateTime", "must be a full date and time with time zone")
+	} else if recordedAt.After(now.Add(time.Minute * 5)) {
+		errs.invalid("Observation.effectiveDateTime", "cannot be in the future")
+	} else {
+		vitals.RecordedAt = recordedAt
+	}
+
+	if codeValue == loincBloodPressurePanel {
+		if observation.ValueQuantity != nil {
+			errs.invalid("Observation.valueQuantity", "the blood pressure panel carries its values in components")
+		}
+		if len(observation.Component) == 0 {
+			errs.invalid("Observation.component", "the blood pressure panel needs systolic and/or diastolic components")
+		}
+		for i, component := range observation.Component {
+			expression := "Observation.component[" + strconv.Itoa(i) + "]"
+			componentCode, ok := observationLOINC(component.Code)
+			code, found := findObservationCode(componentCode)
+			if !ok || !found || (code.Metric != "systolic" && code.Metric != "diastolic") {
+				errs.unsupported(expression+".code", "must be systolic or diastolic blood pressure")
+				continue
+			}
+			if value, ok := quantityValue(component.ValueQuantity, code, expression+".valueQuantity", errs); ok {
+				code.set(vitals, value)
+			}
+		}
+		return &ObservationValues{Vitals: vitals}, errs.err()
+	}
+
+	code, _ := findObservationCode(codeValue)
+	if len(observation.Component) > 0 {
+		errs.unsupported("Observation.component", "%s does not have components", code.Display)
+	}
+	if value, ok := quantityValue(observation.ValueQuantity, code, "Observation.valueQuantity", errs); ok {
+		code.set(vitals, value)
+	}
+	return &ObservationValues{Vitals: vitals}, errs.err()
+}
+
+// observationLOINC returns the first supported LOINC code of a concept
+func observationLOINC(concept CodeableConcept) (string, bool) {
+	for _, coding := range concept.Coding {
+		if coding.System != systemLOINC {
+			continue
+		}
+		if coding.Code == loincBloodPressurePanel || coding.Code == loincBodyHeight {
+			return coding.Code, true
+		}
+		if code, ok := findObservationCode(coding.Code); ok {
+			return code.LOINC, true
+		}
+	}
+	return "", false
+}
+
+// findObservationCode looks up a vital sign by its LOINC code or one of its aliases
+func findObservationCode(loinc string) (observationCode, bool) {
+	for _, code := range observationCodes {
+		if code.LOINC == loinc {
+			return code, true
+		}
+		for _, alias := range code.aliases {
+			if alias == loinc {
+				return code, true
+			}
+		}
+	}
+	return observationCode{}, false
+}
+
+// quantityValue reads a UCUM quantity in the unit expected by the code, converting it when possible
+func quantityValue(quantity *Quantity, code observationCode, expression string, errs *issues) (float64, bool) {
+	if quantity == nil || quantity.Value == nil {
+		errs.invalid(expression, "a value is required")
+		return 0, false
+	}
+	if quantity.System != "" && quantity.System != systemUCUM {
+		errs.unsupported(expression+".system", "units must be UCUM")
+		return 0, false
+	}
+
+	unit := quantity.Code
+	if unit == "" {
+		unit = quantity.Unit
+	}
+	if unit == code.Unit {
+		return *quantity.Value, true
+	}
+	if convert, ok := code.conversions[unit]; ok {
+		return convert(*quantity.Value), true
+	}
+	errs.unsupported(expression+".code", "%s must be given in %s", code.Display, code.Unit)
+	return 0, false
+}
+
+// newVitalSignsObservation builds a final vital signs observation without a value
+func newVitalSignsObservation(id string, subject Reference, loinc, display string) Observation {
+	return Observation{
+		ResourceType: "Observation",
+		ID:           id,
+		Status:       "final",
+		Category: []CodeableConcept{{
+			Coding: []Coding{{System: systemObservationCategory, Code: "vital-signs", Display: "Vital Signs"}},
+		}},
+		Code:    loincConcept(loinc, display),
+		Subject: &subject,
+	}
+}
+
+// loincConcept builds a concept with a single LOINC coding
+func loincConcept(code, display string) CodeableConcept {
+	return CodeableConcept{
+		Coding: []Coding{{System: systemLOINC, Code: code, Display: display}},
+		Text:   display,
+	}
+}
+
+// ucumQuantity builds a quantity in a UCUM unit
+func ucumQuantity(value float64, unit string) *Quantity {
+	value = math.Round(value*100) / 100
+	return &Quantity{Value: &value, Unit: unit, System: systemUCUM, Code: unit}
+}
+
+// int32Value reads an optional integer measurement
+func int32Value(value *int32) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+	return float64(*value), true
+}
+
+// float32Value reads an optional decimal measurement
+func float32Value(value *float32) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+	return float64(*value), true
+}
+
+// int32Pointer rounds a value to an optional integer measurement
+func int32Pointer(value float64) *int32 {
+	rounded := int32(math.Round(value))
+	return &rounded
+}
+
+// float32Pointer converts a value to an optional decimal measurement
+func float32Pointer(value float64) *float32 {
+	converted := float32(math.Round(value*100) / 100)
+	return &converted
+}
@@ -0,0 +1,126 @@
+package fhir
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// genderCodes maps the gateway's canonical gender values to FHIR administrative genders
+var genderCodes = map[string]string{
+	"masculino":            "male",
+	"feminino":             "female",
+	"outro":                "other",
+	"prefiro_nao_informar": "unknown",
+}
+
+// NewPatient maps a patient profile to a Patient resource.
+// The gateway only knows the patient's age, which is carried in an extension instead of a birth date.
+func NewPatient(id string, info grpc.PatientInfo, version int64) Patient {
+	age := float64(info.Age)
+	patient := Patient{
+		ResourceType: "Patient",
+		ID:           id,
+		Meta:         &Meta{VersionID: strconv.FormatInt(version, 10)},
+		Extension: []Extension{{
+			URL:      ageExtensionURL,
+			ValueAge: &Quantity{Value: &age, Unit: "a", System: systemUCUM, Code: "a"},
+		}},
+		Gender: genderCodes[info.Gender],
+	}
+	if info.Name != "" {
+		patient.Name = []HumanName{{Use: "usual", Text: info.Name}}
+	}
+	return patient
+}
+
+// ApplyPatient copies the demographics of a Patient resource into the patient info.
+// Weight, height and the clinical history are left untouched, as Patient does not carry them.
+func ApplyPatient(patient Patient, info *grpc.PatientInfo, now time.Time) error {
+	errs := &issues{}
+
+	if patient.Active != nil && !*patient.Active {
+		errs.unsupported("Patient.active", "inactive patients cannot be stored")
+	}
+
+	// Name
+	switch len(patient.Name) {
+	case 0:
+		errs.invalid("Patient.name", "a name is required")
+	case 1:
+		name := patient.Name[0]
+		text := strings.TrimSpace(name.Text)
+		if text == "" {
+			text = strings.TrimSpace(strings.Join(append(append([]string{}, name.Given...), name.Family), " "))
+		}
+		if text == "" {
+			errs.invalid("Patient.name[0]", "must have text, given or family")
+		}
+		info.Name = text
+	default:
+		errs.unsupported("Patient.name", "only one name can be stored")
+	}
+
+	// Gender
+	info.Gender = ""
+	for canonical, code := range genderCodes {
+		if code == patient.Gender {
+			info.Gender = canonical
+		}
+	}
+	if patient.Gender == "" {
+		info.Gender = "prefiro_nao_informar"
+	} else if info.Gender == "" {
+		errs.invalid("Patient.gender", "must be male, female, other or unknown")
+	}
+
+	// Age, from the birth date when given, otherwise from the age extension
+	age, ageFound := int32(0), false
+	for i, extension := range patient.Extension {
+		if extension.URL != ageExtensionURL || extension.ValueAge == nil || extension.ValueAge.Value == nil {
+			errs.unsupported("Patient.extension["+strconv.Itoa(i)+"]", "only the %s extension is supported", ageExtensionURL)
+			continue
+		}
+		if extension.ValueAge.Code != "" && extension.ValueAge.Code != "a" {
+			errs.unsupported("Patient.extension["+strconv.Itoa(i)+"].valueAge.code", "age must be given in years (a)")
+			continue
+		}
+		age, ageFound = int32(math.Floor(*extension.ValueAge.Value)), true
+	}
+	if patient.BirthDate != "" {
+		birthAge, ok := ageAt(patient.BirthDate, now)
+		if !ok {
+			errs.invalid("Patient.birthDate", "must be a date in YYYY, YYYY-MM or YYYY-MM-DD format, not in the future")
+		}
+		age, ageFound = birthAge, ok
+	}
+	if ageFound {
+		info.Age = age
+	} else if patient.BirthDate == "" {
+		errs.invalid("Patient.birthDate", "birthDate or the patient-age extension is required")
+	}
+
+	return errs.err()
+}
+
+// ageAt computes the age in whole years on the given day from a FHIR date of any precision
+func ageAt(birthDate string, now time.Time) (int32, bool) {
+	for _, layout := range []string{"2006-01-02", "2006-01", "2006"} {
+		born, err := time.Parse(layout, birthDate)
+		if err != nil {
+			continue
+		}
+		if born.After(now) {
+			return 0, false
+		}
+		age := now.Year() - born.Year()
+		if now.Month() < born.Month() || (now.Month() == born.Month() && now.Day() < born.Day()) {
+			age--
+		}
+		return int32(max(age, 0)), true
+	}
+	return 0, false
+}
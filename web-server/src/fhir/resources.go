@@ -0,0 +1,160 @@
+package fhir
+
+// ContentType is the media type of FHIR JSON documents
+const ContentType = "application/fhir+json"
+
+// Code systems used by the mapped resources
+const (
+	systemLOINC               = "http://loinc.org"
+	systemUCUM                = "http://unitsofmeasure.org"
+	systemObservationCategory = "http://terminology.hl7.org/CodeSystem/observation-category"
+	systemAllergyClinical     = "http://terminology.hl7.org/CodeSystem/allergyintolerance-clinical"
+	systemAllergyVerification = "http://terminology.hl7.org/CodeSystem/allergyintolerance-verification"
+	// ageExtensionURL carries the patient age, since the gateway does not store a birth date
+	ageExtensionURL = "https://unb.br/fhir/StructureDefinition/patient-age"
+)
+
+// Resource is implemented by every resource the gateway can place in a bundle
+type Resource interface {
+	resourceType() string
+	resourceID() string
+}
+
+// Meta represents the metadata of a resource
+type Meta struct {
+	VersionID   string `json:"versionId,omitempty"`
+	LastUpdated string `json:"lastUpdated,omitempty"`
+}
+
+// Coding represents a code defined by a terminology system
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+// CodeableConcept represents a concept given by codings and/or text
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+// Quantity represents a measured amount
+type Quantity struct {
+	Value  *float64 `json:"value,omitempty"`
+	Unit   string   `json:"unit,omitempty"`
+	System string   `json:"system,omitempty"`
+	Code   string   `json:"code,omitempty"`
+}
+
+// Reference represents a reference from one resource to another
+type Reference struct {
+	Reference string `json:"reference,omitempty"`
+	Display   string `json:"display,omitempty"`
+}
+
+// HumanName represents the name of a person
+type HumanName struct {
+	Use    string   `json:"use,omitempty"`
+	Text   string   `json:"text,omitempty"`
+	Family string   `json:"family,omitempty"`
+	Given  []string `json:"given,omitempty"`
+}
+
+// Extension represents an additional element; only the patient age extension is supported
+type Extension struct {
+	URL      string    `json:"url"`
+	ValueAge *Quantity `json:"valueAge,omitempty"`
+}
+
+// Annotation represents a text note
+type Annotation struct {
+	Text string `json:"text"`
+}
+
+// Patient represents the supported subset of the FHIR R4 Patient resource
+type Patient struct {
+	ResourceType string      `json:"resourceType"`
+	ID           string      `json:"id,omitempty"`
+	Meta         *Meta       `json:"meta,omitempty"`
+	Extension    []Extension `json:"extension,omitempty"`
+	Active       *bool       `json:"active,omitempty"`
+	Name         []HumanName `json:"name,omitempty"`
+	Gender       string      `json:"gender,omitempty"`
+	BirthDate    string      `json:"birthDate,omitempty"`
+}
+
+// ObservationComponent represents one value of a multi-part observation, such as blood pressure
+type ObservationComponent struct {
+	Code          CodeableConcept `json:"code"`
+	ValueQuantity *Quantity       `json:"valueQuantity,omitempty"`
+}
+
+// Observation represents the supported subset of the FHIR R4 Observation resource
+type Observation struct {
+	ResourceType      string                 `json:"resourceType"`
+	ID                string                 `json:"id,omitempty"`
+	Meta              *Meta                  `json:"meta,omitempty"`
+	Status            string                 `json:"status"`
+	Category          []CodeableConcept      `json:"category,omitempty"`
+	Code              CodeableConcept        `json:"code"`
+	Subject           *Reference             `json:"subject,omitempty"`
+	EffectiveDateTime string                 `json:"effectiveDateTime,omitempty"`
+	ValueQuantity     *Quantity              `json:"valueQuantity,omitempty"`
+	Component         []ObservationComponent `json:"component,omitempty"`
+}
+
+// AllergyReaction represents a reaction recorded for an allergy
+type AllergyReaction struct {
+	Manifestation []CodeableConcept `json:"manifestation"`
+	Severity      string            `json:"severity,omitempty"`
+}
+
+// AllergyIntolerance represents the supported subset of the FHIR R4 AllergyIntolerance resource
+type AllergyIntolerance struct {
+	ResourceType       string            `json:"resourceType"`
+	ID                 string            `json:"id,omitempty"`
+	Meta               *Meta             `json:"meta,omitempty"`
+	ClinicalStatus     *CodeableConcept  `json:"clinicalStatus,omitempty"`
+	VerificationStatus *CodeableConcept  `json:"verificationStatus,omitempty"`
+	Code               *CodeableConcept  `json:"code,omitempty"`
+	Patient            Reference         `json:"patient"`
+	Reaction           []AllergyReaction `json:"reaction,omitempty"`
+	Note               []Annotation      `json:"note,omitempty"`
+}
+
+// BundleEntry represents a resource inside a bundle
+type BundleEntry struct {
+	FullURL  string   `json:"fullUrl,omitempty"`
+	Resource Resource `json:"resource"`
+}
+
+// Bundle represents a FHIR R4 Bundle of resources
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	Type         string        `json:"type"`
+	Timestamp    string        `json:"timestamp,omitempty"`
+	Total        *int          `json:"total,omitempty"`
+	Entry        []BundleEntry `json:"entry"`
+}
+
+// Issue represents a single problem reported in an OperationOutcome
+type Issue struct {
+	Severity    string   `json:"severity"`
+	Code        string   `json:"code"`
+	Diagnostics string   `json:"diagnostics,omitempty"`
+	Expression  []string `json:"expression,omitempty"`
+}
+
+// OperationOutcome represents the FHIR R4 error response
+type OperationOutcome struct {
+	ResourceType string  `json:"resourceType"`
+	Issue        []Issue `json:"issue"`
+}
+
+func (p Patient) resourceType() string            { return "Patient" }
+func (p Patient) resourceID() string              { return p.ID }
+func (o Observation) resourceType() string        { return "Observation" }
+func (o Observation) resourceID() string          { return o.ID }
+func (a AllergyIntolerance) resourceType() string { return "AllergyIntolerance" }
+func (a AllergyIntolerance) resourceID() string   { return a.ID }
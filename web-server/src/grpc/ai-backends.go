@@ -0,0 +1,266 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	pb "unb.br/web-server/src/proto"
+)
+
+// Roles of the AI backends
+const (
+	// BackendPrimary answers every request while it is up
+	BackendPrimary = "primary"
+	// BackendFallback answers the requests the backends before it failed
+	BackendFallback = "fallback"
+)
+
+// Backend represents an AI server the client can send requests to
+type Backend struct {
+	Name string
+	Role string
+	Addr string
+}
+
+// BackendStats represents the traffic of a backend since the client started
+type BackendStats struct {
+	Backend
+	// Requests counts the calls and diagnoses started on the backend
+	Requests int64
+	// Failures counts the ones that failed on it
+	Failures int64
+	// Failovers counts the failures moved to the next backend
+	Failovers int64
+}
+
+// aiBackend is the connection to a backend and its counters
+type aiBackend struct {
+	Backend
+	conn      *grpc.ClientConn
+	client    pb.AiServiceClient
+	requests  atomic.Int64
+	failures  atomic.Int64
+	failovers atomic.Int64
+}
+
+// ParseBackends parses an ordered list of backends, as "role=address,role=address".
+// Backends are named after their role, numbered when several share it.
+func ParseBackends(value string) ([]Backend, error) {
+	var backends []Backend
+	count := map[string]int{}
+	for _, entry := range strings.Split(value, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		role, addr, ok := strings.Cut(entry, "=")
+		role, addr = strings.TrimSpace(role), strings.TrimSpace(addr)
+		if !ok || addr == "" {
+			return nil, fmt.Errorf("invalid AI backend %q", entry)
+		}
+		if role != BackendPrimary && role != BackendFallback {
+			return nil, fmt.Errorf("AI backend role must be %s or %s, got %q", BackendPrimary, BackendFallback, role)
+		}
+
+		count[role]++
+		name := role
+		if count[role] > 1 {
+			name = fmt.Sprintf("%s-%d", role, count[role])
+		}
+		backends = append(backends, Backend{Name: name, Role: role, Addr: addr})
+	}
+	return backends, nil
+}
+
+// NewFailoverAiClient creates an AI gRPC client over an ordered list of backends.
+// A diagnosis that fails before its first chunk, or within window of its start, moves to the next backend;
+// the chunks received within the window are held back until then, so the switch is transparent.
+// The options are added to the connection of every backend, for example to connect in memory in tests.
+func NewFailoverAiClient(backends []Backend, window time.Duration, opts ...grpc.DialOption) (*AiClient, error) {
+	if len(backends) == 0 {
+		return nil, fmt.Errorf("no AI backend configured")
+	}
+
+	client := &AiClient{failoverWindow: window}
+	for _, backend := range backends {
+		// Set up a connection to the server
+		conn, err := grpc.NewClient(backend.Addr, append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)...)
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		client.backends = append(client.backends, &aiBackend{
+			Backend: backend,
+			conn:    conn,
+			client:  pb.NewAiServiceClient(conn),
+		})
+	}
+	return client, nil
+}
+
+// BackendStats reports the traffic of each backend, in order
+func (c *AiClient) BackendStats() []BackendStats {
+	stats := make([]BackendStats, len(c.backends))
+	for i, backend := range c.backends {
+		stats[i] = BackendStats{
+			Backend:   backend.Backend,
+			Requests:  backend.requests.Load(),
+			Failures:  backend.failures.Load(),
+			Failovers: backend.failovers.Load(),
+		}
+	}
+	return stats
+}
+
+// shouldFailover reports whether a failed request may succeed on another backend.
+// Requests the caller gave up on, and requests no backend would accept, are not retried.
+func shouldFailover(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Canceled, codes.InvalidArgument, codes.PermissionDenied, codes.Unauthenticated, codes.FailedPrecondition, codes.OutOfRange:
+		return false
+	}
+	return true
+}
+
+// isBackendFailure reports whether an error is the backend's fault: calls the caller cancelled or let expire
+// end with Canceled or DeadlineExceeded without the backend doing anything wrong
+func isBackendFailure(ctx context.Context, err error) bool {
+	if err == nil || err == io.EOF {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Canceled:
+		return false
+	case codes.DeadlineExceeded:
+		return ctx.Err() == nil
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// failover counts a failure of the backend at index i and reports whether the next one should be tried
+func (c *AiClient) failover(ctx context.Context, i int, err error) bool {
+	backend := c.backends[i]
+	if isBackendFailure(ctx, err) {
+		backend.failures.Add(1)
+	}
+	if i+1 >= len(c.backends) || !shouldFailover(ctx, err) {
+		return false
+	}
+
+	backend.failovers.Add(1)
+	log.Printf("AI backend %s failed, moving to %s: %v", backend.Name, c.backends[i+1].Name, err)
+	return true
+}
+
+// callBackends runs a unary call on each backend in turn, until one answers or fails for good
+func (c *AiClient) callBackends(ctx context.Context, call func(backend *aiBackend) error) error {
+	var err error
+	for i, backend := range c.backends {
+		backend.requests.Add(1)
+		if err = call(backend); err == nil || !c.failover(ctx, i, err) {
+			return err
+		}
+	}
+	return err
+}
+
+// failoverStream reads a diagnosis from the first backend that answers it.
+// Until it commits to a backend, it holds back the events, so a failure can still move to the next one.
+type failoverStream struct {
+	client  *AiClient
+	ctx     context.Context
+	req     *pb.DiagnoseRequest
+	current int
+	stream  pb.AiService_DiagnoseClient
+	started time.Time
+	// buffer holds the events received before committing, and committed whether failures are final
+	buffer    []*pb.DiagnoseResponse
+	committed bool
+	// err ends the stream once the buffer is read
+	err error
+}
+
+// openFailover starts a diagnosis on the first backend that accepts it
+func (c *AiClient) openFailover(ctx context.Context, req *pb.DiagnoseRequest) (*failoverStream, error) {
+	s := &failoverStream{client: c, ctx: ctx, req: req, current: -1}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// open starts the diagnosis on the next backend that accepts it
+func (s *failoverStream) open() error {
+	for {
+		s.current++
+		backend := s.client.backends[s.current]
+		backend.requests.Add(1)
+
+		stream, err := backend.client.Diagnose(s.ctx, s.req)
+		if err == nil {
+			s.stream = stream
+			s.started = time.Now()
+			s.buffer = nil
+			return nil
+		}
+		if !s.client.failover(s.ctx, s.current, err) {
+			return err
+		}
+	}
+}
+
+// Recv returns the next event of the diagnosis
+func (s *failoverStream) Recv() (*pb.DiagnoseResponse, error) {
+	for !s.committed {
+		resp, err := s.stream.Recv()
+		switch {
+		case err == io.EOF:
+			s.committed, s.err = true, err
+		case err != nil:
+			if !s.client.failover(s.ctx, s.current, err) {
+				// No backend left, the events received so far are still delivered
+				s.committed, s.err = true, err
+				break
+			}
+			if err := s.open(); err != nil {
+				return nil, err
+			}
+		default:
+			s.buffer = append(s.buffer, resp)
+			// The metadata alone does not commit, the first chunk does once the window is over
+			s.committed = resp.GetMetadata() == nil && time.Since(s.started) >= s.client.failoverWindow
+		}
+	}
+
+	if len(s.buffer) > 0 {
+		resp := s.buffer[0]
+		s.buffer = s.buffer[1:]
+		return resp, nil
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	resp, err := s.stream.Recv()
+	if isBackendFailure(s.ctx, err) {
+		s.client.backends[s.current].failures.Add(1)
+	}
+	return resp, err
+}
+
+// backend names the backend answering the diagnosis
+func (s *failoverStream) backend() string {
+	return s.client.backends[s.current].Name
+}
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "unb.br/web-server/src/proto"
+)
+
+// erroringStream is a diagnosis stream that fails its next Recv with err
+type erroringStream struct {
+	grpc.ClientStream
+	err error
+}
+
+func (s *erroringStream) Recv() (*pb.DiagnoseResponse, error) {
+	return nil, s.err
+}
+
+// testBackends returns a client with unconnected primary and fallback backends
+func testBackends() *AiClient {
+	return &AiClient{backends: []*aiBackend{
+		{Backend: Backend{Name: "primary", Role: "primary"}},
+		{Backend: Backend{Name: "fallback", Role: "fallback"}},
+	}}
+}
+
+func TestIsBackendFailure(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	live := context.Background()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{"end of stream", live, io.EOF, false},
+		{"unavailable", live, status.Error(codes.Unavailable, "connection refused"), true},
+		{"internal", live, status.Error(codes.Internal, "model crashed"), true},
+		{"cancelled by the caller", cancelled, status.Error(codes.Canceled, "context canceled"), false},
+		{"cancelled status", live, status.Error(codes.Canceled, "stream stopped"), false},
+		{"caller deadline", cancelled, status.Error(codes.DeadlineExceeded, "deadline exceeded"), false},
+		{"backend deadline", live, status.Error(codes.DeadlineExceeded, "upstream deadline exceeded"), true},
+		{"context error", live, context.Canceled, false},
+		{"wrapped context error", live, errors.Join(errors.New("read failed"), context.DeadlineExceeded), false},
+	}
+
+	for _, tt := range tests {
+		if got := isBackendFailure(tt.ctx, tt.err); got != tt.want {
+			t.Errorf("%s: isBackendFailure() = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFailoverCountsOnlyBackendFailures(t *testing.T) {
+	client := testBackends()
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if client.failover(cancelled, 0, status.Error(codes.Canceled, "context canceled")) {
+		t.Error("failover() moved a cancelled request to the next backend")
+	}
+	if failures := client.backends[0].failures.Load(); failures != 0 {
+		t.Errorf("failures after a cancellation = %d, want 0", failures)
+	}
+
+	if !client.failover(context.Background(), 0, status.Error(codes.Unavailable, "down")) {
+		t.Error("failover() kept an unavailable backend")
+	}
+	if failures, failovers := client.backends[0].failures.Load(), client.backends[0].failovers.Load(); failures != 1 || failovers != 1 {
+		t.Errorf("failures, failovers = %d, %d, want 1, 1", failures, failovers)
+	}
+}
+
+func TestFailoverStreamCommittedErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		cancel   bool
+		err      error
+		failures int64
+	}{
+		{"caller cancelled", true, status.Error(codes.Canceled, "context canceled"), 0},
+		{"caller deadline", true, status.Error(codes.DeadlineExceeded, "deadline exceeded"), 0},
+		{"backend failed", false, status.Error(codes.Internal, "model crashed"), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testBackends()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				cancel()
+			}
+
+			stream := &failoverStream{client: client, ctx: ctx, current: 0, stream: &erroringStream{err: tt.err}, committed: true}
+			if _, err := stream.Recv(); err != tt.err {
+				t.Fatalf("Recv() = %v, want %v", err, tt.err)
+			}
+			if failures := client.backends[0].failures.Load(); failures != tt.failures {
+				t.Errorf("failures = %d, want %d", failures, tt.failures)
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package grpc
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	pb "unb.br/web-server/src/proto"
+)
+
+// diagnosisFlightTimeout bounds an upstream diagnosis shared by several requests
+const diagnosisFlightTimeout = time.Minute * 5
+
+// diagnoseSource is where StreamDiagnose reads the events of a diagnosis from: the AI service or the cache
+type diagnoseSource interface {
+	Recv() (*pb.DiagnoseResponse, error)
+	// backend names the AI backend that answers, once the first event was received
+	backend() string
+}
+
+// DiagnosisCache keeps complete diagnoses for a while, replaying them to identical requests of the same user
+// with their original chunks. Identical requests arriving while a diagnosis is streamed share its upstream stream.
+// The token usage only reaches the request that started the upstream stream, as the others cost nothing,
+// or one of the requests still following it when that request left before the end.
+type DiagnosisCache struct {
+	ttl      time.Duration
+	maxBytes int
+
+	mu sync.Mutex
+	// entries indexes the cached diagnoses in lru, the most recently used first
+	entries map[string]*list.Element
+	lru     *list.List
+	size    int
+	// flights holds the diagnoses being streamed from the AI service
+	flights map[string]*diagnosisFlight
+}
+
+// cachedDiagnosis is a complete diagnosis kept in the cache
+type cachedDiagnosis struct {
+	key     string
+	backend string
+	events  []*pb.DiagnoseResponse
+	size    int
+	expires time.Time
+}
+
+// diagnosisFlight is a diagnosis being streamed from the AI service, shared by its subscribers
+type diagnosisFlight struct {
+	mu      sync.Mutex
+	backend string
+	events  []*pb.DiagnoseResponse
+	size    int
+	done    bool
+	err     error
+	// changed is closed and replaced on every event, waking up the subscribers
+	changed     chan struct{}
+	subscribers int
+	cancel      context.CancelFunc
+	// owner is the subscription receiving the usage, nil once it left and another one may take it
+	owner *diagnosisSubscription
+	// usageTaken tells whether a subscription received the usage
+	usageTaken bool
+}
+
+// NewDiagnosisCache creates a cache keeping diagnoses for ttl, within maxBytes of memory
+func NewDiagnosisCache(ttl time.Duration, maxBytes int) *DiagnosisCache {
+	return &DiagnosisCache{
+		ttl:      ttl,
+		maxBytes: maxBytes,
+		entries:  map[string]*list.Element{},
+		lru:      list.New(),
+		flights:  map[string]*diagnosisFlight{},
+	}
+}
+
+// SetDiagnosisCache replays identical diagnoses from a cache; nil disables it.
+// Only requests with a cache scope are cached, see DiagnoseInput.
+func (c *AiClient) SetDiagnosisCache(cache *DiagnosisCache) {
+	c.cache = cache
+}
+
+// diagnosisCacheKey hashes a request canonically, within the scope of a user
+func diagnosisCacheKey(scope string, req *pb.DiagnoseRequest) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(scope))
+	hash.Write([]byte{0})
+	hash.Write(data)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// open returns the events of a diagnosis: replayed from the cache, shared with a flight in progress
+// or streamed by a new flight started with start. The subscription must be closed once read.
+func (dc *DiagnosisCache) open(ctx context.Context, key string, start func(context.Context) (diagnoseSource, error)) (*diagnosisSubscription, error) {
+	dc.mu.Lock()
+
+	// Replay a cached diagnosis that has not expired
+	if element, ok := dc.entries[key]; ok {
+		entry := element.Value.(*cachedDiagnosis)
+		if time.Now().Before(entry.expires) {
+			dc.lru.MoveToFront(element)
+			dc.mu.Unlock()
+			return &diagnosisSubscription{ctx: ctx, events: entry.events, replayed: entry.backend}, nil
+		}
+		dc.remove(element)
+	}
+
+	// Share a diagnosis being streamed, unless everyone left it and it is being stopped
+	if flight, ok := dc.flights[key]; ok {
+		flight.mu.Lock()
+		joined := flight.subscribers > 0
+		if joined {
+			flight.subscribers++
+		}
+		flight.mu.Unlock()
+		if joined {
+			dc.mu.Unlock()
+			return &diagnosisSubscription{ctx: ctx, flight: flight}, nil
+		}
+	}
+
+	// Register a new flight before starting it, so identical requests join it while the AI service is called
+	// without the cache lock. The flight outlives the request that started it while other requests follow it.
+	flightCtx, cancel := context.WithTimeout(context.Background(), diagnosisFlightTimeout)
+	flight := &diagnosisFlight{
+		changed:     make(chan struct{}),
+		subscribers: 1,
+		cancel:      cancel,
+	}
+	subscription := &diagnosisSubscription{ctx: ctx, flight: flight}
+	flight.owner = subscription
+	dc.flights[key] = flight
+	dc.mu.Unlock()
+
+	stream, err := start(flightCtx)
+	if err != nil {
+		// The requests that joined meanwhile get the same error
+		dc.land(key, flight, err)
+		cancel()
+		return nil, err
+	}
+	go dc.run(key, flight, stream)
+
+	return subscription, nil
+}
+
+// run reads a flight from the AI service, then caches it when it completed
+func (dc *DiagnosisCache) run(key string, flight *diagnosisFlight, stream diagnoseSource) {
+	defer flight.cancel()
+
+	var err error
+	for {
+		var resp *pb.DiagnoseResponse
+		resp, err = stream.Recv()
+		if err != nil {
+			break
+		}
+
+		flight.mu.Lock()
+		flight.backend = stream.backend()
+		flight.events = append(flight.events, resp)
+		flight.size += proto.Size(resp)
+		close(flight.changed)
+		flight.changed = make(chan struct{})
+		flight.mu.Unlock()
+	}
+
+	dc.land(key, flight, err)
+}
+
+// land ends a flight with the error its stream ended with, waking up its subscribers,
+// and caches it when it completed
+func (dc *DiagnosisCache) land(key string, flight *diagnosisFlight, err error) {
+	flight.mu.Lock()
+	flight.done = true
+	flight.err = err
+	close(flight.changed)
+	events, size, backend := flight.events, flight.size, flight.backend
+	flight.mu.Unlock()
+
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	if dc.flights[key] == flight {
+		delete(dc.flights, key)
+	}
+	if err != io.EOF {
+		// Failed and stopped diagnoses are not cached
+		return
+	}
+	if size > dc.maxBytes {
+		log.Printf("Diagnosis of %d bytes is too large to cache", size)
+		return
+	}
+
+	dc.entries[key] = dc.lru.PushFront(&cachedDiagnosis{
+		key:     key,
+		backend: backend,
+		events:  events,
+		size:    size,
+		expires: time.Now().Add(dc.ttl),
+	})
+	dc.size += size
+	for dc.size > dc.maxBytes {
+		dc.remove(dc.lru.Back())
+	}
+}
+
+// remove drops a cached diagnosis; the cache lock must be held
+func (dc *DiagnosisCache) remove(element *list.Element) {
+	entry := dc.lru.Remove(element).(*cachedDiagnosis)
+	delete(dc.entries, entry.key)
+	dc.size -= entry.size
+}
+
+// diagnosisSubscription reads the events of a cached diagnosis or of a flight
+type diagnosisSubscription struct {
+	ctx    context.Context
+	events []*pb.DiagnoseResponse
+	flight *diagnosisFlight
+	next   int
+	closed bool
+	// replayed is the backend that answered a cached diagnosis
+	replayed string
+}
+
+// Recv returns the next event, leaving out the usage unless the subscription takes it
+func (s *diagnosisSubscription) Recv() (*pb.DiagnoseResponse, error) {
+	for {
+		resp, err := s.recv()
+		if err != nil || resp.GetUsage() == nil || s.takeUsage() {
+			return resp, err
+		}
+	}
+}
+
+// takeUsage reports whether the subscription receives the usage of its flight: the owner does,
+// or the first subscription to reach it once the owner left. Replayed diagnoses cost nothing.
+func (s *diagnosisSubscription) takeUsage() bool {
+	if s.flight == nil {
+		return false
+	}
+
+	s.flight.mu.Lock()
+	defer s.flight.mu.Unlock()
+	if s.flight.usageTaken || (s.flight.owner != nil && s.flight.owner != s) {
+		return false
+	}
+	s.flight.usageTaken = true
+	return true
+}
+
+// recv returns the next event, waiting for the flight when it has not arrived yet
+func (s *diagnosisSubscription) recv() (*pb.DiagnoseResponse, error) {
+	if err := s.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if s.flight == nil {
+		if s.next >= len(s.events) {
+			return nil, io.EOF
+		}
+		s.next++
+		return s.events[s.next-1], nil
+	}
+
+	for {
+		s.flight.mu.Lock()
+		if s.next < len(s.flight.events) {
+			resp := s.flight.events[s.next]
+			s.next++
+			s.flight.mu.Unlock()
+			return resp, nil
+		}
+		if s.flight.done {
+			err := s.flight.err
+			s.flight.mu.Unlock()
+			return nil, err
+		}
+		changed := s.flight.changed
+		s.flight.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-s.ctx.Done():
+			return nil, s.ctx.Err()
+		}
+	}
+}
+
+// backend names the backend that answered the diagnosis
+func (s *diagnosisSubscription) backend() string {
+	if s.flight == nil {
+		return s.replayed
+	}
+	s.flight.mu.Lock()
+	defer s.flight.mu.Unlock()
+	return s.flight.backend
+}
+
+// close leaves the flight, handing its usage over to the remaining subscribers when it owns it,
+// and stopping it when no one follows it anymore
+func (s *diagnosisSubscription) close() {
+	if s.flight == nil || s.closed {
+		return
+	}
+	s.closed = true
+
+	s.flight.mu.Lock()
+	defer s.flight.mu.Unlock()
+	if s.flight.owner == s {
+		s.flight.owner = nil
+	}
+	s.flight.subscribers--
+	if s.flight.subscribers == 0 && !s.flight.done {
+		s.flight.cancel()
+	}
+}
@@ -0,0 +1,311 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "unb.br/web-server/src/proto"
+)
+
+// contentEvent is a diagnosis event carrying markdown
+func contentEvent(content string) *pb.DiagnoseResponse {
+	return &pb.DiagnoseResponse{Event: &pb.DiagnoseResponse_Content{Content: content}}
+}
+
+// fakeSource streams the events sent on a channel, ending with io.EOF once it is closed
+type fakeSource struct {
+	ctx    context.Context
+	events chan *pb.DiagnoseResponse
+}
+
+func (s *fakeSource) Recv() (*pb.DiagnoseResponse, error) {
+	select {
+	case resp, ok := <-s.events:
+		if !ok {
+			return nil, io.EOF
+		}
+		return resp, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func (s *fakeSource) backend() string {
+	return "primary"
+}
+
+// fakeUpstream counts the flights started and streams their events from a shared channel
+type fakeUpstream struct {
+	starts atomic.Int32
+	events chan *pb.DiagnoseResponse
+	// release, when set, blocks the start of a flight until it is closed
+	release chan struct{}
+	err     error
+}
+
+func newFakeUpstream() *fakeUpstream {
+	return &fakeUpstream{events: make(chan *pb.DiagnoseResponse, 16)}
+}
+
+func (u *fakeUpstream) start(ctx context.Context) (diagnoseSource, error) {
+	u.starts.Add(1)
+	if u.release != nil {
+		<-u.release
+	}
+	if u.err != nil {
+		return nil, u.err
+	}
+	return &fakeSource{ctx: ctx, events: u.events}, nil
+}
+
+// readAll reads a subscription to its end, returning the contents and the error it ended with
+func readAll(t *testing.T, sub *diagnosisSubscription) (string, error) {
+	t.Helper()
+	defer sub.close()
+
+	var content string
+	for {
+		resp, err := sub.Recv()
+		if err == io.EOF {
+			return content, nil
+		}
+		if err != nil {
+			return content, err
+		}
+		content += resp.GetContent()
+	}
+}
+
+// readUsage reads a subscription to its end, returning the usage it received
+func readUsage(t *testing.T, sub *diagnosisSubscription) *pb.TokenUsage {
+	t.Helper()
+	defer sub.close()
+
+	var usage *pb.TokenUsage
+	for {
+		resp, err := sub.Recv()
+		if err == io.EOF {
+			return usage
+		}
+		if err != nil {
+			t.Fatalf("Recv() = %v", err)
+		}
+		if resp.GetUsage() != nil {
+			usage = resp.GetUsage()
+		}
+	}
+}
+
+func TestDiagnosisCacheHandsUsageOver(t *testing.T) {
+	tests := []struct {
+		name        string
+		ownerLeaves bool
+	}{
+		{"owner follows to the end", false},
+		{"owner leaves early", true},
+	}
+
+	for _, tt := range tests {
+		cache := NewDiagnosisCache(time.Minute, 1<<20)
+		upstream := newFakeUpstream()
+		ctx := context.Background()
+
+		var subs []*diagnosisSubscription
+		for range 3 {
+			sub, err := cache.open(ctx, "key", upstream.start)
+			if err != nil {
+				t.Fatalf("%s: open() = %v", tt.name, err)
+			}
+			subs = append(subs, sub)
+		}
+		owner := subs[0]
+		if tt.ownerLeaves {
+			owner.close()
+			subs = subs[1:]
+		}
+
+		upstream.events <- contentEvent("Procure um médico")
+		upstream.events <- &pb.DiagnoseResponse{Event: &pb.DiagnoseResponse_Usage{Usage: &pb.TokenUsage{PromptTokens: 10}}}
+		close(upstream.events)
+
+		// Exactly one request is billed: the owner, or another one once the owner left
+		var billed []*diagnosisSubscription
+		for _, sub := range subs {
+			if usage := readUsage(t, sub); usage != nil {
+				billed = append(billed, sub)
+			}
+		}
+		if len(billed) != 1 || (!tt.ownerLeaves && billed[0] != owner) {
+			t.Errorf("%s: %d subscriptions received the usage, want the owner or its successor only", tt.name, len(billed))
+		}
+	}
+}
+
+func TestDiagnosisCacheReplaysCompletedDiagnoses(t *testing.T) {
+	cache := NewDiagnosisCache(time.Minute, 1<<20)
+	upstream := newFakeUpstream()
+	ctx := context.Background()
+
+	sub, err := cache.open(ctx, "key", upstream.start)
+	if err != nil {
+		t.Fatalf("open() = %v", err)
+	}
+	upstream.events <- contentEvent("Repouso ")
+	upstream.events <- contentEvent("e hidratação")
+	close(upstream.events)
+	if content, err := readAll(t, sub); err != nil || content != "Repouso e hidratação" {
+		t.Fatalf("first read = %q, %v, want the diagnosis", content, err)
+	}
+
+	// The flight lands in the cache once its stream ends, which may happen after the last event was read
+	deadline := time.Now().Add(time.Second)
+	for {
+		sub, err := cache.open(ctx, "key", upstream.start)
+		if err != nil {
+			t.Fatalf("open() = %v", err)
+		}
+		if sub.flight == nil {
+			if content, err := readAll(t, sub); err != nil || content != "Repouso e hidratação" {
+				t.Fatalf("replay = %q, %v, want the cached diagnosis", content, err)
+			}
+			if sub.backend() != "primary" {
+				t.Errorf("backend() = %q, want the backend that answered", sub.backend())
+			}
+			break
+		}
+		sub.close()
+		if time.Now().After(deadline) {
+			t.Fatal("diagnosis was not cached")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if starts := upstream.starts.Load(); starts != 1 {
+		t.Errorf("upstream started %d times, want 1", starts)
+	}
+}
+
+func TestDiagnosisCacheJoinsFlightWhileStarting(t *testing.T) {
+	cache := NewDiagnosisCache(time.Minute, 1<<20)
+	upstream := newFakeUpstream()
+	upstream.release = make(chan struct{})
+	ctx := context.Background()
+
+	// The first request blocks in start; the cache must stay usable meanwhile
+	opened := make(chan *diagnosisSubscription)
+	go func() {
+		sub, err := cache.open(ctx, "key", upstream.start)
+		if err != nil {
+			t.Errorf("open() = %v", err)
+		}
+		opened <- sub
+	}()
+	for upstream.starts.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	joinedCh := make(chan *diagnosisSubscription)
+	go func() {
+		sub, _ := cache.open(ctx, "key", upstream.start)
+		joinedCh <- sub
+	}()
+	var joined *diagnosisSubscription
+	select {
+	case joined = <-joinedCh:
+	case <-time.After(time.Second):
+		t.Fatal("open() blocked while another flight was starting")
+	}
+	if joined.flight == nil || joined.flight.owner == joined {
+		t.Fatalf("second open() = %+v, want it to join the flight without owning it", joined)
+	}
+	other, err := cache.open(ctx, "other", func(flightCtx context.Context) (diagnoseSource, error) {
+		return &fakeSource{ctx: flightCtx, events: make(chan *pb.DiagnoseResponse)}, nil
+	})
+	if err != nil {
+		t.Fatalf("open() of another key = %v", err)
+	}
+	other.close()
+
+	close(upstream.release)
+	owner := <-opened
+	upstream.events <- contentEvent("Procure um médico")
+	upstream.events <- &pb.DiagnoseResponse{Event: &pb.DiagnoseResponse_Usage{Usage: &pb.TokenUsage{PromptTokens: 10}}}
+	close(upstream.events)
+
+	for _, sub := range []*diagnosisSubscription{owner, joined} {
+		if content, err := readAll(t, sub); err != nil || content != "Procure um médico" {
+			t.Errorf("read = %q, %v, want the shared diagnosis", content, err)
+		}
+	}
+	if starts := upstream.starts.Load(); starts != 1 {
+		t.Errorf("upstream started %d times, want 1", starts)
+	}
+}
+
+func TestDiagnosisCacheSharesStartErrors(t *testing.T) {
+	cache := NewDiagnosisCache(time.Minute, 1<<20)
+	upstream := newFakeUpstream()
+	upstream.release = make(chan struct{})
+	upstream.err = errors.New("unavailable")
+	ctx := context.Background()
+
+	failed := make(chan error)
+	go func() {
+		_, err := cache.open(ctx, "key", upstream.start)
+		failed <- err
+	}()
+	for upstream.starts.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	joined, err := cache.open(ctx, "key", upstream.start)
+	if err != nil {
+		t.Fatalf("open() = %v", err)
+	}
+
+	close(upstream.release)
+	if err := <-failed; err != upstream.err {
+		t.Fatalf("open() = %v, want the start error", err)
+	}
+	if _, err := readAll(t, joined); err != upstream.err {
+		t.Errorf("joined read = %v, want the start error", err)
+	}
+
+	// A failed flight is not cached, so the next request starts again
+	upstream.err = nil
+	sub, err := cache.open(ctx, "key", upstream.start)
+	if err != nil || sub.flight == nil {
+		t.Fatalf("open() after a failure = %+v, %v, want a new flight", sub, err)
+	}
+	sub.close()
+	if starts := upstream.starts.Load(); starts != 2 {
+		t.Errorf("upstream started %d times, want 2", starts)
+	}
+}
+
+func TestDiagnosisCacheExpires(t *testing.T) {
+	cache := NewDiagnosisCache(time.Minute, 1<<20)
+	ctx := context.Background()
+
+	// Store an entry directly, already past its expiry
+	flight := &diagnosisFlight{changed: make(chan struct{}), events: []*pb.DiagnoseResponse{contentEvent("antigo")}, size: 8}
+	cache.flights["key"] = flight
+	cache.land("key", flight, io.EOF)
+	cache.entries["key"].Value.(*cachedDiagnosis).expires = time.Now().Add(-time.Second)
+
+	upstream := newFakeUpstream()
+	sub, err := cache.open(ctx, "key", upstream.start)
+	if err != nil {
+		t.Fatalf("open() = %v", err)
+	}
+	if sub.flight == nil || upstream.starts.Load() != 1 {
+		t.Fatal("open() replayed an expired diagnosis")
+	}
+	if cache.size != 0 || len(cache.entries) != 0 {
+		t.Errorf("cache size, entries = %d, %d, want the expired entry removed", cache.size, len(cache.entries))
+	}
+	sub.close()
+}
@@ -0,0 +1,615 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	pb "unb.br/web-server/src/proto"
+)
+
+// Modes of the AI client connection
+const (
+	// AiModeLive calls the AI service
+	AiModeLive = "live"
+	// AiModeRecord calls the AI service and writes its answers to cassettes
+	AiModeRecord = "record"
+	// AiModeReplay answers from the cassettes without calling the AI service
+	AiModeReplay = "replay"
+)
+
+// Cassettes records the answers of the AI service to files, or replays them instead of calling the service.
+// Each cassette holds one request with its answer: the events of a diagnosis with their timing, or a unary response.
+// Chat turns are recorded and replayed as the diagnosis of the same request, so both APIs share the cassettes.
+// Replayed requests must match the recorded ones exactly.
+type Cassettes struct {
+	mode string
+	dir  string
+	// realTime keeps the recorded timing of the events when replaying, instead of sending them at once
+	realTime bool
+}
+
+// cassette is the file format of a recorded call, with the messages in the protobuf JSON format
+type cassette struct {
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Events   []cassetteEvent `json:"events,omitempty"`
+	Error    *cassetteError  `json:"error,omitempty"`
+}
+
+// cassetteEvent is a streamed event, received offset milliseconds after the call started
+type cassetteEvent struct {
+	Offset int64           `json:"offset_ms"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// cassetteError is the status a call failed with
+type cassetteError struct {
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message"`
+}
+
+// NewCassettes records to or replays from the cassettes in dir, as mode says.
+// It returns nil in the live mode, whose connection is not intercepted.
+func NewCassettes(mode, dir string, realTime bool) (*Cassettes, error) {
+	switch mode {
+	case "", AiModeLive:
+		return nil, nil
+	case AiModeRecord:
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create cassette directory: %v", err)
+		}
+	case AiModeReplay:
+		if _, err := os.Stat(dir); err != nil {
+			return nil, fmt.Errorf("failed to open cassette directory: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("AI mode must be %s, %s or %s, got %q", AiModeLive, AiModeRecord, AiModeReplay, mode)
+	}
+	return &Cassettes{mode: mode, dir: dir, realTime: realTime}, nil
+}
+
+// DialOptions intercepts the calls of an AI client connection, see NewAiClient; nil cassettes add no options
+func (c *Cassettes) DialOptions() []grpc.DialOption {
+	if c == nil {
+		return nil
+	}
+	if c.mode == AiModeReplay {
+		return []grpc.DialOption{
+			grpc.WithChainUnaryInterceptor(c.replayUnary),
+			grpc.WithChainStreamInterceptor(c.replayStream),
+		}
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(c.recordUnary),
+		grpc.WithChainStreamInterceptor(c.recordStream),
+	}
+}
+
+// path names the cassette of a request, after the method and a hash of the request
+func (c *Cassettes) path(method string, req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write(data)
+	name := fmt.Sprintf("%s-%s.json", strings.ToLower(path.Base(method)), hex.EncodeToString(hash.Sum(nil))[:16])
+	return filepath.Join(c.dir, name), nil
+}
+
+// load reads the cassette of a request, failing with NotFound when it was not recorded
+func (c *Cassettes) load(method string, req proto.Message) (*cassette, error) {
+	file, err := c.path(method, req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		log.Printf("No cassette for %s request, record it first: %s", method, file)
+		return nil, status.Errorf(codes.NotFound, "no cassette for %s request %s", method, filepath.Base(file))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var recorded cassette
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %v", file, err)
+	}
+	return &recorded, nil
+}
+
+// save writes a cassette, replacing the previous recording of the same request
+func (c *Cassettes) save(req proto.Message, recorded *cassette) {
+	file, err := c.path(recorded.Method, req)
+	if err != nil {
+		log.Printf("Failed to name cassette: %v", err)
+		return
+	}
+
+	data, err := json.MarshalIndent(recorded, "", "  ")
+	if err != nil {
+		log.Printf("Failed to encode cassette: %v", err)
+		return
+	}
+	// Write to a temporary file first, so a replay never reads half a cassette
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		log.Printf("Failed to write cassette: %v", err)
+		return
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		log.Printf("Failed to write cassette: %v", err)
+	}
+}
+
+// recordable reports whether a failure belongs to the answer: an application-level status the AI server replied
+// with. Transport failures, such as Unavailable, and the caller giving up are transient and not recorded.
+func recordable(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.PermissionDenied, codes.FailedPrecondition,
+		codes.OutOfRange, codes.Unimplemented, codes.Internal, codes.DataLoss, codes.Unauthenticated:
+		return true
+	}
+	return false
+}
+
+// newCassette starts recording a call
+func newCassette(method string, req proto.Message) (*cassette, error) {
+	data, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	return &cassette{Method: method, Request: data}, nil
+}
+
+// add records an event received at offset
+func (r *cassette) add(event proto.Message, offset time.Duration) {
+	data, err := protojson.Marshal(event)
+	if err != nil {
+		log.Printf("Failed to encode cassette event: %v", err)
+		return
+	}
+	r.Events = append(r.Events, cassetteEvent{Offset: offset.Milliseconds(), Event: data})
+}
+
+// fail records the status the call failed with
+func (r *cassette) fail(err error) {
+	st := status.Convert(err)
+	r.Error = &cassetteError{Code: st.Code(), Message: st.Message()}
+}
+
+// err returns the recorded failure, or nil
+func (r *cassette) err() error {
+	if r.Error == nil {
+		return nil
+	}
+	return status.Error(r.Error.Code, r.Error.Message)
+}
+
+// recordUnary records a unary call and its response or failure
+func (c *Cassettes) recordUnary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err != nil && !recordable(err) {
+		return err
+	}
+
+	recorded, cerr := newCassette(method, req.(proto.Message))
+	if cerr != nil {
+		log.Printf("Failed to encode cassette request: %v", cerr)
+		return err
+	}
+	if err != nil {
+		recorded.fail(err)
+	} else if recorded.Response, cerr = protojson.Marshal(reply.(proto.Message)); cerr != nil {
+		log.Printf("Failed to encode cassette response: %v", cerr)
+		return err
+	}
+	c.save(req.(proto.Message), recorded)
+	return err
+}
+
+// replayUnary answers a unary call from its cassette
+func (c *Cassettes) replayUnary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	recorded, err := c.load(method, req.(proto.Message))
+	if err != nil {
+		return err
+	}
+	if err := recorded.err(); err != nil {
+		return err
+	}
+	return protojson.Unmarshal(recorded.Response, reply.(proto.Message))
+}
+
+// recordStream records the diagnoses and the chat turns streamed by the AI service
+func (c *Cassettes) recordStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, err
+	}
+	switch method {
+	case pb.AiService_Diagnose_FullMethodName:
+		return &recordingDiagnosis{ClientStream: stream, cassettes: c, started: time.Now()}, nil
+	case pb.AiService_Chat_FullMethodName:
+		return &recordingChat{ClientStream: stream, cassettes: c, turns: map[string]*recordingTurn{}}, nil
+	}
+	return stream, nil
+}
+
+// replayStream answers the diagnoses and the chat turns from their cassettes
+func (c *Cassettes) replayStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	switch method {
+	case pb.AiService_Diagnose_FullMethodName:
+		return &replayDiagnosis{replayStreamBase: replayStreamBase{ctx: ctx}, cassettes: c, started: time.Now()}, nil
+	case pb.AiService_Chat_FullMethodName:
+		return newReplayChat(ctx, c), nil
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method %s cannot be replayed", method)
+}
+
+// wait sleeps until an event is due, unless replaying at once
+func (c *Cassettes) wait(ctx context.Context, started time.Time, event cassetteEvent) error {
+	if !c.realTime {
+		return nil
+	}
+	select {
+	case <-time.After(time.Until(started.Add(time.Duration(event.Offset) * time.Millisecond))):
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// recordingDiagnosis records a diagnosis once it ends
+type recordingDiagnosis struct {
+	grpc.ClientStream
+	cassettes *Cassettes
+	started   time.Time
+	req       proto.Message
+	recorded  *cassette
+}
+
+// SendMsg starts recording the request
+func (s *recordingDiagnosis) SendMsg(m any) error {
+	recorded, err := newCassette(pb.AiService_Diagnose_FullMethodName, m.(proto.Message))
+	if err != nil {
+		log.Printf("Failed to encode cassette request: %v", err)
+	} else {
+		s.req, s.recorded = proto.Clone(m.(proto.Message)), recorded
+	}
+	return s.ClientStream.SendMsg(m)
+}
+
+// RecvMsg records each event, and saves the cassette at the end of the stream
+func (s *recordingDiagnosis) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if s.recorded == nil {
+		return err
+	}
+
+	switch {
+	case err == nil:
+		s.recorded.add(m.(proto.Message), time.Since(s.started))
+		return nil
+	case err != io.EOF:
+		if !recordable(err) {
+			return err
+		}
+		s.recorded.fail(err)
+	}
+	s.cassettes.save(s.req, s.recorded)
+	s.recorded = nil
+	return err
+}
+
+// recordingTurn is a chat turn being recorded
+type recordingTurn struct {
+	req      *pb.DiagnoseRequest
+	started  time.Time
+	recorded *cassette
+}
+
+// recordingChat records the turns of a chat session as diagnoses; stopped turns are not recorded
+type recordingChat struct {
+	grpc.ClientStream
+	cassettes *Cassettes
+
+	mu    sync.Mutex
+	turns map[string]*recordingTurn
+}
+
+// SendMsg starts recording a turn, or drops it when it is stopped
+func (s *recordingChat) SendMsg(m any) error {
+	req := m.(*pb.ChatSessionRequest)
+	s.mu.Lock()
+	switch action := req.GetAction().(type) {
+	case *pb.ChatSessionRequest_Turn:
+		if recorded, err := newCassette(pb.AiService_Diagnose_FullMethodName, action.Turn); err == nil {
+			s.turns[req.GetTurnId()] = &recordingTurn{req: proto.Clone(action.Turn).(*pb.DiagnoseRequest), started: time.Now(), recorded: recorded}
+		} else {
+			log.Printf("Failed to encode cassette request: %v", err)
+		}
+	case *pb.ChatSessionRequest_Stop:
+		delete(s.turns, req.GetTurnId())
+	}
+	s.mu.Unlock()
+	return s.ClientStream.SendMsg(m)
+}
+
+// RecvMsg records each event of a turn, and saves the turn once it ends
+func (s *recordingChat) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		// A failed session fails the turns in progress
+		if err != io.EOF && recordable(err) {
+			for id, turn := range s.turns {
+				turn.recorded.fail(err)
+				s.cassettes.save(turn.req, turn.recorded)
+				delete(s.turns, id)
+			}
+		}
+		return err
+	}
+
+	resp := m.(*pb.ChatSessionResponse)
+	turn, ok := s.turns[resp.GetTurnId()]
+	if !ok {
+		return nil
+	}
+	event := &pb.DiagnoseResponse{}
+	switch e := resp.GetEvent().(type) {
+	case *pb.ChatSessionResponse_Content:
+		event.Event = &pb.DiagnoseResponse_Content{Content: e.Content}
+	case *pb.ChatSessionResponse_Summary:
+		event.Event = &pb.DiagnoseResponse_Summary{Summary: e.Summary}
+	case *pb.ChatSessionResponse_Metadata:
+		event.Event = &pb.DiagnoseResponse_Metadata{Metadata: e.Metadata}
+	case *pb.ChatSessionResponse_Usage:
+		event.Event = &pb.DiagnoseResponse_Usage{Usage: e.Usage}
+	case *pb.ChatSessionResponse_End:
+		if !e.End.GetStopped() {
+			s.cassettes.save(turn.req, turn.recorded)
+		}
+		delete(s.turns, resp.GetTurnId())
+		return nil
+	}
+	turn.recorded.add(event, time.Since(turn.started))
+	return nil
+}
+
+// replayStreamBase implements the parts of a client stream a replay does not use
+type replayStreamBase struct {
+	ctx context.Context
+}
+
+// Header returns no metadata
+func (s *replayStreamBase) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+// Trailer returns no metadata
+func (s *replayStreamBase) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+// Context returns the context of the call
+func (s *replayStreamBase) Context() context.Context {
+	return s.ctx
+}
+
+// replayDiagnosis streams a recorded diagnosis
+type replayDiagnosis struct {
+	replayStreamBase
+	cassettes *Cassettes
+	started   time.Time
+	req       proto.Message
+	recorded  *cassette
+	next      int
+}
+
+// SendMsg takes the request
+func (s *replayDiagnosis) SendMsg(m any) error {
+	s.req = proto.Clone(m.(proto.Message))
+	return nil
+}
+
+// CloseSend has nothing to close
+func (s *replayDiagnosis) CloseSend() error {
+	return nil
+}
+
+// RecvMsg returns the next recorded event when it is due, then the recorded end of the stream
+func (s *replayDiagnosis) RecvMsg(m any) error {
+	if s.recorded == nil {
+		recorded, err := s.cassettes.load(pb.AiService_Diagnose_FullMethodName, s.req)
+		if err != nil {
+			return err
+		}
+		s.recorded = recorded
+	}
+
+	if s.next >= len(s.recorded.Events) {
+		if err := s.recorded.err(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+	event := s.recorded.Events[s.next]
+	if err := s.cassettes.wait(s.ctx, s.started, event); err != nil {
+		return err
+	}
+	s.next++
+	return protojson.Unmarshal(event.Event, m.(proto.Message))
+}
+
+// replayChat answers the turns of a chat session from the diagnosis cassettes
+type replayChat struct {
+	replayStreamBase
+	cassettes *Cassettes
+	// responses carries the events of the turns, and is closed once the turns after CloseSend ended
+	responses chan *pb.ChatSessionResponse
+	// failed carries the first recorded failure, which ends the session
+	failed chan error
+
+	mu     sync.Mutex
+	stops  map[string]context.CancelFunc
+	turns  sync.WaitGroup
+	closed sync.Once
+}
+
+// newReplayChat starts replaying a chat session
+func newReplayChat(ctx context.Context, c *Cassettes) *replayChat {
+	return &replayChat{
+		replayStreamBase: replayStreamBase{ctx: ctx},
+		cassettes:        c,
+		responses:        make(chan *pb.ChatSessionResponse),
+		failed:           make(chan error, 1),
+		stops:            map[string]context.CancelFunc{},
+	}
+}
+
+// SendMsg starts replaying a turn, or stops one
+func (s *replayChat) SendMsg(m any) error {
+	req := m.(*pb.ChatSessionRequest)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch action := req.GetAction().(type) {
+	case *pb.ChatSessionRequest_Turn:
+		recorded, err := s.cassettes.load(pb.AiService_Diagnose_FullMethodName, action.Turn)
+		if err != nil {
+			s.fail(err)
+			return nil
+		}
+		turnCtx, stop := context.WithCancel(s.ctx)
+		s.stops[req.GetTurnId()] = stop
+		s.turns.Add(1)
+		go s.replayTurn(turnCtx, req.GetTurnId(), recorded)
+	case *pb.ChatSessionRequest_Stop:
+		if stop, ok := s.stops[req.GetTurnId()]; ok {
+			stop()
+		}
+	}
+	return nil
+}
+
+// replayTurn sends the recorded events of a turn, then its end
+func (s *replayChat) replayTurn(ctx context.Context, turnID string, recorded *cassette) {
+	defer s.turns.Done()
+	defer s.endTurn(turnID)
+	started := time.Now()
+
+	send := func(resp *pb.ChatSessionResponse) bool {
+		select {
+		case s.responses <- resp:
+			return true
+		case <-s.ctx.Done():
+			return false
+		}
+	}
+
+	for _, event := range recorded.Events {
+		if s.cassettes.wait(ctx, started, event) != nil {
+			break
+		}
+		var diagnosis pb.DiagnoseResponse
+		if err := protojson.Unmarshal(event.Event, &diagnosis); err != nil {
+			s.fail(err)
+			return
+		}
+
+		resp := &pb.ChatSessionResponse{TurnId: turnID}
+		switch e := diagnosis.GetEvent().(type) {
+		case *pb.DiagnoseResponse_Content:
+			resp.Event = &pb.ChatSessionResponse_Content{Content: e.Content}
+		case *pb.DiagnoseResponse_Summary:
+			resp.Event = &pb.ChatSessionResponse_Summary{Summary: e.Summary}
+		case *pb.DiagnoseResponse_Metadata:
+			resp.Event = &pb.ChatSessionResponse_Metadata{Metadata: e.Metadata}
+		case *pb.DiagnoseResponse_Usage:
+			resp.Event = &pb.ChatSessionResponse_Usage{Usage: e.Usage}
+		}
+		if !send(resp) {
+			return
+		}
+	}
+
+	stopped := ctx.Err() != nil
+	if err := recorded.err(); err != nil && !stopped {
+		s.fail(err)
+		return
+	}
+	send(&pb.ChatSessionResponse{TurnId: turnID, Event: &pb.ChatSessionResponse_End{End: &pb.TurnEnd{Stopped: stopped}}})
+}
+
+// endTurn forgets a turn that ended, so it can no longer be stopped
+func (s *replayChat) endTurn(turnID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stop, ok := s.stops[turnID]; ok {
+		stop()
+		delete(s.stops, turnID)
+	}
+}
+
+// fail ends the session with an error, keeping the first one
+func (s *replayChat) fail(err error) {
+	select {
+	case s.failed <- err:
+	default:
+	}
+}
+
+// CloseSend ends the session once the turns in progress end
+func (s *replayChat) CloseSend() error {
+	s.closed.Do(func() {
+		go func() {
+			s.turns.Wait()
+			close(s.responses)
+		}()
+	})
+	return nil
+}
+
+// RecvMsg returns the next event of the turns
+func (s *replayChat) RecvMsg(m any) error {
+	select {
+	case resp, ok := <-s.responses:
+		if !ok {
+			return io.EOF
+		}
+		proto.Reset(m.(proto.Message))
+		proto.Merge(m.(proto.Message), resp)
+		return nil
+	case err := <-s.failed:
+		return err
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "unb.br/web-server/src/proto"
+)
+
+func TestRecordable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{status.Error(codes.Internal, "model failed"), true},
+		{status.Error(codes.InvalidArgument, "bad request"), true},
+		{status.Error(codes.FailedPrecondition, "no key"), true},
+		{status.Error(codes.Unavailable, "connection refused"), false},
+		{status.Error(codes.ResourceExhausted, "rate limited"), false},
+		{status.Error(codes.Canceled, "context canceled"), false},
+		{status.Error(codes.DeadlineExceeded, "deadline exceeded"), false},
+		{io.ErrUnexpectedEOF, false},
+	}
+
+	for _, tt := range tests {
+		if got := recordable(tt.err); got != tt.want {
+			t.Errorf("recordable(%v) = %t, want %t", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestReplayChatForgetsEndedTurns(t *testing.T) {
+	cassettes, err := NewCassettes(AiModeReplay, t.TempDir(), false)
+	if err != nil {
+		t.Fatalf("NewCassettes() = %v", err)
+	}
+	turn := &pb.DiagnoseRequest{Messages: []*pb.Message{{Role: "user", Content: "Olá"}}}
+	recorded, err := newCassette(pb.AiService_Diagnose_FullMethodName, turn)
+	if err != nil {
+		t.Fatalf("newCassette() = %v", err)
+	}
+	recorded.add(contentEvent("Oi"), 0)
+	cassettes.save(turn, recorded)
+
+	chat := newReplayChat(context.Background(), cassettes)
+	for _, id := range []string{"1", "2", "3"} {
+		chat.SendMsg(&pb.ChatSessionRequest{TurnId: id, Action: &pb.ChatSessionRequest_Turn{Turn: turn}})
+	}
+	chat.CloseSend()
+
+	ends := 0
+	for {
+		var resp pb.ChatSessionResponse
+		err := chat.RecvMsg(&resp)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("RecvMsg() = %v", err)
+		}
+		if resp.GetEnd() != nil {
+			ends++
+		}
+	}
+	if ends != 3 {
+		t.Fatalf("got %d turn ends, want 3", ends)
+	}
+
+	chat.mu.Lock()
+	defer chat.mu.Unlock()
+	if len(chat.stops) != 0 {
+		t.Errorf("stops = %d entries after every turn ended, want none", len(chat.stops))
+	}
+}
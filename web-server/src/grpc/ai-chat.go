@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	pb "unb.br/web-server/src/proto"
+)
+
+// ChatEvent represents an event of a chat session turn; exactly one of Content, Summary, Metadata, Usage and End is set
+type ChatEvent struct {
+	TurnID   string
+	Content  string
+	Summary  *DiagnosisSummary
+	Metadata *DiagnoseMetadata
+	Usage    *TokenUsage
+	End      bool
+	// Stopped tells, on the end event, whether the turn was stopped before the answer was complete
+	Stopped bool
+}
+
+// ChatSession is a long-lived bidirectional chat with the AI service.
+// Sends are safe to call concurrently with each other and with Recv, which must be called from a single goroutine.
+type ChatSession struct {
+	stream pb.AiService_ChatClient
+	sendMu sync.Mutex
+	client *AiClient
+	// backend names the AI backend holding the session
+	backend string
+	// filters holds the output stream of each turn being answered
+	filters map[string]OutputStream
+	// pending holds the events to return before reading the stream again, and held a response read ahead of them
+	pending []*ChatEvent
+	held    *pb.ChatSessionResponse
+}
+
+// OpenChat starts a chat session on the first backend that accepts it,
+// which lasts until the context is cancelled or CloseSend is called.
+// A session stays on its backend; once it fails, the next one opened starts from the first backend again.
+func (c *AiClient) OpenChat(ctx context.Context) (*ChatSession, error) {
+	session := &ChatSession{client: c, filters: map[string]OutputStream{}}
+	err := c.callBackends(ctx, func(backend *aiBackend) (err error) {
+		session.stream, err = backend.client.Chat(ctx)
+		session.backend = backend.Name
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed to start chat session: %v", err)
+		return nil, err
+	}
+	return session, nil
+}
+
+// SendTurn asks for the answer to a turn, identified by the caller
+func (s *ChatSession) SendTurn(turnID string, input DiagnoseInput) error {
+	return s.send(&pb.ChatSessionRequest{
+		TurnId: turnID,
+		Action: &pb.ChatSessionRequest_Turn{Turn: diagnoseRequest(input)},
+	})
+}
+
+// Stop asks the AI service to stop answering a turn; the turn still ends with an end event
+func (s *ChatSession) Stop(turnID string) error {
+	return s.send(&pb.ChatSessionRequest{
+		TurnId: turnID,
+		Action: &pb.ChatSessionRequest_Stop{Stop: &pb.StopGeneration{}},
+	})
+}
+
+// Recv waits for the next event, returning io.EOF when the AI service closes the session.
+// Content goes through the output filter, which releases what it held back before the summary or the end of the turn.
+func (s *ChatSession) Recv() (*ChatEvent, error) {
+	for {
+		if len(s.pending) > 0 {
+			event := s.pending[0]
+			s.pending = s.pending[1:]
+			return event, nil
+		}
+
+		resp := s.held
+		s.held = nil
+		if resp == nil {
+			var err error
+			if resp, err = s.stream.Recv(); err != nil {
+				return nil, err
+			}
+		}
+
+		event := &ChatEvent{TurnID: resp.GetTurnId()}
+		filter, ok := s.filters[event.TurnID]
+		if !ok {
+			filter = s.client.newOutputStream()
+			s.filters[event.TurnID] = filter
+
+			// Every turn starts with the metadata, telling which backend answers even when the AI service sends none
+			if resp.GetMetadata() == nil {
+				s.held = resp
+				event.Metadata = &DiagnoseMetadata{Backend: s.backend}
+				return event, nil
+			}
+		}
+
+		switch {
+		case resp.GetMetadata() != nil:
+			event.Metadata = &DiagnoseMetadata{Model: resp.GetMetadata().Model, Backend: s.backend}
+			return event, nil
+		case resp.GetUsage() != nil:
+			event.Usage = &TokenUsage{
+				PromptTokens:     resp.GetUsage().PromptTokens,
+				CompletionTokens: resp.GetUsage().CompletionTokens,
+			}
+			return event, nil
+		case resp.GetSummary() != nil:
+			event.Summary = diagnosisSummaryFromPrompt(resp.GetSummary())
+		case resp.GetEnd() != nil:
+			event.End = true
+			event.Stopped = resp.GetEnd().GetStopped()
+		default:
+			// Content after the summary is ignored, like in StreamDiagnose
+			if filter == nil {
+				continue
+			}
+			event.Content = filter.Write(resp.GetContent())
+			if event.Content == "" {
+				continue
+			}
+			return event, nil
+		}
+
+		// The summary or the end of the turn comes after the rest of the markdown, flushed once per turn
+		s.pending = append(s.pending, event)
+		if event.End {
+			delete(s.filters, event.TurnID)
+		} else {
+			s.filters[event.TurnID] = nil
+		}
+		if filter != nil {
+			if rest := filter.Flush(); rest != "" {
+				return &ChatEvent{TurnID: event.TurnID, Content: rest}, nil
+			}
+		}
+	}
+}
+
+// CloseSend tells the AI service that no more turns will be sent
+func (s *ChatSession) CloseSend() error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.stream.CloseSend()
+}
+
+// send writes a request to the stream, one at a time as gRPC requires
+func (s *ChatSession) send(req *pb.ChatSessionRequest) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	if err := s.stream.Send(req); err != nil {
+		log.Printf("Failed to send to chat session: %v", err)
+		return err
+	}
+	return nil
+}
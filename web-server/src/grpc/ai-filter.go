@@ -0,0 +1,41 @@
+package grpc
+
+// OutputFilter checks the markdown of diagnoses before it reaches the client
+type OutputFilter interface {
+	// NewStream starts filtering a new reply
+	NewStream() OutputStream
+}
+
+// OutputStream filters the markdown of one reply. Text may be held back until enough follows it to be checked.
+type OutputStream interface {
+	// Write takes the next chunk and returns the text that is ready to be sent
+	Write(chunk string) string
+	// Flush returns the text still held back, followed by any mandatory footer when the reply is not empty
+	Flush() string
+}
+
+// SetOutputFilter filters the diagnoses streamed by StreamDiagnose and chat sessions; nil disables it
+func (c *AiClient) SetOutputFilter(filter OutputFilter) {
+	c.filter = filter
+}
+
+// newOutputStream starts filtering a reply, passing it through unchanged when no filter is set
+func (c *AiClient) newOutputStream() OutputStream {
+	if c.filter == nil {
+		return passthroughStream{}
+	}
+	return c.filter.NewStream()
+}
+
+// passthroughStream is the output stream used without a filter
+type passthroughStream struct{}
+
+// Write returns the chunk unchanged
+func (passthroughStream) Write(chunk string) string {
+	return chunk
+}
+
+// Flush has nothing held back
+func (passthroughStream) Flush() string {
+	return ""
+}
@@ -4,64 +4,160 @@ import (
 	"context"
 	"io"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	pb "unb.br/web-server/src/proto"
 )
 
-// PatientInfo represents the patient information
-type PatientInfoForPrompt struct {
-	Name   string
-	Age    int32
-	Gender string
-	Weight float32
-	Height float32
-}
-
 // Message represents a chat message between user and assistant
 type Message struct {
 	Role    string
 	Content string
 }
 
+// VitalTrend represents the aggregate of one measurement over the recent readings
+type VitalTrend struct {
+	Metric  string
+	Unit    string
+	Count   int32
+	Latest  float32
+	Average float32
+	Min     float32
+	Max     float32
+	Change  float32
+}
+
 // DiagnoseInput represents the input for the Diagnose method
 type DiagnoseInput struct {
-	PatientInfo PatientInfo
-	Messages    []Message
+	PatientInfo  PatientInfo
+	Messages     []Message
+	RecentVitals []VitalSigns
+	VitalTrends  []VitalTrend
+	// ConversationSummary stands for the earlier turns left out of Messages
+	ConversationSummary string
+	// CacheScope ties cached diagnoses to the requesting user; empty skips the cache
+	CacheScope string
+	// Model, Temperature, MaxTokens and Language tune the generation; unset ones use the AI server's defaults
+	Model       string
+	Temperature *float32
+	MaxTokens   int32
+	Language    string
 }
 
-// AiClient handles the communication with the AI gRPC server
-type AiClient struct {
-	conn   *grpc.ClientConn
-	client pb.AiServiceClient
+// SummarizeInput represents the input for the Summarize method
+type SummarizeInput struct {
+	PreviousSummary string
+	Messages        []Message
+	MaxTokens       int32
 }
 
-// NewAiClient creates a new AI gRPC client
-func NewAiClient(serverAddr string) (*AiClient, error) {
-	// Set up a connection to the server
-	conn, err := grpc.NewClient(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return nil, err
-	}
+// SummarizeOutput represents the output from the Summarize method
+type SummarizeOutput struct {
+	Summary string
+	// Model is the model that wrote the summary, as reported by the AI service
+	Model string
+	// Usage is the tokens billed for the summary, nil when the AI service sent none
+	Usage *TokenUsage
+}
 
-	// Create a new client
-	client := pb.NewAiServiceClient(conn)
-	return &AiClient{
-		conn:   conn,
-		client: client,
-	}, nil
+// ModerateInput represents the input for the Moderate method
+type ModerateInput struct {
+	Text string
+}
+
+// ModerateOutput represents the output from the Moderate method
+type ModerateOutput struct {
+	// Outcome is "allow", "warn" or "block"
+	Outcome    string
+	Categories []string
+}
+
+// DiagnoseOutput represents the output from the Diagnose method
+type DiagnoseOutput struct {
+	Content string
+	// Summary is the structured diagnosis sent after the content, nil when the AI service sent none
+	Summary *DiagnosisSummary
+	// Model is the model that answered, as reported by the AI service
+	Model string
+	// Backend names the AI backend that answered
+	Backend string
+	// Usage is the tokens billed for the diagnosis, nil when the AI service sent none or it was replayed from the cache
+	Usage *TokenUsage
+}
+
+// TokenUsage represents the tokens billed by the model provider for a generation
+type TokenUsage struct {
+	PromptTokens     int32
+	CompletionTokens int32
+}
+
+// DiagnoseMetadata describes how a diagnosis is generated, sent before its content
+type DiagnoseMetadata struct {
+	// Model is empty when the AI service does not report it
+	Model   string
+	Backend string
 }
 
-// Close closes the client connection
+// DiagnosisSummary represents the structured part of a diagnosis
+type DiagnosisSummary struct {
+	Differentials []Differential
+	// TriageLevel is "emergency", "urgent", "routine" or "self_care"
+	TriageLevel string
+	Specialties []string
+	RedFlags    []string
+	Disclaimers []string
+	NextSteps   []string
+}
+
+// Differential represents a candidate condition with its likelihood between 0 and 1
+type Differential struct {
+	Condition  string
+	Likelihood float32
+	Rationale  string
+}
+
+// DiagnoseWriter receives a diagnosis while it is streamed
+type DiagnoseWriter interface {
+	// WriteContent receives each markdown delta
+	WriteContent(content string) error
+	// WriteSummary receives the structured diagnosis, after the last delta
+	WriteSummary(summary DiagnosisSummary) error
+	// WriteMetadata receives the metadata, before the first delta
+	WriteMetadata(metadata DiagnoseMetadata) error
+}
+
+// AiClient handles the communication with the AI gRPC servers, moving to the next backend when one fails
+type AiClient struct {
+	backends []*aiBackend
+	// failoverWindow is how long a diagnosis can still move to the next backend after it started
+	failoverWindow time.Duration
+	// filter checks the streamed markdown when set, see SetOutputFilter
+	filter OutputFilter
+	// cache replays identical diagnoses when set, see SetDiagnosisCache
+	cache *DiagnosisCache
+}
+
+// NewAiClient creates a new AI gRPC client with a single backend
+func NewAiClient(serverAddr string, opts ...grpc.DialOption) (*AiClient, error) {
+	return NewFailoverAiClient([]Backend{{Name: BackendPrimary, Role: BackendPrimary, Addr: serverAddr}}, 0, opts...)
+}
+
+// Close closes the client connections
 func (c *AiClient) Close() error {
-	return c.conn.Close()
+	var firstErr error
+	for _, backend := range c.backends {
+		if err := backend.conn.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
 }
 
-// StreamDiagnose streams a diagnosis response to the Gin context
-func (c *AiClient) StreamDiagnose(ctx context.Context, ginCtx *gin.Context, input DiagnoseInput) error {
+// StreamDiagnose streams a diagnosis response to a writer, through the output filter if one is set.
+// The returned output holds everything written so far, even when an error interrupts the stream.
+func (c *AiClient) StreamDiagnose(ctx context.Context, writer DiagnoseWriter, input DiagnoseInput) (*DiagnoseOutput, error) {
 	// Create a context with timeout if none was provided
 	if ctx == nil {
 		var cancel context.CancelFunc
@@ -69,40 +165,33 @@ func (c *AiClient) StreamDiagnose(ctx context.Context, ginCtx *gin.Context, inpu
 		defer cancel()
 	}
 
-	// Convert the patient info to protobuf format
-	patientInfo := &pb.PatientInfoForPrompt{
-		Name:   input.PatientInfo.Name,
-		Age:    input.PatientInfo.Age,
-		Gender: input.PatientInfo.Gender,
-		Weight: input.PatientInfo.Weight,
-		Height: input.PatientInfo.Height,
-	}
+	// Convert the input to the protobuf format
+	req := diagnoseRequest(input)
 
-	// Convert the messages to protobuf format
-	pbMessages := make([]*pb.Message, len(input.Messages))
-	for i, msg := range input.Messages {
-		pbMessages[i] = &pb.Message{
-			Role:    msg.Role,
-			Content: msg.Content,
-		}
+	// Stream the response, from the cache when the request can use it
+	output := &DiagnoseOutput{}
+	stream, err := c.openDiagnosis(ctx, req, input.CacheScope)
+	if err != nil {
+		log.Printf("Failed to start diagnosis stream: %v", err)
+		return output, err
 	}
-
-	// Create the request
-	req := &pb.DiagnoseRequest{
-		PatientInfo: patientInfo,
-		Messages:    pbMessages,
+	if subscription, ok := stream.(*diagnosisSubscription); ok {
+		defer subscription.close()
 	}
 
-	// Configure gin to stream the response
-	ginCtx.Header("Content-Type", "text/plain")
-	ginCtx.Header("X-Content-Type-Options", "nosniff")
-	ginCtx.Status(200)
+	var content strings.Builder
+	filter := c.newOutputStream()
 
-	// Stream the response
-	stream, err := c.client.Diagnose(ctx, req)
-	if err != nil {
-		log.Printf("Failed to start diagnosis stream: %v", err)
-		return err
+	// write sends filtered markdown to the response, keeping what was sent
+	write := func(text string) error {
+		if text == "" {
+			return nil
+		}
+		if err := writer.WriteContent(text); err != nil {
+			return err
+		}
+		content.WriteString(text)
+		return nil
 	}
 
 	// Read from the stream and write to the response
@@ -114,19 +203,307 @@ func (c *AiClient) StreamDiagnose(ctx context.Context, ginCtx *gin.Context, inpu
 		}
 		if err != nil {
 			log.Printf("Error receiving from stream: %v", err)
-			return err
+			output.Content = content.String()
+			if output.Summary != nil {
+				// The diagnosis is complete, only its usage is missing
+				return output, nil
+			}
+			// Release what the filter held back, so a partial answer still ends with the footer
+			write(filter.Flush())
+			output.Content = content.String()
+			return output, err
+		}
+
+		// The usage is the last event of the stream
+		if usage := resp.GetUsage(); usage != nil {
+			output.Usage = &TokenUsage{
+				PromptTokens:     usage.PromptTokens,
+				CompletionTokens: usage.CompletionTokens,
+			}
+			continue
+		}
+
+		// The summary closes the markdown, any content after it is ignored
+		if output.Summary != nil {
+			continue
+		}
+
+		// The metadata tells which backend answers before anything else, even when the AI service sends none
+		if output.Backend == "" {
+			output.Backend = stream.backend()
+			output.Model = resp.GetMetadata().GetModel()
+			if err := writer.WriteMetadata(DiagnoseMetadata{Model: output.Model, Backend: output.Backend}); err != nil {
+				log.Printf("Error writing metadata to response: %v", err)
+				output.Content = content.String()
+				return output, err
+			}
+		}
+		if resp.GetMetadata() != nil {
+			continue
+		}
+
+		// The summary follows the rest of the markdown
+		if summary := resp.GetSummary(); summary != nil {
+			if err := write(filter.Flush()); err != nil {
+				log.Printf("Error writing to response: %v", err)
+				output.Content = content.String()
+				return output, err
+			}
+			output.Summary = diagnosisSummaryFromPrompt(summary)
+			if err := writer.WriteSummary(*output.Summary); err != nil {
+				log.Printf("Error writing summary to response: %v", err)
+				output.Content = content.String()
+				return output, err
+			}
+			continue
 		}
 
 		// Write the content chunk to the response
-		_, err = ginCtx.Writer.Write([]byte(resp.Content))
-		if err != nil {
+		if err := write(filter.Write(resp.GetContent())); err != nil {
 			log.Printf("Error writing to response: %v", err)
-			return err
+			output.Content = content.String()
+			return output, err
 		}
+	}
+
+	if output.Summary == nil {
+		if err := write(filter.Flush()); err != nil {
+			log.Printf("Error writing to response: %v", err)
+			output.Content = content.String()
+			return output, err
+		}
+	}
+	output.Content = content.String()
+	return output, nil
+}
 
-		// Flush to ensure the client receives data immediately
-		ginCtx.Writer.Flush()
+// openDiagnosis starts reading a diagnosis, through the cache when one is set and the request has a scope
+func (c *AiClient) openDiagnosis(ctx context.Context, req *pb.DiagnoseRequest, scope string) (diagnoseSource, error) {
+	if c.cache == nil || scope == "" {
+		return c.openFailover(ctx, req)
 	}
 
-	return nil
+	key, err := diagnosisCacheKey(scope, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.cache.open(ctx, key, func(ctx context.Context) (diagnoseSource, error) {
+		return c.openFailover(ctx, req)
+	})
+}
+
+// Summarize folds messages into the rolling summary of a conversation
+func (c *AiClient) Summarize(ctx context.Context, input SummarizeInput) (*SummarizeOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.SummarizeRequest{
+		PreviousSummary: input.PreviousSummary,
+		Messages:        messagesToProto(input.Messages),
+		MaxTokens:       input.MaxTokens,
+	}
+
+	// Send the request to the servers, in order
+	var resp *pb.SummarizeResponse
+	err := c.callBackends(ctx, func(backend *aiBackend) (err error) {
+		resp, err = backend.client.Summarize(ctx, req)
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed to summarize conversation: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	output := &SummarizeOutput{
+		Summary: resp.Summary,
+		Model:   resp.Model,
+	}
+	if usage := resp.GetUsage(); usage != nil {
+		output.Usage = &TokenUsage{
+			PromptTokens:     usage.PromptTokens,
+			CompletionTokens: usage.CompletionTokens,
+		}
+	}
+	return output, nil
+}
+
+// Moderate asks the AI service whether a user message is safe to diagnose
+func (c *AiClient) Moderate(ctx context.Context, input ModerateInput) (*ModerateOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.ModerateRequest{
+		Text: input.Text,
+	}
+
+	// Send the request to the servers, in order
+	var resp *pb.ModerateResponse
+	err := c.callBackends(ctx, func(backend *aiBackend) (err error) {
+		resp, err = backend.client.Moderate(ctx, req)
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed to moderate message: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	return &ModerateOutput{
+		Outcome:    resp.Outcome,
+		Categories: resp.Categories,
+	}, nil
+}
+
+// messagesToProto converts chat messages to the protobuf format
+func messagesToProto(messages []Message) []*pb.Message {
+	pbMessages := make([]*pb.Message, len(messages))
+	for i, msg := range messages {
+		pbMessages[i] = &pb.Message{
+			Role:    msg.Role,
+			Content: msg.Content,
+		}
+	}
+	return pbMessages
+}
+
+// diagnoseRequest converts a diagnosis input to the protobuf format
+func diagnoseRequest(input DiagnoseInput) *pb.DiagnoseRequest {
+	// Convert the patient info to protobuf format
+	patientInfo := patientInfoForPrompt(input.PatientInfo)
+
+	// Convert the messages to protobuf format
+	pbMessages := messagesToProto(input.Messages)
+
+	// Convert the vitals context to protobuf format
+	recentVitals := make([]*pb.VitalSignsForPrompt, len(input.RecentVitals))
+	for i, v := range input.RecentVitals {
+		recentVitals[i] = &pb.VitalSignsForPrompt{
+			RecordedAt:  v.RecordedAt.UnixMilli(),
+			Systolic:    v.Systolic,
+			Diastolic:   v.Diastolic,
+			HeartRate:   v.HeartRate,
+			Temperature: v.Temperature,
+			Spo2:        v.SpO2,
+			Glucose:     v.Glucose,
+			Weight:      v.Weight,
+		}
+	}
+
+	vitalTrends := make([]*pb.VitalTrendForPrompt, len(input.VitalTrends))
+	for i, trend := range input.VitalTrends {
+		vitalTrends[i] = &pb.VitalTrendForPrompt{
+			Metric:  trend.Metric,
+			Unit:    trend.Unit,
+			Count:   trend.Count,
+			Latest:  trend.Latest,
+			Average: trend.Average,
+			Min:     trend.Min,
+			Max:     trend.Max,
+			Change:  trend.Change,
+		}
+	}
+
+	return &pb.DiagnoseRequest{
+		PatientInfo:         patientInfo,
+		Messages:            pbMessages,
+		RecentVitals:        recentVitals,
+		VitalTrends:         vitalTrends,
+		ConversationSummary: input.ConversationSummary,
+		Model:               input.Model,
+		Temperature:         input.Temperature,
+		MaxTokens:           input.MaxTokens,
+		Language:            input.Language,
+	}
+}
+
+// diagnosisSummaryFromPrompt converts a structured diagnosis from the protobuf format
+func diagnosisSummaryFromPrompt(summary *pb.StructuredDiagnosis) *DiagnosisSummary {
+	differentials := make([]Differential, len(summary.GetDifferentials()))
+	for i, differential := range summary.GetDifferentials() {
+		differentials[i] = Differential{
+			Condition:  differential.GetCondition(),
+			Likelihood: differential.GetLikelihood(),
+			Rationale:  differential.GetRationale(),
+		}
+	}
+
+	return &DiagnosisSummary{
+		Differentials: differentials,
+		TriageLevel:   summary.GetTriageLevel(),
+		Specialties:   summary.GetSpecialties(),
+		RedFlags:      summary.GetRedFlags(),
+		Disclaimers:   summary.GetDisclaimers(),
+		NextSteps:     summary.GetNextSteps(),
+	}
+}
+
+// patientInfoForPrompt converts a patient's information to the protobuf format used in the AI prompt
+func patientInfoForPrompt(info PatientInfo) *pb.PatientInfoForPrompt {
+	allergies := make([]*pb.AllergyForPrompt, len(info.Allergies))
+	for i, allergy := range info.Allergies {
+		allergies[i] = &pb.AllergyForPrompt{
+			Substance: allergy.Substance,
+			Reaction:  allergy.Reaction,
+			Severity:  allergy.Severity,
+		}
+	}
+
+	medications := make([]*pb.MedicationForPrompt, len(info.Medications))
+	for i, medication := range info.Medications {
+		medications[i] = &pb.MedicationForPrompt{
+			Name:      medication.Name,
+			Dosage:    medication.Dosage,
+			Frequency: medication.Frequency,
+		}
+	}
+
+	conditions := make([]*pb.ConditionForPrompt, len(info.Conditions))
+	for i, condition := range info.Conditions {
+		conditions[i] = &pb.ConditionForPrompt{
+			Name:          condition.Name,
+			DiagnosedYear: condition.DiagnosedYear,
+			Notes:         condition.Notes,
+		}
+	}
+
+	surgeries := make([]*pb.SurgeryForPrompt, len(info.Surgeries))
+	for i, surgery := range info.Surgeries {
+		surgeries[i] = &pb.SurgeryForPrompt{
+			Procedure: surgery.Procedure,
+			Year:      surgery.Year,
+			Notes:     surgery.Notes,
+		}
+	}
+
+	familyHistory := make([]*pb.FamilyHistoryForPrompt, len(info.FamilyHistory))
+	for i, entry := range info.FamilyHistory {
+		familyHistory[i] = &pb.FamilyHistoryForPrompt{
+			Relative:  entry.Relative,
+			Condition: entry.Condition,
+		}
+	}
+
+	return &pb.PatientInfoForPrompt{
+		Name:          info.Name,
+		Age:           info.Age,
+		Gender:        info.Gender,
+		Weight:        info.Weight,
+		Height:        info.Height,
+		Allergies:     allergies,
+		Medications:   medications,
+		Conditions:    conditions,
+		Surgeries:     surgeries,
+		FamilyHistory: familyHistory,
+	}
 }
@@ -3,20 +3,62 @@ package grpc
 import (
 	"context"
 	"log"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	pb "unb.br/web-server/src/proto"
 )
 
 // PatientInfo represents a patient's information
 type PatientInfo struct {
-	Name   string
-	Age    int32
-	Gender string
-	Weight float32
-	Height float32
+	Name          string
+	Age           int32
+	Gender        string
+	Weight        float32
+	Height        float32
+	Allergies     []Allergy
+	Medications   []Medication
+	Conditions    []Condition
+	Surgeries     []Surgery
+	FamilyHistory []FamilyHistoryEntry
+}
+
+// Allergy represents a known allergy of a patient
+type Allergy struct {
+	Substance string
+	Reaction  string
+	Severity  string
+}
+
+// Medication represents a medication the patient currently takes
+type Medication struct {
+	Name      string
+	Dosage    string
+	Frequency string
+}
+
+// Condition represents a chronic condition of a patient
+type Condition struct {
+	Name          string
+	DiagnosedYear int32
+	Notes         string
+}
+
+// Surgery represents a past surgery of a patient
+type Surgery struct {
+	Procedure string
+	Year      int32
+	Notes     string
+}
+
+// FamilyHistoryEntry represents a condition found in the patient's family
+type FamilyHistoryEntry struct {
+	Relative  string
+	Condition string
 }
 
 // LoginInput represents the input for the Login method
@@ -41,15 +83,18 @@ type RegisterOutput struct {
 	Token string
 }
 
-// SavePatientInfoInput represents the input for the SavePatientInfo method
+// SavePatientInfoInput represents the input for the SavePatientInfo method.
+// A non-zero ExpectedVersion makes the save fail when the stored version differs.
 type SavePatientInfoInput struct {
-	Token       string
-	PatientInfo PatientInfo
+	Token           string
+	PatientInfo     PatientInfo
+	ExpectedVersion int64
 }
 
 // SavePatientInfoOutput represents the output from the SavePatientInfo method
 type SavePatientInfoOutput struct {
 	Success bool
+	Version int64
 }
 
 // GetPatientInput represents the input for the GetPatient method
@@ -60,18 +105,367 @@ type GetPatientInput struct {
 // GetPatientOutput represents the output from the GetPatient method
 type GetPatientOutput struct {
 	PatientInfo PatientInfo
+	Version     int64
+}
+
+// ChatMessage represents a stored conversation message
+type ChatMessage struct {
+	Role      string
+	Content   string
+	CreatedAt time.Time
+	// Summary is the structured diagnosis of an assistant reply, if any
+	Summary *DiagnosisSummary
+	// Truncated marks a reply that was stopped or interrupted before it was complete
+	Truncated bool
+}
+
+// Conversation represents a stored conversation
+type Conversation struct {
+	ID        string
+	Messages  []ChatMessage
+	CreatedAt time.Time
+	PatientID int32
+}
+
+// Account represents a user account
+type Account struct {
+	ID       int32
+	Username string
+	Role     string
+}
+
+// AuditEvent represents a stored audit event
+type AuditEvent struct {
+	ID        string
+	Time      time.Time
+	Actor     string
+	Action    string
+	Resource  string
+	Outcome   string
+	RequestID string
+	ClientIP  string
+	Detail    string
+}
+
+// SaveConversationInput represents the input for the SaveConversation method
+type SaveConversationInput struct {
+	Token          string
+	ConversationID string
+	Messages       []ChatMessage
+	// PatientID is zero for the account's own profile
+	PatientID int32
+}
+
+// SaveConversationOutput represents the output from the SaveConversation method
+type SaveConversationOutput struct {
+	Success bool
+}
+
+// ExportAccountInput represents the input for the ExportAccount method
+type ExportAccountInput struct {
+	Token string
+}
+
+// ExportAccountOutput represents the output from the ExportAccount method
+type ExportAccountOutput struct {
+	Account       Account
+	PatientInfo   *PatientInfo
+	Conversations []Conversation
+	AuditID       string
+	Patients      []PatientProfile
+}
+
+// DeleteAccountInput represents the input for the DeleteAccount method
+type DeleteAccountInput struct {
+	Token    string
+	Password string
+}
+
+// DeleteAccountOutput represents the output from the DeleteAccount method
+type DeleteAccountOutput struct {
+	Success bool
+	AuditID string
+}
+
+// GetAccountInput represents the input for the GetAccount method
+type GetAccountInput struct {
+	Token string
+}
+
+// GetAccountOutput represents the output from the GetAccount method
+type GetAccountOutput struct {
+	Account Account
+}
+
+// RecordAuditEventInput represents the input for the RecordAuditEvent method
+type RecordAuditEventInput struct {
+	Event AuditEvent
+}
+
+// RecordAuditEventOutput represents the output from the RecordAuditEvent method
+type RecordAuditEventOutput struct {
+	ID string
+}
+
+// ListAuditEventsInput represents the input for the ListAuditEvents method
+type ListAuditEventsInput struct {
+	Actor    string
+	Action   string
+	Resource string
+	Since    time.Time
+	Until    time.Time
+	Limit    int32
+}
+
+// ListAuditEventsOutput represents the output from the ListAuditEvents method
+type ListAuditEventsOutput struct {
+	Events []AuditEvent
+}
+
+// VitalSigns represents a set of measurements taken at the same time.
+// Unmeasured values are nil.
+type VitalSigns struct {
+	ID          string
+	RecordedAt  time.Time
+	Systolic    *int32
+	Diastolic   *int32
+	HeartRate   *int32
+	Temperature *float32
+	SpO2        *int32
+	Glucose     *float32
+	Weight      *float32
+}
+
+// RecordVitalsInput represents the input for the RecordVitals method.
+// A zero PatientID targets the account's own profile.
+type RecordVitalsInput struct {
+	Token     string
+	PatientID int32
+	Vitals    VitalSigns
+}
+
+// RecordVitalsOutput represents the output from the RecordVitals method
+type RecordVitalsOutput struct {
+	ID string
+}
+
+// ListVitalsInput represents the input for the ListVitals method.
+// A zero PatientID targets the account's own profile.
+type ListVitalsInput struct {
+	Token     string
+	PatientID int32
+	Since     time.Time
+	Until     time.Time
+	Limit     int32
+}
+
+// ListVitalsOutput represents the output from the ListVitals method
+type ListVitalsOutput struct {
+	Vitals []VitalSigns
+}
+
+// IsVersionConflict reports whether a save failed because the stored version changed
+func IsVersionConflict(err error) bool {
+	return status.Code(err) == codes.FailedPrecondition
+}
+
+// IsNotFound reports whether a request failed because the record does not exist
+func IsNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// IsPermissionDenied reports whether a request failed because the record belongs to another account
+func IsPermissionDenied(err error) bool {
+	return status.Code(err) == codes.PermissionDenied
+}
+
+// IsUnauthenticated reports whether a request failed because of an invalid token or password
+func IsUnauthenticated(err error) bool {
+	return status.Code(err) == codes.Unauthenticated
+}
+
+// IsUnavailable reports whether a request failed because the database server could not be reached
+func IsUnavailable(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
+
+// PatientProfile represents a patient profile managed by an account
+type PatientProfile struct {
+	ID           int32
+	OwnerID      int32
+	Relationship string
+	PatientInfo  PatientInfo
+	Version      int64
+}
+
+// ListPatientsInput represents the input for the ListPatients method
+type ListPatientsInput struct {
+	Token string
+}
+
+// ListPatientsOutput represents the output from the ListPatients method
+type ListPatientsOutput struct {
+	Patients []PatientProfile
+}
+
+// CreatePatientInput represents the input for the CreatePatient method
+type CreatePatientInput struct {
+	Token        string
+	Relationship string
+	PatientInfo  PatientInfo
+}
+
+// CreatePatientOutput represents the output from the CreatePatient method
+type CreatePatientOutput struct {
+	Patient PatientProfile
+}
+
+// GetPatientByIDInput represents the input for the GetPatientByID method
+type GetPatientByIDInput struct {
+	Token     string
+	PatientID int32
+}
+
+// GetPatientByIDOutput represents the output from the GetPatientByID method
+type GetPatientByIDOutput struct {
+	Patient PatientProfile
+}
+
+// UpdatePatientInput represents the input for the UpdatePatient method.
+// A non-zero ExpectedVersion makes the update fail when the stored version differs.
+type UpdatePatientInput struct {
+	Token           string
+	PatientID       int32
+	Relationship    string
+	PatientInfo     PatientInfo
+	ExpectedVersion int64
+}
+
+// UpdatePatientOutput represents the output from the UpdatePatient method
+type UpdatePatientOutput struct {
+	Patient PatientProfile
+}
+
+// DeletePatientInput represents the input for the DeletePatient method
+type DeletePatientInput struct {
+	Token     string
+	PatientID int32
+}
+
+// DeletePatientOutput represents the output from the DeletePatient method
+type DeletePatientOutput struct {
+	Success bool
+}
+
+// FlagConversationInput represents the input for the FlagConversation method
+type FlagConversationInput struct {
+	Token          string
+	ConversationID string
+	PatientID      int32
+	Reason         string
+	RuleIDs        []string
+	Severity       string
+}
+
+// FlagConversationOutput represents the output from the FlagConversation method
+type FlagConversationOutput struct {
+	Success bool
+}
+
+// ConversationSummary represents the cached rolling summary of a conversation
+type ConversationSummary struct {
+	Summary string
+	// MessageCount is how many messages, from the start of the conversation, the summary covers
+	MessageCount int32
+	// MessagesHash identifies those messages, to detect a history that changed
+	MessagesHash string
+	UpdatedAt    time.Time
+}
+
+// GetConversationSummaryInput represents the input for the GetConversationSummary method
+type GetConversationSummaryInput struct {
+	Token          string
+	ConversationID string
+}
+
+// GetConversationSummaryOutput represents the output from the GetConversationSummary method
+type GetConversationSummaryOutput struct {
+	// Summary is nil when the conversation has no summary yet
+	Summary *ConversationSummary
+}
+
+// SaveConversationSummaryInput represents the input for the SaveConversationSummary method
+type SaveConversationSummaryInput struct {
+	Token          string
+	ConversationID string
+	Summary        ConversationSummary
+}
+
+// SaveConversationSummaryOutput represents the output from the SaveConversationSummary method
+type SaveConversationSummaryOutput struct {
+	Success bool
+}
+
+// UsageRecord represents the tokens used by a diagnosis
+type UsageRecord struct {
+	AccountID        int32
+	ConversationID   string
+	Model            string
+	PromptTokens     int32
+	CompletionTokens int32
+	Time             time.Time
+}
+
+// UsageTotal represents the usage of an account summed per conversation and model
+type UsageTotal struct {
+	AccountID        int32
+	ConversationID   string
+	Model            string
+	PromptTokens     int64
+	CompletionTokens int64
+	Requests         int32
+}
+
+// RecordUsageInput represents the input for the RecordUsage method
+type RecordUsageInput struct {
+	Record UsageRecord
+}
+
+// RecordUsageOutput represents the output from the RecordUsage method
+type RecordUsageOutput struct {
+	Success bool
+}
+
+// ListUsageInput represents the input for the ListUsage method.
+// A zero AccountID lists the usage of every account.
+type ListUsageInput struct {
+	AccountID int32
+	Since     time.Time
+	Until     time.Time
+}
+
+// ListUsageOutput represents the output from the ListUsage method
+type ListUsageOutput struct {
+	Totals []UsageTotal
 }
 
 // DatabaseClient handles the communication with the Database gRPC server
 type DatabaseClient struct {
 	conn   *grpc.ClientConn
 	client pb.DatabaseServiceClient
+	// cipher encrypts sensitive fields when set, see SetFieldCipher
+	cipher FieldCipher
+	// serviceToken authenticates the calls the web server makes on its own behalf, see SetServiceToken
+	serviceToken string
+	// owners caches the account behind each token, see ownerOf
+	ownersMu sync.Mutex
+	owners   map[string]int32
 }
 
-// NewDatabaseClient creates a new Database gRPC client
-func NewDatabaseClient(serverAddr string) (*DatabaseClient, error) {
+// NewDatabaseClient creates a new Database gRPC client; the options are added to the connection
+func NewDatabaseClient(serverAddr string, opts ...grpc.DialOption) (*DatabaseClient, error) {
 	// Set up a connection to the server
-	conn, err := grpc.NewClient(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(serverAddr, append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +478,12 @@ func NewDatabaseClient(serverAddr string) (*DatabaseClient, error) {
 	}, nil
 }
 
+// SetServiceToken sets the token shared with the database server that authenticates the audit and usage RPCs,
+// which are not made on behalf of a user
+func (c *DatabaseClient) SetServiceToken(token string) {
+	c.serviceToken = token
+}
+
 // Close closes the client connection
 func (c *DatabaseClient) Close() error {
 	return c.conn.Close()
@@ -154,16 +554,21 @@ func (c *DatabaseClient) SavePatientInfo(ctx context.Context, input SavePatientI
 		defer cancel()
 	}
 
-	// Convert the input to the protobuf format
+	// Encrypt the sensitive fields for the owning account, then convert the input to the protobuf format
+	owner, err := c.ownerOf(ctx, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	patientInfo, err := c.encryptPatientInfo(input.PatientInfo, owner)
+	if err != nil {
+		log.Printf("Failed to encrypt patient info: %v", err)
+		return nil, err
+	}
+
 	req := &pb.SavePatientInfoRequest{
-		Token: input.Token,
-		PatientInfo: &pb.PatientInfo{
-			Name:   input.PatientInfo.Name,
-			Age:    input.PatientInfo.Age,
-			Gender: input.PatientInfo.Gender,
-			Weight: input.PatientInfo.Weight,
-			Height: input.PatientInfo.Height,
-		},
+		Token:           input.Token,
+		PatientInfo:     patientInfoToProto(patientInfo),
+		ExpectedVersion: input.ExpectedVersion,
 	}
 
 	// Send the request to the server
@@ -176,6 +581,7 @@ func (c *DatabaseClient) SavePatientInfo(ctx context.Context, input SavePatientI
 	// Convert the response to the output format
 	return &SavePatientInfoOutput{
 		Success: resp.Success,
+		Version: resp.Version,
 	}, nil
 }
 
@@ -200,14 +606,956 @@ func (c *DatabaseClient) GetPatient(ctx context.Context, input GetPatientInput)
 		return nil, err
 	}
 
-	// Convert the response to the output format
+	// Convert the response to the output format, decrypting the sensitive fields
+	owner, err := c.ownerOf(ctx, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	patientInfo, err := c.decryptPatientInfo(patientInfoFromProto(resp.PatientInfo), owner)
+	if err != nil {
+		log.Printf("Failed to decrypt patient info: %v", err)
+		return nil, err
+	}
+
 	return &GetPatientOutput{
-		PatientInfo: PatientInfo{
-			Name:   resp.PatientInfo.Name,
-			Age:    resp.PatientInfo.Age,
-			Gender: resp.PatientInfo.Gender,
-			Weight: resp.PatientInfo.Weight,
-			Height: resp.PatientInfo.Height,
+		PatientInfo: patientInfo,
+		Version:     resp.Version,
+	}, nil
+}
+
+// SaveConversation appends messages to a conversation
+func (c *DatabaseClient) SaveConversation(ctx context.Context, input SaveConversationInput) (*SaveConversationOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Encrypt the message content for the owning account, then convert the input to the protobuf format
+	owner, err := c.ownerOf(ctx, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := c.encryptMessages(input.Messages, owner)
+	if err != nil {
+		log.Printf("Failed to encrypt conversation: %v", err)
+		return nil, err
+	}
+
+	pbMessages := make([]*pb.ChatMessage, len(messages))
+	for i, msg := range messages {
+		pbMessages[i] = &pb.ChatMessage{
+			Role:      msg.Role,
+			Content:   msg.Content,
+			CreatedAt: msg.CreatedAt.UnixMilli(),
+			Summary:   diagnosisSummaryToProto(msg.Summary),
+			Truncated: msg.Truncated,
+		}
+	}
+
+	req := &pb.SaveConversationRequest{
+		Token:          input.Token,
+		ConversationId: input.ConversationID,
+		Messages:       pbMessages,
+		PatientId:      input.PatientID,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.SaveConversation(ctx, req)
+	if err != nil {
+		log.Printf("Failed to save conversation: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	return &SaveConversationOutput{
+		Success: resp.Success,
+	}, nil
+}
+
+// ExportAccount retrieves every record tied to an account
+func (c *DatabaseClient) ExportAccount(ctx context.Context, input ExportAccountInput) (*ExportAccountOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.ExportAccountRequest{
+		Token: input.Token,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.ExportAccount(ctx, req)
+	if err != nil {
+		log.Printf("Failed to export account: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	output := &ExportAccountOutput{
+		Account: Account{
+			ID:       resp.GetAccount().GetId(),
+			Username: resp.GetAccount().GetUsername(),
+			Role:     resp.GetAccount().GetRole(),
+		},
+		Conversations: make([]Conversation, len(resp.Conversations)),
+		AuditID:       resp.AuditId,
+	}
+
+	// The patient profile is optional, accounts may not have filled it in yet
+	if resp.PatientInfo != nil {
+		patientInfo, err := c.decryptPatientInfo(patientInfoFromProto(resp.PatientInfo), output.Account.ID)
+		if err != nil {
+			log.Printf("Failed to decrypt patient info: %v", err)
+			return nil, err
+		}
+		output.PatientInfo = &patientInfo
+	}
+
+	for i, conv := range resp.Conversations {
+		messages := make([]ChatMessage, len(conv.Messages))
+		for j, msg := range conv.Messages {
+			messages[j] = ChatMessage{
+				Role:      msg.Role,
+				Content:   msg.Content,
+				CreatedAt: time.UnixMilli(msg.CreatedAt),
+				Summary:   diagnosisSummaryFromProto(msg.Summary),
+				Truncated: msg.Truncated,
+			}
+		}
+		messages, err := c.decryptMessages(messages, output.Account.ID)
+		if err != nil {
+			log.Printf("Failed to decrypt conversation %s: %v", conv.Id, err)
+			return nil, err
+		}
+		output.Conversations[i] = Conversation{
+			ID:        conv.Id,
+			Messages:  messages,
+			CreatedAt: time.UnixMilli(conv.CreatedAt),
+			PatientID: conv.PatientId,
+		}
+	}
+
+	for _, profile := range resp.Patients {
+		patient, err := c.decryptPatientProfile(patientProfileFromProto(profile))
+		if err != nil {
+			log.Printf("Failed to decrypt patient %d: %v", profile.GetId(), err)
+			return nil, err
+		}
+		output.Patients = append(output.Patients, patient)
+	}
+
+	return output, nil
+}
+
+// DeleteAccount erases an account after re-authenticating the user
+func (c *DatabaseClient) DeleteAccount(ctx context.Context, input DeleteAccountInput) (*DeleteAccountOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.DeleteAccountRequest{
+		Token:    input.Token,
+		Password: input.Password,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.DeleteAccount(ctx, req)
+	if err != nil {
+		log.Printf("Failed to delete account: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	return &DeleteAccountOutput{
+		Success: resp.Success,
+		AuditID: resp.AuditId,
+	}, nil
+}
+
+// GetAccount retrieves the account that owns a token
+func (c *DatabaseClient) GetAccount(ctx context.Context, input GetAccountInput) (*GetAccountOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.GetAccountRequest{
+		Token: input.Token,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.GetAccount(ctx, req)
+	if err != nil {
+		log.Printf("Failed to get account: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	return &GetAccountOutput{
+		Account: Account{
+			ID:       resp.GetAccount().GetId(),
+			Username: resp.GetAccount().GetUsername(),
+			Role:     resp.GetAccount().GetRole(),
 		},
 	}, nil
 }
+
+// RecordAuditEvent appends an event to the audit log
+func (c *DatabaseClient) RecordAuditEvent(ctx context.Context, input RecordAuditEventInput) (*RecordAuditEventOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.RecordAuditEventRequest{
+		Event: &pb.AuditEvent{
+			Time:      input.Event.Time.UnixMilli(),
+			Actor:     input.Event.Actor,
+			Action:    input.Event.Action,
+			Resource:  input.Event.Resource,
+			Outcome:   input.Event.Outcome,
+			RequestId: input.Event.RequestID,
+			ClientIp:  input.Event.ClientIP,
+			Detail:    input.Event.Detail,
+		},
+		ServiceToken: c.serviceToken,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.RecordAuditEvent(ctx, req)
+	if err != nil {
+		log.Printf("Failed to record audit event: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	return &RecordAuditEventOutput{
+		ID: resp.Id,
+	}, nil
+}
+
+// ListAuditEvents retrieves audit events matching a filter
+func (c *DatabaseClient) ListAuditEvents(ctx context.Context, input ListAuditEventsInput) (*ListAuditEventsOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.ListAuditEventsRequest{
+		Actor:        input.Actor,
+		Action:       input.Action,
+		Resource:     input.Resource,
+		Limit:        input.Limit,
+		ServiceToken: c.serviceToken,
+	}
+	if !input.Since.IsZero() {
+		req.Since = input.Since.UnixMilli()
+	}
+	if !input.Until.IsZero() {
+		req.Until = input.Until.UnixMilli()
+	}
+
+	// Send the request to the server
+	resp, err := c.client.ListAuditEvents(ctx, req)
+	if err != nil {
+		log.Printf("Failed to list audit events: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	events := make([]AuditEvent, len(resp.Events))
+	for i, event := range resp.Events {
+		events[i] = AuditEvent{
+			ID:        event.Id,
+			Time:      time.UnixMilli(event.Time),
+			Actor:     event.Actor,
+			Action:    event.Action,
+			Resource:  event.Resource,
+			Outcome:   event.Outcome,
+			RequestID: event.RequestId,
+			ClientIP:  event.ClientIp,
+			Detail:    event.Detail,
+		}
+	}
+
+	return &ListAuditEventsOutput{
+		Events: events,
+	}, nil
+}
+
+// patientInfoToProto converts a patient's information to the protobuf format
+func patientInfoToProto(info PatientInfo) *pb.PatientInfo {
+	allergies := make([]*pb.Allergy, len(info.Allergies))
+	for i, allergy := range info.Allergies {
+		allergies[i] = &pb.Allergy{
+			Substance: allergy.Substance,
+			Reaction:  allergy.Reaction,
+			Severity:  allergy.Severity,
+		}
+	}
+
+	medications := make([]*pb.Medication, len(info.Medications))
+	for i, medication := range info.Medications {
+		medications[i] = &pb.Medication{
+			Name:      medication.Name,
+			Dosage:    medication.Dosage,
+			Frequency: medication.Frequency,
+		}
+	}
+
+	conditions := make([]*pb.Condition, len(info.Conditions))
+	for i, condition := range info.Conditions {
+		conditions[i] = &pb.Condition{
+			Name:          condition.Name,
+			DiagnosedYear: condition.DiagnosedYear,
+			Notes:         condition.Notes,
+		}
+	}
+
+	surgeries := make([]*pb.Surgery, len(info.Surgeries))
+	for i, surgery := range info.Surgeries {
+		surgeries[i] = &pb.Surgery{
+			Procedure: surgery.Procedure,
+			Year:      surgery.Year,
+			Notes:     surgery.Notes,
+		}
+	}
+
+	familyHistory := make([]*pb.FamilyHistoryEntry, len(info.FamilyHistory))
+	for i, entry := range info.FamilyHistory {
+		familyHistory[i] = &pb.FamilyHistoryEntry{
+			Relative:  entry.Relative,
+			Condition: entry.Condition,
+		}
+	}
+
+	return &pb.PatientInfo{
+		Name:          info.Name,
+		Age:           info.Age,
+		Gender:        info.Gender,
+		Weight:        info.Weight,
+		Height:        info.Height,
+		Allergies:     allergies,
+		Medications:   medications,
+		Conditions:    conditions,
+		Surgeries:     surgeries,
+		FamilyHistory: familyHistory,
+	}
+}
+
+// patientInfoFromProto converts a patient's information from the protobuf format
+func patientInfoFromProto(info *pb.PatientInfo) PatientInfo {
+	allergies := make([]Allergy, len(info.GetAllergies()))
+	for i, allergy := range info.GetAllergies() {
+		allergies[i] = Allergy{
+			Substance: allergy.Substance,
+			Reaction:  allergy.Reaction,
+			Severity:  allergy.Severity,
+		}
+	}
+
+	medications := make([]Medication, len(info.GetMedications()))
+	for i, medication := range info.GetMedications() {
+		medications[i] = Medication{
+			Name:      medication.Name,
+			Dosage:    medication.Dosage,
+			Frequency: medication.Frequency,
+		}
+	}
+
+	conditions := make([]Condition, len(info.GetConditions()))
+	for i, condition := range info.GetConditions() {
+		conditions[i] = Condition{
+			Name:          condition.Name,
+			DiagnosedYear: condition.DiagnosedYear,
+			Notes:         condition.Notes,
+		}
+	}
+
+	surgeries := make([]Surgery, len(info.GetSurgeries()))
+	for i, surgery := range info.GetSurgeries() {
+		surgeries[i] = Surgery{
+			Procedure: surgery.Procedure,
+			Year:      surgery.Year,
+			Notes:     surgery.Notes,
+		}
+	}
+
+	familyHistory := make([]FamilyHistoryEntry, len(info.GetFamilyHistory()))
+	for i, entry := range info.GetFamilyHistory() {
+		familyHistory[i] = FamilyHistoryEntry{
+			Relative:  entry.Relative,
+			Condition: entry.Condition,
+		}
+	}
+
+	return PatientInfo{
+		Name:          info.GetName(),
+		Age:           info.GetAge(),
+		Gender:        info.GetGender(),
+		Weight:        info.GetWeight(),
+		Height:        info.GetHeight(),
+		Allergies:     allergies,
+		Medications:   medications,
+		Conditions:    conditions,
+		Surgeries:     surgeries,
+		FamilyHistory: familyHistory,
+	}
+}
+
+// RecordVitals stores a new set of vital signs for the patient
+func (c *DatabaseClient) RecordVitals(ctx context.Context, input RecordVitalsInput) (*RecordVitalsOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.RecordVitalsRequest{
+		Token:     input.Token,
+		PatientId: input.PatientID,
+		Vitals: &pb.VitalSigns{
+			RecordedAt:  input.Vitals.RecordedAt.UnixMilli(),
+			Systolic:    input.Vitals.Systolic,
+			Diastolic:   input.Vitals.Diastolic,
+			HeartRate:   input.Vitals.HeartRate,
+			Temperature: input.Vitals.Temperature,
+			Spo2:        input.Vitals.SpO2,
+			Glucose:     input.Vitals.Glucose,
+			Weight:      input.Vitals.Weight,
+		},
+	}
+
+	// Send the request to the server
+	resp, err := c.client.RecordVitals(ctx, req)
+	if err != nil {
+		log.Printf("Failed to record vitals: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	return &RecordVitalsOutput{
+		ID: resp.Id,
+	}, nil
+}
+
+// ListVitals retrieves the patient's vital signs, newest first
+func (c *DatabaseClient) ListVitals(ctx context.Context, input ListVitalsInput) (*ListVitalsOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.ListVitalsRequest{
+		Token:     input.Token,
+		PatientId: input.PatientID,
+		Limit:     input.Limit,
+	}
+	if !input.Since.IsZero() {
+		req.Since = input.Since.UnixMilli()
+	}
+	if !input.Until.IsZero() {
+		req.Until = input.Until.UnixMilli()
+	}
+
+	// Send the request to the server
+	resp, err := c.client.ListVitals(ctx, req)
+	if err != nil {
+		log.Printf("Failed to list vitals: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	vitals := make([]VitalSigns, len(resp.Vitals))
+	for i, v := range resp.Vitals {
+		vitals[i] = VitalSigns{
+			ID:          v.Id,
+			RecordedAt:  time.UnixMilli(v.RecordedAt),
+			Systolic:    v.Systolic,
+			Diastolic:   v.Diastolic,
+			HeartRate:   v.HeartRate,
+			Temperature: v.Temperature,
+			SpO2:        v.Spo2,
+			Glucose:     v.Glucose,
+			Weight:      v.Weight,
+		}
+	}
+
+	return &ListVitalsOutput{
+		Vitals: vitals,
+	}, nil
+}
+
+// ListPatients retrieves every patient profile managed by the account
+func (c *DatabaseClient) ListPatients(ctx context.Context, input ListPatientsInput) (*ListPatientsOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.ListPatientsRequest{
+		Token: input.Token,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.ListPatients(ctx, req)
+	if err != nil {
+		log.Printf("Failed to list patients: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	patients := make([]PatientProfile, len(resp.Patients))
+	for i, patient := range resp.Patients {
+		if patients[i], err = c.decryptPatientProfile(patientProfileFromProto(patient)); err != nil {
+			log.Printf("Failed to decrypt patient %d: %v", patient.GetId(), err)
+			return nil, err
+		}
+	}
+
+	return &ListPatientsOutput{
+		Patients: patients,
+	}, nil
+}
+
+// CreatePatient creates a new patient profile managed by the account
+func (c *DatabaseClient) CreatePatient(ctx context.Context, input CreatePatientInput) (*CreatePatientOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Encrypt the sensitive fields for the owning account, then convert the input to the protobuf format
+	owner, err := c.ownerOf(ctx, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	patientInfo, err := c.encryptPatientInfo(input.PatientInfo, owner)
+	if err != nil {
+		log.Printf("Failed to encrypt patient info: %v", err)
+		return nil, err
+	}
+
+	req := &pb.CreatePatientRequest{
+		Token:        input.Token,
+		Relationship: input.Relationship,
+		PatientInfo:  patientInfoToProto(patientInfo),
+	}
+
+	// Send the request to the server
+	resp, err := c.client.CreatePatient(ctx, req)
+	if err != nil {
+		log.Printf("Failed to create patient: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	patient, err := c.decryptPatientProfile(patientProfileFromProto(resp.Patient))
+	if err != nil {
+		log.Printf("Failed to decrypt patient: %v", err)
+		return nil, err
+	}
+
+	return &CreatePatientOutput{
+		Patient: patient,
+	}, nil
+}
+
+// GetPatientByID retrieves a patient profile by its ID
+func (c *DatabaseClient) GetPatientByID(ctx context.Context, input GetPatientByIDInput) (*GetPatientByIDOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.GetPatientByIdRequest{
+		Token:     input.Token,
+		PatientId: input.PatientID,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.GetPatientById(ctx, req)
+	if err != nil {
+		log.Printf("Failed to get patient %d: %v", input.PatientID, err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	patient, err := c.decryptPatientProfile(patientProfileFromProto(resp.Patient))
+	if err != nil {
+		log.Printf("Failed to decrypt patient %d: %v", input.PatientID, err)
+		return nil, err
+	}
+
+	return &GetPatientByIDOutput{
+		Patient: patient,
+	}, nil
+}
+
+// UpdatePatient replaces a patient profile
+func (c *DatabaseClient) UpdatePatient(ctx context.Context, input UpdatePatientInput) (*UpdatePatientOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Encrypt the sensitive fields for the owning account, then convert the input to the protobuf format
+	owner, err := c.ownerOf(ctx, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	patientInfo, err := c.encryptPatientInfo(input.PatientInfo, owner)
+	if err != nil {
+		log.Printf("Failed to encrypt patient info: %v", err)
+		return nil, err
+	}
+
+	req := &pb.UpdatePatientRequest{
+		Token:           input.Token,
+		PatientId:       input.PatientID,
+		Relationship:    input.Relationship,
+		PatientInfo:     patientInfoToProto(patientInfo),
+		ExpectedVersion: input.ExpectedVersion,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.UpdatePatient(ctx, req)
+	if err != nil {
+		log.Printf("Failed to update patient %d: %v", input.PatientID, err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	patient, err := c.decryptPatientProfile(patientProfileFromProto(resp.Patient))
+	if err != nil {
+		log.Printf("Failed to decrypt patient %d: %v", input.PatientID, err)
+		return nil, err
+	}
+
+	return &UpdatePatientOutput{
+		Patient: patient,
+	}, nil
+}
+
+// DeletePatient deletes a patient profile
+func (c *DatabaseClient) DeletePatient(ctx context.Context, input DeletePatientInput) (*DeletePatientOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.DeletePatientRequest{
+		Token:     input.Token,
+		PatientId: input.PatientID,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.DeletePatient(ctx, req)
+	if err != nil {
+		log.Printf("Failed to delete patient %d: %v", input.PatientID, err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	return &DeletePatientOutput{
+		Success: resp.Success,
+	}, nil
+}
+
+// FlagConversation marks a conversation for review by a healthcare professional
+func (c *DatabaseClient) FlagConversation(ctx context.Context, input FlagConversationInput) (*FlagConversationOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.FlagConversationRequest{
+		Token:          input.Token,
+		ConversationId: input.ConversationID,
+		PatientId:      input.PatientID,
+		Reason:         input.Reason,
+		RuleIds:        input.RuleIDs,
+		Severity:       input.Severity,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.FlagConversation(ctx, req)
+	if err != nil {
+		log.Printf("Failed to flag conversation %s: %v", input.ConversationID, err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	return &FlagConversationOutput{
+		Success: resp.Success,
+	}, nil
+}
+
+// GetConversationSummary retrieves the cached rolling summary of a conversation
+func (c *DatabaseClient) GetConversationSummary(ctx context.Context, input GetConversationSummaryInput) (*GetConversationSummaryOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.GetConversationSummaryRequest{
+		Token:          input.Token,
+		ConversationId: input.ConversationID,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.GetConversationSummary(ctx, req)
+	if err != nil {
+		log.Printf("Failed to get summary of conversation %s: %v", input.ConversationID, err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	output := &GetConversationSummaryOutput{}
+	if resp.Summary != nil {
+		owner, err := c.ownerOf(ctx, input.Token)
+		if err != nil {
+			return nil, err
+		}
+		summary, err := c.decryptField(resp.Summary.Summary, fieldConversationSummary, owner)
+		if err != nil {
+			log.Printf("Failed to decrypt summary of conversation %s: %v", input.ConversationID, err)
+			return nil, err
+		}
+		output.Summary = &ConversationSummary{
+			Summary:      summary,
+			MessageCount: resp.Summary.MessageCount,
+			MessagesHash: resp.Summary.MessagesHash,
+			UpdatedAt:    time.UnixMilli(resp.Summary.UpdatedAt),
+		}
+	}
+	return output, nil
+}
+
+// SaveConversationSummary caches the rolling summary of a conversation, replacing the previous one
+func (c *DatabaseClient) SaveConversationSummary(ctx context.Context, input SaveConversationSummaryInput) (*SaveConversationSummaryOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Encrypt the summary for the owning account, then convert the input to the protobuf format
+	owner, err := c.ownerOf(ctx, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := c.encryptField(input.Summary.Summary, fieldConversationSummary, owner)
+	if err != nil {
+		log.Printf("Failed to encrypt summary of conversation %s: %v", input.ConversationID, err)
+		return nil, err
+	}
+
+	req := &pb.SaveConversationSummaryRequest{
+		Token:          input.Token,
+		ConversationId: input.ConversationID,
+		Summary: &pb.ConversationSummary{
+			Summary:      summary,
+			MessageCount: input.Summary.MessageCount,
+			MessagesHash: input.Summary.MessagesHash,
+			UpdatedAt:    input.Summary.UpdatedAt.UnixMilli(),
+		},
+	}
+
+	// Send the request to the server
+	resp, err := c.client.SaveConversationSummary(ctx, req)
+	if err != nil {
+		log.Printf("Failed to save summary of conversation %s: %v", input.ConversationID, err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	return &SaveConversationSummaryOutput{
+		Success: resp.Success,
+	}, nil
+}
+
+// RecordUsage records the tokens used by a diagnosis
+func (c *DatabaseClient) RecordUsage(ctx context.Context, input RecordUsageInput) (*RecordUsageOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.RecordUsageRequest{
+		Record: &pb.UsageRecord{
+			AccountId:        input.Record.AccountID,
+			ConversationId:   input.Record.ConversationID,
+			Model:            input.Record.Model,
+			PromptTokens:     input.Record.PromptTokens,
+			CompletionTokens: input.Record.CompletionTokens,
+			Time:             input.Record.Time.UnixMilli(),
+		},
+		ServiceToken: c.serviceToken,
+	}
+
+	// Send the request to the server
+	resp, err := c.client.RecordUsage(ctx, req)
+	if err != nil {
+		log.Printf("Failed to record usage: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	return &RecordUsageOutput{
+		Success: resp.Success,
+	}, nil
+}
+
+// ListUsage retrieves the usage within a period, summed per account, conversation and model
+func (c *DatabaseClient) ListUsage(ctx context.Context, input ListUsageInput) (*ListUsageOutput, error) {
+	// Create a context with timeout if none was provided
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+	}
+
+	// Convert the input to the protobuf format
+	req := &pb.ListUsageRequest{
+		AccountId:    input.AccountID,
+		ServiceToken: c.serviceToken,
+	}
+	if !input.Since.IsZero() {
+		req.Since = input.Since.UnixMilli()
+	}
+	if !input.Until.IsZero() {
+		req.Until = input.Until.UnixMilli()
+	}
+
+	// Send the request to the server
+	resp, err := c.client.ListUsage(ctx, req)
+	if err != nil {
+		log.Printf("Failed to list usage: %v", err)
+		return nil, err
+	}
+
+	// Convert the response to the output format
+	totals := make([]UsageTotal, len(resp.Totals))
+	for i, total := range resp.Totals {
+		totals[i] = UsageTotal{
+			AccountID:        total.AccountId,
+			ConversationID:   total.ConversationId,
+			Model:            total.Model,
+			PromptTokens:     total.PromptTokens,
+			CompletionTokens: total.CompletionTokens,
+			Requests:         total.Requests,
+		}
+	}
+
+	return &ListUsageOutput{
+		Totals: totals,
+	}, nil
+}
+
+// diagnosisSummaryToProto converts a structured diagnosis to the protobuf format, keeping nil as nil
+func diagnosisSummaryToProto(summary *DiagnosisSummary) *pb.DiagnosisSummary {
+	if summary == nil {
+		return nil
+	}
+
+	differentials := make([]*pb.DiagnosisDifferential, len(summary.Differentials))
+	for i, differential := range summary.Differentials {
+		differentials[i] = &pb.DiagnosisDifferential{
+			Condition:  differential.Condition,
+			Likelihood: differential.Likelihood,
+			Rationale:  differential.Rationale,
+		}
+	}
+
+	return &pb.DiagnosisSummary{
+		Differentials: differentials,
+		TriageLevel:   summary.TriageLevel,
+		Specialties:   summary.Specialties,
+		RedFlags:      summary.RedFlags,
+		Disclaimers:   summary.Disclaimers,
+		NextSteps:     summary.NextSteps,
+	}
+}
+
+// diagnosisSummaryFromProto converts a structured diagnosis from the protobuf format, keeping nil as nil
+func diagnosisSummaryFromProto(summary *pb.DiagnosisSummary) *DiagnosisSummary {
+	if summary == nil {
+		return nil
+	}
+
+	differentials := make([]Differential, len(summary.GetDifferentials()))
+	for i, differential := range summary.GetDifferentials() {
+		differentials[i] = Differential{
+			Condition:  differential.GetCondition(),
+			Likelihood: differential.GetLikelihood(),
+			Rationale:  differential.GetRationale(),
+		}
+	}
+
+	return &DiagnosisSummary{
+		Differentials: differentials,
+		TriageLevel:   summary.GetTriageLevel(),
+		Specialties:   summary.GetSpecialties(),
+		RedFlags:      summary.GetRedFlags(),
+		Disclaimers:   summary.GetDisclaimers(),
+		NextSteps:     summary.GetNextSteps(),
+	}
+}
+
+// patientProfileFromProto converts a patient profile from the protobuf format
+func patientProfileFromProto(profile *pb.PatientProfile) PatientProfile {
+	return PatientProfile{
+		ID:           profile.GetId(),
+		OwnerID:      profile.GetOwnerId(),
+		Relationship: profile.GetRelationship(),
+		PatientInfo:  patientInfoFromProto(profile.GetPatientInfo()),
+		Version:      profile.GetVersion(),
+	}
+}
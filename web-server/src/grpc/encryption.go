@@ -0,0 +1,223 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+)
+
+// Contexts bound to encrypted fields, so a ciphertext cannot be moved to another field.
+// Each one is combined with the owning account, so it cannot be moved to another account either.
+const (
+	fieldPatientName         = "patient_info.name"
+	fieldAllergySubstance    = "patient_info.allergies.substance"
+	fieldAllergyReaction     = "patient_info.allergies.reaction"
+	fieldAllergySeverity     = "patient_info.allergies.severity"
+	fieldMedicationName      = "patient_info.medications.name"
+	fieldMedicationDosage    = "patient_info.medications.dosage"
+	fieldMedicationFrequency = "patient_info.medications.frequency"
+	fieldConditionName       = "patient_info.conditions.name"
+	fieldConditionNotes      = "patient_info.conditions.notes"
+	fieldSurgeryProcedure    = "patient_info.surgeries.procedure"
+	fieldSurgeryNotes        = "patient_info.surgeries.notes"
+	fieldFamilyRelative      = "patient_info.family_history.relative"
+	fieldFamilyCondition     = "patient_info.family_history.condition"
+	fieldMessageContent      = "chat_message.content"
+	fieldSummaryCondition    = "chat_message.summary.differentials.condition"
+	fieldSummaryRationale    = "chat_message.summary.differentials.rationale"
+	fieldSummaryRedFlag      = "chat_message.summary.red_flags"
+	fieldSummaryNextStep     = "chat_message.summary.next_steps"
+	fieldConversationSummary = "conversation_summary.summary"
+)
+
+// FieldCipher encrypts sensitive fields before they leave the gateway.
+// Decrypt must return values that were never encrypted unchanged.
+type FieldCipher interface {
+	Encrypt(plaintext, context string) (string, error)
+	Decrypt(value, context string) (string, error)
+}
+
+// SetFieldCipher enables field-level encryption of patient data and chat content; nil disables it.
+// Ages, genders and measurements stay in plaintext, as the database stores them as numbers or enums,
+// and so do triage levels, specialties and disclaimers of diagnosis summaries, which feed analytics.
+func (c *DatabaseClient) SetFieldCipher(cipher FieldCipher) {
+	c.cipher = cipher
+}
+
+// maxCachedOwners bounds the cache of token owners, which is cleared when full
+const maxCachedOwners = 1024
+
+// ownerContext binds the context of a field to the account that owns it
+func ownerContext(field string, owner int32) string {
+	return field + "@account:" + strconv.FormatInt(int64(owner), 10)
+}
+
+// ownerOf returns the ID of the account behind a token, which its encrypted fields are bound to.
+// Owners are cached, so only the first encrypted call made with a token costs an extra request.
+func (c *DatabaseClient) ownerOf(ctx context.Context, token string) (int32, error) {
+	if c.cipher == nil {
+		return 0, nil
+	}
+
+	c.ownersMu.Lock()
+	owner, ok := c.owners[token]
+	c.ownersMu.Unlock()
+	if ok {
+		return owner, nil
+	}
+
+	output, err := c.GetAccount(ctx, GetAccountInput{Token: token})
+	if err != nil {
+		return 0, err
+	}
+
+	c.ownersMu.Lock()
+	defer c.ownersMu.Unlock()
+	if c.owners == nil || len(c.owners) >= maxCachedOwners {
+		c.owners = make(map[string]int32)
+	}
+	c.owners[token] = output.Account.ID
+	return output.Account.ID, nil
+}
+
+// fieldTransform applies a cipher operation to several fields of an account, keeping the first error
+type fieldTransform struct {
+	apply func(value, context string) (string, error)
+	owner int32
+	err   error
+}
+
+// field transforms a single field in place
+func (t *fieldTransform) field(value *string, context string) {
+	if t.err != nil {
+		return
+	}
+	*value, t.err = t.apply(*value, ownerContext(context, t.owner))
+}
+
+// encryptPatientInfo encrypts the free-text fields of a patient info owned by the account
+func (c *DatabaseClient) encryptPatientInfo(info PatientInfo, owner int32) (PatientInfo, error) {
+	if c.cipher == nil {
+		return info, nil
+	}
+	return transformPatientInfo(info, owner, c.cipher.Encrypt)
+}
+
+// decryptPatientInfo decrypts the free-text fields of a patient info owned by the account
+func (c *DatabaseClient) decryptPatientInfo(info PatientInfo, owner int32) (PatientInfo, error) {
+	if c.cipher == nil {
+		return info, nil
+	}
+	return transformPatientInfo(info, owner, c.cipher.Decrypt)
+}
+
+// encryptField encrypts a single field owned by the account
+func (c *DatabaseClient) encryptField(value, context string, owner int32) (string, error) {
+	if c.cipher == nil {
+		return value, nil
+	}
+	return c.cipher.Encrypt(value, ownerContext(context, owner))
+}
+
+// decryptField decrypts a single field owned by the account
+func (c *DatabaseClient) decryptField(value, context string, owner int32) (string, error) {
+	if c.cipher == nil {
+		return value, nil
+	}
+	return c.cipher.Decrypt(value, ownerContext(context, owner))
+}
+
+// decryptPatientProfile decrypts the patient info of a patient profile, which is bound to its owner
+func (c *DatabaseClient) decryptPatientProfile(profile PatientProfile) (PatientProfile, error) {
+	var err error
+	profile.PatientInfo, err = c.decryptPatientInfo(profile.PatientInfo, profile.OwnerID)
+	return profile, err
+}
+
+// encryptMessages encrypts the content of chat messages owned by the account
+func (c *DatabaseClient) encryptMessages(messages []ChatMessage, owner int32) ([]ChatMessage, error) {
+	if c.cipher == nil {
+		return messages, nil
+	}
+	return transformMessages(messages, owner, c.cipher.Encrypt)
+}
+
+// decryptMessages decrypts the content of chat messages owned by the account
+func (c *DatabaseClient) decryptMessages(messages []ChatMessage, owner int32) ([]ChatMessage, error) {
+	if c.cipher == nil {
+		return messages, nil
+	}
+	return transformMessages(messages, owner, c.cipher.Decrypt)
+}
+
+// transformPatientInfo applies a cipher operation to every free-text field, copying the lists so the input is not modified
+func transformPatientInfo(info PatientInfo, owner int32, apply func(value, context string) (string, error)) (PatientInfo, error) {
+	t := &fieldTransform{apply: apply, owner: owner}
+	t.field(&info.Name, fieldPatientName)
+
+	info.Allergies = append([]Allergy(nil), info.Allergies...)
+	for i := range info.Allergies {
+		t.field(&info.Allergies[i].Substance, fieldAllergySubstance)
+		t.field(&info.Allergies[i].Reaction, fieldAllergyReaction)
+		t.field(&info.Allergies[i].Severity, fieldAllergySeverity)
+	}
+
+	info.Medications = append([]Medication(nil), info.Medications...)
+	for i := range info.Medications {
+		t.field(&info.Medications[i].Name, fieldMedicationName)
+		t.field(&info.Medications[i].Dosage, fieldMedicationDosage)
+		t.field(&info.Medications[i].Frequency, fieldMedicationFrequency)
+	}
+
+	info.Conditions = append([]Condition(nil), info.Conditions...)
+	for i := range info.Conditions {
+		t.field(&info.Conditions[i].Name, fieldConditionName)
+		t.field(&info.Conditions[i].Notes, fieldConditionNotes)
+	}
+
+	info.Surgeries = append([]Surgery(nil), info.Surgeries...)
+	for i := range info.Surgeries {
+		t.field(&info.Surgeries[i].Procedure, fieldSurgeryProcedure)
+		t.field(&info.Surgeries[i].Notes, fieldSurgeryNotes)
+	}
+
+	info.FamilyHistory = append([]FamilyHistoryEntry(nil), info.FamilyHistory...)
+	for i := range info.FamilyHistory {
+		t.field(&info.FamilyHistory[i].Relative, fieldFamilyRelative)
+		t.field(&info.FamilyHistory[i].Condition, fieldFamilyCondition)
+	}
+
+	return info, t.err
+}
+
+// transformMessages applies a cipher operation to the content and summary of chat messages, copying the slice
+func transformMessages(messages []ChatMessage, owner int32, apply func(value, context string) (string, error)) ([]ChatMessage, error) {
+	t := &fieldTransform{apply: apply, owner: owner}
+	messages = append([]ChatMessage(nil), messages...)
+	for i := range messages {
+		t.field(&messages[i].Content, fieldMessageContent)
+		if messages[i].Summary != nil {
+			messages[i].Summary = t.summary(*messages[i].Summary)
+		}
+	}
+	return messages, t.err
+}
+
+// summary transforms the free-text fields of a diagnosis summary, copying the lists
+func (t *fieldTransform) summary(summary DiagnosisSummary) *DiagnosisSummary {
+	summary.Differentials = append([]Differential(nil), summary.Differentials...)
+	for i := range summary.Differentials {
+		t.field(&summary.Differentials[i].Condition, fieldSummaryCondition)
+		t.field(&summary.Differentials[i].Rationale, fieldSummaryRationale)
+	}
+
+	summary.RedFlags = append([]string(nil), summary.RedFlags...)
+	for i := range summary.RedFlags {
+		t.field(&summary.RedFlags[i], fieldSummaryRedFlag)
+	}
+
+	summary.NextSteps = append([]string(nil), summary.NextSteps...)
+	for i := range summary.NextSteps {
+		t.field(&summary.NextSteps[i], fieldSummaryNextStep)
+	}
+	return &summary
+}
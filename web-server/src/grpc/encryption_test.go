@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"path/filepath"
+	"testing"
+
+	"unb.br/web-server/src/encryption"
+)
+
+// newTestCipher returns a keyring with a single random key
+func newTestCipher(t *testing.T) FieldCipher {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "keys.json")
+	if _, err := encryption.GenerateKey(path); err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	keyring, err := encryption.LoadKeyring(path)
+	if err != nil {
+		t.Fatalf("failed to load keyring: %v", err)
+	}
+	return keyring
+}
+
+func TestPatientInfoEncryptionIsBoundToOwner(t *testing.T) {
+	client := &DatabaseClient{}
+	client.SetFieldCipher(newTestCipher(t))
+
+	info := PatientInfo{
+		Name:      "Maria",
+		Age:       40,
+		Allergies: []Allergy{{Substance: "penicilina", Reaction: "urticária"}},
+	}
+	encrypted, err := client.encryptPatientInfo(info, 1)
+	if err != nil {
+		t.Fatalf("encryptPatientInfo() = %v", err)
+	}
+	if !encryption.IsEncrypted(encrypted.Name) || !encryption.IsEncrypted(encrypted.Allergies[0].Substance) {
+		t.Fatalf("encryptPatientInfo() = %+v, want encrypted free-text fields", encrypted)
+	}
+	if info.Allergies[0].Substance != "penicilina" {
+		t.Errorf("encryptPatientInfo() modified its input")
+	}
+
+	decrypted, err := client.decryptPatientInfo(encrypted, 1)
+	if err != nil || decrypted.Name != "Maria" || decrypted.Allergies[0].Substance != "penicilina" {
+		t.Fatalf("decryptPatientInfo() = %+v, %v, want the original info", decrypted, err)
+	}
+
+	// A ciphertext copied into another account's record cannot be read there
+	if _, err := client.decryptPatientInfo(encrypted, 2); err == nil {
+		t.Error("decryptPatientInfo() decrypted a patient info owned by another account")
+	}
+	profile := PatientProfile{ID: 7, OwnerID: 2, PatientInfo: encrypted}
+	if _, err := client.decryptPatientProfile(profile); err == nil {
+		t.Error("decryptPatientProfile() decrypted a profile encrypted for another owner")
+	}
+}
+
+func TestMessageEncryptionIsBoundToOwner(t *testing.T) {
+	client := &DatabaseClient{}
+	client.SetFieldCipher(newTestCipher(t))
+
+	messages := []ChatMessage{{
+		Role:    "assistant",
+		Content: "Procure atendimento",
+		Summary: &DiagnosisSummary{RedFlags: []string{"dor no peito"}},
+	}}
+	encrypted, err := client.encryptMessages(messages, 1)
+	if err != nil {
+		t.Fatalf("encryptMessages() = %v", err)
+	}
+
+	if decrypted, err := client.decryptMessages(encrypted, 1); err != nil || decrypted[0].Summary.RedFlags[0] != "dor no peito" {
+		t.Fatalf("decryptMessages() = %+v, %v, want the original messages", decrypted, err)
+	}
+	if _, err := client.decryptMessages(encrypted, 2); err == nil {
+		t.Error("decryptMessages() decrypted messages owned by another account")
+	}
+
+	summary, _ := client.encryptField("resumo", fieldConversationSummary, 1)
+	if _, err := client.decryptField(summary, fieldConversationSummary, 2); err == nil {
+		t.Error("decryptField() decrypted a summary owned by another account")
+	}
+}
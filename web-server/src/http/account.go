@@ -0,0 +1,270 @@
+package http
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/grpc"
+)
+
+// DeleteAccountRequest represents an account deletion request
+type DeleteAccountRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// DeleteAccountResponse represents an account deletion response
+type DeleteAccountResponse struct {
+	Success bool   `json:"success"`
+	AuditID string `json:"audit_id"`
+}
+
+// AccountInfo represents the account section of a data export
+type AccountInfo struct {
+	ID       int32  `json:"id"`
+	Username string `json:"username"`
+}
+
+// ConversationMessage represents a stored conversation message
+type ConversationMessage struct {
+	Role      string            `json:"role"`
+	Content   string            `json:"content"`
+	CreatedAt time.Time         `json:"created_at"`
+	Summary   *DiagnosisSummary `json:"summary,omitempty"`
+	Truncated bool              `json:"truncated,omitempty"`
+}
+
+// Conversation represents a stored conversation
+type Conversation struct {
+	ID        string                `json:"id"`
+	CreatedAt time.Time             `json:"created_at"`
+	PatientID int32                 `json:"patient_id,omitempty"`
+	Messages  []ConversationMessage `json:"messages"`
+}
+
+// AccountExport represents the machine-readable copy of an account's data
+type AccountExport struct {
+	ExportedAt    time.Time        `json:"exported_at"`
+	AuditID       string           `json:"audit_id"`
+	Account       AccountInfo      `json:"account"`
+	Patient       *PatientInfo     `json:"patient"`
+	Patients      []PatientProfile `json:"patients"`
+	Conversations []Conversation   `json:"conversations"`
+}
+
+// handleExportAccount handles data export requests.
+// The archive is returned as JSON by default, or as a zip file with one entry per conversation when format=zip.
+func (s *Server) handleExportAccount(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		s.errorLogger.Printf("Missing token in export request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token is required"})
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "zip" {
+		s.errorLogger.Printf("Unsupported export format: %s", format)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Format must be json or zip"})
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	// Call the gRPC service
+	exportInput := grpc.ExportAccountInput{
+		Token: token,
+	}
+
+	exportOutput, err := s.dbClient.ExportAccount(ctx, exportInput)
+	if err != nil {
+		s.errorLogger.Printf("Failed to export account: %v", err)
+		s.recordAudit(c, accountActor(s.lookupAccount(c, token)), auditActionAccountExport, "account", audit.OutcomeFailure, "")
+		code, message := accountErrorStatus(err, "Invalid token")
+		c.JSON(code, gin.H{"error": message})
+		return
+	}
+
+	s.recordAudit(c, accountActor(&exportOutput.Account), auditActionAccountExport, "account", audit.OutcomeSuccess, "database audit record "+exportOutput.AuditID)
+
+	s.accessLogger.Printf("Account export for user %d (audit record %s)", exportOutput.Account.ID, exportOutput.AuditID)
+
+	export := newAccountExport(exportOutput)
+	filename := fmt.Sprintf("account-%d-export", export.Account.ID)
+
+	if format == "json" {
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+".json"))
+		c.JSON(http.StatusOK, export)
+		return
+	}
+
+	archive, err := buildExportArchive(export)
+	if err != nil {
+		s.errorLogger.Printf("Failed to build export archive: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build export"})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+".zip"))
+	c.Data(http.StatusOK, "application/zip", archive)
+}
+
+// handleDeleteAccount handles account deletion requests.
+// The password is checked again by the database server before anything is erased.
+func (s *Server) handleDeleteAccount(c *gin.Context) {
+	var req DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.errorLogger.Printf("Invalid delete account request: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	// Call the gRPC service
+	deleteInput := grpc.DeleteAccountInput{
+		Token:    req.Token,
+		Password: req.Password,
+	}
+
+	// Resolve the actor before the account disappears
+	actor := accountActor(s.lookupAccount(c, req.Token))
+	deleteOutput, err := s.dbClient.DeleteAccount(ctx, deleteInput)
+	if err != nil {
+		s.errorLogger.Printf("Account deletion failed: %v", err)
+		detail := ""
+		if grpc.IsUnauthenticated(err) {
+			detail = "re-authentication failed"
+		}
+		s.recordAudit(c, actor, auditActionAccountDelete, "account", audit.OutcomeFailure, detail)
+		code, message := accountErrorStatus(err, "Invalid credentials")
+		c.JSON(code, gin.H{"error": message})
+		return
+	}
+
+	s.recordAudit(c, actor, auditActionAccountDelete, "account", audit.OutcomeSuccess, "database audit record "+deleteOutput.AuditID)
+
+	s.accessLogger.Printf("Account deleted (audit record %s)", deleteOutput.AuditID)
+
+	c.JSON(http.StatusOK, DeleteAccountResponse{
+		Success: deleteOutput.Success,
+		AuditID: deleteOutput.AuditID,
+	})
+}
+
+// accountErrorStatus maps a failed account RPC to an HTTP status and message
+func accountErrorStatus(err error, unauthenticated string) (int, string) {
+	switch {
+	case grpc.IsUnauthenticated(err):
+		return http.StatusUnauthorized, unauthenticated
+	case grpc.IsNotFound(err):
+		return http.StatusNotFound, "Account not found"
+	case grpc.IsUnavailable(err):
+		return http.StatusServiceUnavailable, "Database service unavailable"
+	default:
+		return http.StatusInternalServerError, "Internal server error"
+	}
+}
+
+// newAccountExport converts the gRPC export into its HTTP representation
+func newAccountExport(output *grpc.ExportAccountOutput) AccountExport {
+	export := AccountExport{
+		ExportedAt: time.Now().UTC(),
+		AuditID:    output.AuditID,
+		Account: AccountInfo{
+			ID:       output.Account.ID,
+			Username: output.Account.Username,
+		},
+		Patients:      make([]PatientProfile, len(output.Patients)),
+		Conversations: make([]Conversation, len(output.Conversations)),
+	}
+
+	if output.PatientInfo != nil {
+		patient := patientInfoFromGrpc(*output.PatientInfo)
+		export.Patient = &patient
+	}
+
+	for i, profile := range output.Patients {
+		export.Patients[i] = newPatientProfile(profile)
+	}
+
+	for i, conv := range output.Conversations {
+		messages := make([]ConversationMessage, len(conv.Messages))
+		for j, msg := range conv.Messages {
+			messages[j] = ConversationMessage{
+				Role:      msg.Role,
+				Content:   msg.Content,
+				CreatedAt: msg.CreatedAt.UTC(),
+				Truncated: msg.Truncated,
+			}
+			if msg.Summary != nil {
+				summary := newDiagnosisSummary(*msg.Summary)
+				messages[j].Summary = &summary
+			}
+		}
+		export.Conversations[i] = Conversation{
+			ID:        conv.ID,
+			CreatedAt: conv.CreatedAt.UTC(),
+			PatientID: conv.PatientID,
+			Messages:  messages,
+		}
+	}
+
+	return export
+}
+
+// buildExportArchive packs an export into a zip file with the account, the patient profiles and each conversation
+func buildExportArchive(export AccountExport) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	writeEntry := func(name string, v any) error {
+		w, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(v)
+	}
+
+	if err := writeEntry("account.json", gin.H{
+		"exported_at": export.ExportedAt,
+		"audit_id":    export.AuditID,
+		"account":     export.Account,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := writeEntry("patient.json", export.Patient); err != nil {
+		return nil, err
+	}
+
+	for _, profile := range export.Patients {
+		if err := writeEntry(fmt.Sprintf("patients/%d.json", profile.ID), profile); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, conv := range export.Conversations {
+		if err := writeEntry(fmt.Sprintf("conversations/%s.json", conv.ID), conv); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
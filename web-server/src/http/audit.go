@@ -0,0 +1,259 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/grpc"
+)
+
+// Audit actions recorded by the server
+const (
+	auditActionLogin         = "auth.login"
+	auditActionRegister      = "auth.register"
+	auditActionPatientRead   = "patient.read"
+	auditActionPatientUpdate = "patient.update"
+	auditActionPatientCreate = "patient.create"
+	auditActionPatientDelete = "patient.delete"
+	auditActionDiagnosis     = "diagnosis.request"
+	auditActionDiagnosisStop = "diagnosis.stop"
+	auditActionScreen        = "diagnosis.screen"
+	auditActionTriageFlag    = "triage.flag"
+	auditActionAccountExport = "account.export"
+	auditActionAccountDelete = "account.delete"
+	auditActionVitalsRecord  = "vitals.record"
+	auditActionVitalsRead    = "vitals.read"
+	auditActionAuditQuery    = "audit.query"
+	auditActionAuditVerify   = "audit.verify"
+	auditActionUsageRead     = "usage.read"
+	auditActionUsageQuery    = "usage.query"
+	auditActionBackendsRead  = "backends.read"
+)
+
+const (
+	// anonymousActor is recorded when the token could not be resolved to an account
+	anonymousActor = "anonymous"
+	// adminRole is the account role allowed to read the audit log
+	adminRole = "admin"
+	// accountKey is the context key of the account resolved for the request
+	accountKey = "account"
+
+	defaultAuditQueryLimit    = 100
+	maxAuditQueryLimit        = 1000
+	auditRecordTimeout        = time.Second * 5
+	auditAccountLookupTimeout = time.Second * 5
+)
+
+// AuditEventsResponse represents an audit query response
+type AuditEventsResponse struct {
+	Events []audit.Event `json:"events"`
+}
+
+// AuditVerifyResponse represents an audit chain verification response
+type AuditVerifyResponse struct {
+	Valid  bool   `json:"valid"`
+	Events int    `json:"events"`
+	Error  string `json:"error,omitempty"`
+}
+
+// initAudit creates the audit logger with the configured sinks
+func (s *Server) initAudit() error {
+	if s.auditLogger != nil {
+		return nil
+	}
+
+	sinks := make([]audit.Sink, 0, len(s.config.AuditSinks))
+	for _, name := range s.config.AuditSinks {
+		switch name {
+		case "file":
+			sink, err := audit.NewFileSink(s.config.AuditFile, []byte(s.config.AuditKey))
+			if err != nil {
+				return fmt.Errorf("failed to create audit file sink: %v", err)
+			}
+			s.auditFile = sink
+			sinks = append(sinks, sink)
+		case "database":
+			sinks = append(sinks, audit.NewDatabaseSink(s.dbClient))
+		default:
+			return fmt.Errorf("unknown audit sink: %s", name)
+		}
+	}
+
+	s.auditLogger = audit.NewLogger(sinks...)
+	return nil
+}
+
+// recordAudit records an event, filling in the request metadata
+func (s *Server) recordAudit(c *gin.Context, actor, action, resource string, outcome audit.Outcome, detail string) {
+	if s.auditLogger == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), auditRecordTimeout)
+	defer cancel()
+
+	s.auditLogger.Record(ctx, audit.Event{
+		Time:      time.Now().UTC(),
+		Actor:     actor,
+		Action:    action,
+		Resource:  resource,
+		Outcome:   outcome,
+		RequestID: c.GetString(requestIDKey),
+		ClientIP:  c.ClientIP(),
+		Detail:    detail,
+	})
+}
+
+// resolvedAccount is the account a token resolved to, kept for the rest of the request
+type resolvedAccount struct {
+	token   string
+	account *grpc.Account
+}
+
+// lookupAccount resolves the account that owns a token, returning nil when the token is invalid.
+// The result is kept in the request context, so each request asks the database at most once per token.
+func (s *Server) lookupAccount(c *gin.Context, token string) *grpc.Account {
+	if cached, ok := c.Get(accountKey); ok {
+		if resolved := cached.(resolvedAccount); resolved.token == token {
+			return resolved.account
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), auditAccountLookupTimeout)
+	defer cancel()
+
+	var account *grpc.Account
+	if output, err := s.dbClient.GetAccount(ctx, grpc.GetAccountInput{Token: token}); err == nil {
+		account = &output.Account
+	}
+	c.Set(accountKey, resolvedAccount{token: token, account: account})
+	return account
+}
+
+// accountActor formats an account as an audit actor
+func accountActor(account *grpc.Account) string {
+	if account == nil {
+		return anonymousActor
+	}
+	return fmt.Sprintf("user:%d", account.ID)
+}
+
+// patientResource formats the patient profile of an account as an audit resource
+func patientResource(account *grpc.Account) string {
+	if account == nil {
+		return "patient:unknown"
+	}
+	return fmt.Sprintf("patient:user:%d", account.ID)
+}
+
+// requireAdmin resolves the token's account and rejects anyone without the admin role
+func (s *Server) requireAdmin(c *gin.Context, action, resource string) (*grpc.Account, bool) {
+	token := c.Query("token")
+	if token == "" {
+		s.errorLogger.Printf("Missing token in admin request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token is required"})
+		return nil, false
+	}
+
+	account := s.lookupAccount(c, token)
+	if account == nil {
+		s.recordAudit(c, anonymousActor, action, resource, audit.OutcomeFailure, "invalid token")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return nil, false
+	}
+
+	if account.Role != adminRole {
+		s.recordAudit(c, accountActor(account), action, resource, audit.OutcomeDenied, "admin role required")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Admin role required"})
+		return nil, false
+	}
+
+	return account, true
+}
+
+// handleQueryAudit handles audit log queries from administrators
+func (s *Server) handleQueryAudit(c *gin.Context) {
+	account, ok := s.requireAdmin(c, auditActionAuditQuery, "audit")
+	if !ok {
+		return
+	}
+
+	// Parse the filter from the query string
+	filter := audit.Filter{
+		Actor:    c.Query("actor"),
+		Action:   c.Query("action"),
+		Resource: c.Query("resource"),
+		Limit:    defaultAuditQueryLimit,
+	}
+
+	var err error
+	if since := c.Query("since"); since != "" {
+		if filter.Since, err = time.Parse(time.RFC3339, since); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "since must be an RFC 3339 timestamp"})
+			return
+		}
+	}
+	if until := c.Query("until"); until != "" {
+		if filter.Until, err = time.Parse(time.RFC3339, until); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "until must be an RFC 3339 timestamp"})
+			return
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		filter.Limit, err = strconv.Atoi(limit)
+		if err != nil || filter.Limit <= 0 || filter.Limit > maxAuditQueryLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("limit must be between 1 and %d", maxAuditQueryLimit)})
+			return
+		}
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	events, err := s.auditLogger.Query(ctx, filter)
+	if err != nil {
+		s.errorLogger.Printf("Failed to query audit log: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionAuditQuery, "audit", audit.OutcomeFailure, err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to query audit log"})
+		return
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionAuditQuery, "audit", audit.OutcomeSuccess, "")
+	c.JSON(http.StatusOK, AuditEventsResponse{
+		Events: events,
+	})
+}
+
+// handleVerifyAudit handles hash chain verification of the audit file
+func (s *Server) handleVerifyAudit(c *gin.Context) {
+	account, ok := s.requireAdmin(c, auditActionAuditVerify, "audit")
+	if !ok {
+		return
+	}
+
+	if s.auditFile == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Audit file sink is not enabled"})
+		return
+	}
+
+	count, err := s.auditFile.Verify()
+	response := AuditVerifyResponse{
+		Valid:  err == nil,
+		Events: count,
+	}
+	outcome := audit.OutcomeSuccess
+	if err != nil {
+		s.errorLogger.Printf("Audit chain verification failed: %v", err)
+		response.Error = err.Error()
+		outcome = audit.OutcomeFailure
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionAuditVerify, "audit", outcome, response.Error)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+)
+
+// BackendStatsResponse represents the traffic of the AI backends, in failover order
+type BackendStatsResponse struct {
+	Backends []BackendStats `json:"backends"`
+}
+
+// BackendStats represents the traffic of an AI backend since the server started
+type BackendStats struct {
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Addr      string `json:"addr"`
+	Requests  int64  `json:"requests"`
+	Failures  int64  `json:"failures"`
+	Failovers int64  `json:"failovers"`
+}
+
+// handleAdminBackends handles requests from administrators for the traffic and failovers of the AI backends
+func (s *Server) handleAdminBackends(c *gin.Context) {
+	account, ok := s.requireAdmin(c, auditActionBackendsRead, "ai-backends")
+	if !ok {
+		return
+	}
+
+	stats := s.aiClient.BackendStats()
+	response := BackendStatsResponse{Backends: make([]BackendStats, len(stats))}
+	for i, backend := range stats {
+		response.Backends[i] = BackendStats{
+			Name:      backend.Name,
+			Role:      backend.Role,
+			Addr:      backend.Addr,
+			Requests:  backend.Requests,
+			Failures:  backend.Failures,
+			Failovers: backend.Failovers,
+		}
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionBackendsRead, "ai-backends", audit.OutcomeSuccess, "")
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"context"
+	"time"
+
+	"unb.br/web-server/src/budget"
+	"unb.br/web-server/src/grpc"
+)
+
+// fitConversation keeps a diagnosis within the token budget. The system context and the most recent
+// messages are kept, and the older ones are replaced by a rolling summary cached with the conversation.
+// When no summary can be made, the older messages are simply left out. The tokens of a new summary are
+// recorded as the account's usage, so they count against its quota.
+func (s *Server) fitConversation(ctx context.Context, account *grpc.Account, token, conversationID string, input *grpc.DiagnoseInput) {
+	if s.config.ContextTokenBudget <= 0 {
+		return
+	}
+
+	// A quarter of the budget is kept for the summary
+	summaryTokens := s.config.ContextTokenBudget / 4
+	start := budget.Split(*input, s.config.ContextTokenBudget-summaryTokens)
+	if start == 0 {
+		return
+	}
+
+	dropped := input.Messages[:start]
+	input.Messages = input.Messages[start:]
+	hash := budget.Hash(dropped)
+	s.accessLogger.Printf("Conversation %s is over the token budget, summarizing %d messages", conversationID, len(dropped))
+
+	// Reuse the cached summary when it covers exactly the dropped messages, or extend it when it covers the first ones
+	previous := ""
+	pending := dropped
+	getSummaryOutput, err := s.dbClient.GetConversationSummary(ctx, grpc.GetConversationSummaryInput{
+		Token:          token,
+		ConversationID: conversationID,
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to get summary of conversation %s: %v", conversationID, err)
+	} else if cached := getSummaryOutput.Summary; cached != nil {
+		count := int(cached.MessageCount)
+		switch {
+		case count == len(dropped) && cached.MessagesHash == hash:
+			input.ConversationSummary = cached.Summary
+			return
+		case count < len(dropped) && cached.MessagesHash == budget.Hash(dropped[:count]):
+			previous = cached.Summary
+			pending = dropped[count:]
+		}
+	}
+
+	summarizeOutput, err := s.aiClient.Summarize(ctx, grpc.SummarizeInput{
+		PreviousSummary: previous,
+		Messages:        pending,
+		MaxTokens:       int32(summaryTokens),
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to summarize conversation %s, leaving out %d messages: %v", conversationID, len(dropped), err)
+		return
+	}
+	s.recordUsage(ctx, account, conversationID, summarizeOutput.Model, summarizeOutput.Usage)
+	input.ConversationSummary = summarizeOutput.Summary
+
+	_, err = s.dbClient.SaveConversationSummary(ctx, grpc.SaveConversationSummaryInput{
+		Token:          token,
+		ConversationID: conversationID,
+		Summary: grpc.ConversationSummary{
+			Summary:      summarizeOutput.Summary,
+			MessageCount: int32(len(dropped)),
+			MessagesHash: hash,
+			UpdatedAt:    time.Now(),
+		},
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to save summary of conversation %s: %v", conversationID, err)
+	}
+}
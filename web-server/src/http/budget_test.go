@@ -0,0 +1,76 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// conversation creates a diagnosis input with count long messages, alternating between the user and the assistant
+func conversation(count int) grpc.DiagnoseInput {
+	input := grpc.DiagnoseInput{}
+	for i := range count {
+		role := "user"
+		if i%2 == 1 {
+			role = "assistant"
+		}
+		input.Messages = append(input.Messages, grpc.Message{Role: role, Content: strings.Repeat(fmt.Sprintf("mensagem %d ", i), 40)})
+	}
+	return input
+}
+
+func TestFitConversation(t *testing.T) {
+	ts := newTestServer(t)
+	ts.config.ContextTokenBudget = 400
+	token := ts.register(t, "alice")
+	ctx := context.Background()
+	output, err := ts.dbClient.GetAccount(ctx, grpc.GetAccountInput{Token: token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := &output.Account
+
+	// requests counts the generations recorded as the account's usage
+	requests := func() int32 {
+		usage, err := ts.dbClient.ListUsage(ctx, grpc.ListUsageInput{AccountID: account.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var count int32
+		for _, total := range usage.Totals {
+			count += total.Requests
+		}
+		return count
+	}
+
+	// The older messages are summarized and the latest one is kept
+	input := conversation(6)
+	ts.fitConversation(ctx, account, token, "conversation-1", &input)
+	if len(input.Messages) == 0 || len(input.Messages) == 6 || input.Messages[len(input.Messages)-1] != conversation(6).Messages[5] {
+		t.Fatalf("kept %d messages, want the latest ones", len(input.Messages))
+	}
+	summary := input.ConversationSummary
+	if summary == "" {
+		t.Fatal("no summary of the older messages")
+	}
+	if count := requests(); count != 1 {
+		t.Fatalf("recorded %d generations, want the summary", count)
+	}
+
+	// The cached summary is reused as is for the same messages
+	input = conversation(6)
+	ts.fitConversation(ctx, account, token, "conversation-1", &input)
+	if input.ConversationSummary != summary || requests() != 1 {
+		t.Fatalf("summary %q after %d generations, want the cached one", input.ConversationSummary, requests())
+	}
+
+	// And extended when more messages are left out
+	input = conversation(8)
+	ts.fitConversation(ctx, account, token, "conversation-1", &input)
+	if !strings.HasPrefix(input.ConversationSummary, summary+"\n") || requests() != 2 {
+		t.Fatalf("summary %q after %d generations, want the cached one extended", input.ConversationSummary, requests())
+	}
+}
@@ -0,0 +1,477 @@
+package http
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/mockai"
+	"unb.br/web-server/src/safety"
+)
+
+// sseEvent is a server-sent event of a chat stream
+type sseEvent struct {
+	id   string
+	name string
+	data string
+}
+
+// parseEvents splits an event stream into its events
+func parseEvents(t *testing.T, body []byte) []sseEvent {
+	t.Helper()
+
+	var events []sseEvent
+	for _, block := range strings.Split(strings.TrimSpace(string(body)), "\n\n") {
+		var event sseEvent
+		for _, line := range strings.Split(block, "\n") {
+			if id, ok := strings.CutPrefix(line, "id: "); ok {
+				event.id = id
+			}
+			if name, ok := strings.CutPrefix(line, "event: "); ok {
+				event.name = name
+			}
+			if data, ok := strings.CutPrefix(line, "data: "); ok {
+				event.data = data
+			}
+		}
+		if event.name == "" {
+			t.Fatalf("event without a name in %q", block)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// deltas joins the content of the delta events
+func deltas(t *testing.T, events []sseEvent) string {
+	t.Helper()
+
+	var content strings.Builder
+	for _, event := range events {
+		if event.name != "delta" {
+			continue
+		}
+		var delta struct {
+			Content string `json:"content"`
+		}
+		if err := json.Unmarshal([]byte(event.data), &delta); err != nil {
+			t.Fatalf("invalid delta %q: %v", event.data, err)
+		}
+		content.WriteString(delta.Content)
+	}
+	return content.String()
+}
+
+// eventNames lists the names of the events, with consecutive deltas counted once
+func eventNames(events []sseEvent) []string {
+	var names []string
+	for _, event := range events {
+		if event.name == "delta" && len(names) > 0 && names[len(names)-1] == "delta" {
+			continue
+		}
+		names = append(names, event.name)
+	}
+	return names
+}
+
+// chatRequest builds a chat request with a single user message
+func chatRequest(token, content string) ChatRequest {
+	return ChatRequest{Token: token, Messages: []Message{{Role: roleUser, Content: content}}}
+}
+
+// newChatAccount registers an account with its own patient profile and returns its token
+func (ts *testServer) newChatAccount(t *testing.T, username string) string {
+	t.Helper()
+
+	token := ts.register(t, username)
+	resp, body := ts.do(t, http.MethodPost, "/api/patient", PatientInfoRequest{Token: token, Patient: testPatient("Alice")})
+	expectStatus(t, resp, body, http.StatusOK)
+	return token
+}
+
+func TestChatPlainText(t *testing.T) {
+	ts := newTestServer(t)
+	token := ts.newChatAccount(t, "alice")
+
+	resp, body := ts.do(t, http.MethodPost, "/api/chat", chatRequest(token, "Estou com dor de garganta"))
+	expectStatus(t, resp, body, http.StatusOK)
+	reply := mockai.DefaultScript().Reply
+	if string(body) != reply {
+		t.Fatalf("got reply %q, want %q", body, reply)
+	}
+	if model, backend := resp.Header.Get(diagnosisModelHeader), resp.Header.Get(diagnosisBackendHeader); model != "mock" || backend != "primary" {
+		t.Fatalf("got model %q on backend %q, want mock on primary", model, backend)
+	}
+
+	// The AI service gets the patient and the messages
+	requests := ts.ai.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("AI service got %d requests, want 1", len(requests))
+	}
+	if name, messages := requests[0].GetPatientInfo().GetName(), requests[0].GetMessages(); name != "Alice" || len(messages) != 1 || messages[0].GetContent() != "Estou com dor de garganta" {
+		t.Fatalf("AI service got patient %q and messages %v", name, messages)
+	}
+
+	// The turn is saved once streamed
+	conversationID := resp.Header.Get("X-Conversation-ID")
+	saved := ts.db.Conversation(conversationID)
+	if len(saved) != 2 || saved[1].GetContent() != reply || saved[1].GetTruncated() {
+		t.Fatalf("conversation %q saved as %v", conversationID, saved)
+	}
+}
+
+func TestChatEvents(t *testing.T) {
+	ts := newTestServer(t)
+	token := ts.newChatAccount(t, "alice")
+
+	req := chatRequest(token, "[mock mode=echo,chunk=3] tenho febre há dois dias")
+	req.ConversationID = "conversation-1"
+	resp, body := ts.do(t, http.MethodPost, "/api/chat", req, "Accept", "text/event-stream")
+	expectStatus(t, resp, body, http.StatusOK)
+	if contentType := resp.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Fatalf("got content type %q", contentType)
+	}
+
+	events := parseEvents(t, body)
+	if names := strings.Join(eventNames(events), ","); names != "metadata,delta,summary,done" {
+		t.Fatalf("got events %s, want metadata, deltas, summary and done", names)
+	}
+	var metadata DiagnosisMetadata
+	decode(t, []byte(events[0].data), &metadata)
+	if metadata.Model != "mock" || metadata.Backend != "primary" {
+		t.Fatalf("got metadata %+v", metadata)
+	}
+	if content := deltas(t, events); content != "tenho febre há dois dias" {
+		t.Fatalf("got content %q", content)
+	}
+	var summary DiagnosisSummary
+	decode(t, []byte(events[len(events)-2].data), &summary)
+	if summary.TriageLevel != "routine" || len(summary.Differentials) == 0 {
+		t.Fatalf("got summary %+v", summary)
+	}
+
+	if saved := ts.db.Conversation("conversation-1"); len(saved) != 2 || saved[1].GetSummary().GetTriageLevel() != "routine" {
+		t.Fatalf("conversation saved as %v", saved)
+	}
+}
+
+func TestChatManagedPatient(t *testing.T) {
+	ts := newTestServer(t)
+	token := ts.register(t, "alice")
+
+	resp, body := ts.do(t, http.MethodPost, "/api/patients", PatientProfileRequest{Token: token, Relationship: "child", Patient: testPatient("Carol")})
+	expectStatus(t, resp, body, http.StatusCreated)
+	var profile PatientProfile
+	decode(t, body, &profile)
+
+	req := chatRequest(token, "Minha filha está com tosse")
+	req.PatientID = profile.ID
+	resp, body = ts.do(t, http.MethodPost, "/api/chat", req)
+	expectStatus(t, resp, body, http.StatusOK)
+
+	requests := ts.ai.Requests()
+	if name := requests[len(requests)-1].GetPatientInfo().GetName(); name != "Carol" {
+		t.Fatalf("AI service got patient %q, want Carol", name)
+	}
+}
+
+func TestChatErrors(t *testing.T) {
+	ts := newTestServer(t)
+	token := ts.newChatAccount(t, "alice")
+	noProfileToken := ts.register(t, "bob")
+
+	resp, body := ts.do(t, http.MethodPost, "/api/patients", PatientProfileRequest{Token: noProfileToken, Relationship: "child", Patient: testPatient("Dan")})
+	expectStatus(t, resp, body, http.StatusCreated)
+	var otherProfile PatientProfile
+	decode(t, body, &otherProfile)
+
+	withConversation := chatRequest(token, "Olá")
+	withConversation.ConversationID = "../other"
+	withTemperature := chatRequest(token, "Olá")
+	temperature := float32(5)
+	withTemperature.Temperature = &temperature
+	withModel := chatRequest(token, "Olá")
+	withModel.Model = "gpt-4.1"
+	otherPatient := chatRequest(token, "Olá")
+	otherPatient.PatientID = otherProfile.ID
+	missingPatient := chatRequest(token, "Olá")
+	missingPatient.PatientID = 999
+
+	tests := []struct {
+		name    string
+		body    any
+		status  int
+		message string
+	}{
+		{"malformed JSON", `{"token":`, http.StatusBadRequest, "Invalid request"},
+		{"missing token", gin.H{"messages": []Message{{Role: roleUser, Content: "Olá"}}}, http.StatusBadRequest, "Invalid request"},
+		{"no messages", gin.H{"token": token, "messages": []Message{}}, http.StatusBadRequest, "Invalid messages"},
+		{"assistant message last", ChatRequest{Token: token, Messages: []Message{{Role: roleUser, Content: "Olá"}, {Role: roleAssistant, Content: "Oi"}}}, http.StatusBadRequest, "Invalid messages"},
+		{"message too large", chatRequest(token, strings.Repeat("a", defaultChatMaxMessageBytes+1)), http.StatusRequestEntityTooLarge, "Messages too large"},
+		{"invalid conversation ID", withConversation, http.StatusBadRequest, "Invalid conversation ID"},
+		{"invalid temperature", withTemperature, http.StatusBadRequest, "Invalid generation options"},
+		{"model without an allowlist", withModel, http.StatusBadRequest, "Invalid generation options"},
+		{"invalid token", chatRequest("invalid", "Olá"), http.StatusUnauthorized, "Invalid token"},
+		{"account without a profile", chatRequest(noProfileToken, "Olá"), http.StatusUnauthorized, "Invalid token"},
+		{"patient of another account", otherPatient, http.StatusForbidden, "Patient belongs to another account"},
+		{"missing patient", missingPatient, http.StatusNotFound, "Patient not found"},
+		{"AI failure before the reply", chatRequest(token, "[mock fail-after=0] Olá"), http.StatusInternalServerError, "Diagnosis failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := ts.do(t, http.MethodPost, "/api/chat", tt.body)
+			expectError(t, resp, body, tt.status, tt.message)
+		})
+	}
+
+	// Only the failing turn reached the AI service
+	if requests := ts.ai.Requests(); len(requests) != 1 {
+		t.Fatalf("AI service got %d requests, want 1", len(requests))
+	}
+}
+
+func TestChatFailsMidStream(t *testing.T) {
+	ts := newTestServer(t)
+	token := ts.newChatAccount(t, "alice")
+
+	req := chatRequest(token, "[mock mode=echo,chunk=4,fail-after=2] abcdefghijkl")
+	req.ConversationID = "conversation-1"
+	resp, body := ts.do(t, http.MethodPost, "/api/chat", req, "Accept", "text/event-stream")
+	expectStatus(t, resp, body, http.StatusOK)
+
+	events := parseEvents(t, body)
+	if names := strings.Join(eventNames(events), ","); names != "metadata,delta,error,done" {
+		t.Fatalf("got events %s, want the deltas followed by an error and done", names)
+	}
+	if content := deltas(t, events); content != "abcdefgh" {
+		t.Fatalf("got content %q before the failure", content)
+	}
+
+	// The partial reply is kept, marked as truncated
+	if saved := ts.db.Conversation("conversation-1"); len(saved) != 2 || saved[1].GetContent() != "abcdefgh" || !saved[1].GetTruncated() {
+		t.Fatalf("conversation saved as %v", saved)
+	}
+}
+
+func TestChatAiServiceDown(t *testing.T) {
+	ts := newTestServer(t)
+	token := ts.newChatAccount(t, "alice")
+
+	// The test AI client is created without the output filter, which must not add its footer to a failed diagnosis
+	filter, err := safety.Load("")
+	if err != nil {
+		t.Fatalf("failed to load the output filter: %v", err)
+	}
+	client, err := grpc.NewAiClient(ts.aiListener.Addr(), ts.aiListener.DialOption())
+	if err != nil {
+		t.Fatalf("failed to create AI client: %v", err)
+	}
+	client.SetOutputFilter(filter)
+	ts.aiClient.Close()
+	ts.aiClient = client
+
+	// The AI service fails before its first chunk
+	req := chatRequest(token, "[mock fail-after=0] Olá")
+	req.ConversationID = "conversation-1"
+	resp, body := ts.do(t, http.MethodPost, "/api/chat", req)
+	expectError(t, resp, body, http.StatusInternalServerError, "Diagnosis failed")
+	resp, body = ts.do(t, http.MethodPost, "/api/chat", req, "Accept", "text/event-stream")
+	expectStatus(t, resp, body, http.StatusOK)
+	if names := strings.Join(eventNames(parseEvents(t, body)), ","); names != "metadata,error" {
+		t.Fatalf("got events %s, want the metadata followed by an error", names)
+	}
+
+	// The AI service is down
+	ts.aiListener.Close()
+	resp, body = ts.do(t, http.MethodPost, "/api/chat", req)
+	expectError(t, resp, body, http.StatusInternalServerError, "Diagnosis failed")
+	resp, body = ts.do(t, http.MethodPost, "/api/chat", req, "Accept", "text/event-stream")
+	expectError(t, resp, body, http.StatusInternalServerError, "Diagnosis failed")
+
+	if saved := ts.db.Conversation("conversation-1"); len(saved) > 1 {
+		t.Fatalf("conversation saved as %v, want no reply", saved)
+	}
+}
+
+func TestChatStop(t *testing.T) {
+	ts := newTestServer(t)
+	token := ts.newChatAccount(t, "alice")
+	otherToken := ts.register(t, "bob")
+
+	// The reply hangs after its first chunk until stopped
+	data, err := json.Marshal(chatRequest(token, "[mock mode=echo,chunk=4,hang-after=1] abcdefgh"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPost, ts.url+"/api/chat", bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	streamID := resp.Header.Get("X-Stream-ID")
+	stopPath := fmt.Sprintf("/api/chat/streams/%s?token=", streamID)
+
+	// Wait for the first chunk
+	reader := bufio.NewReader(resp.Body)
+	var received bytes.Buffer
+	for !strings.Contains(received.String(), "event: delta") {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("stream ended before the first chunk: %v", err)
+		}
+		received.WriteString(line)
+	}
+
+	stopResp, body := ts.do(t, http.MethodDelete, stopPath+otherToken, nil)
+	expectError(t, stopResp, body, http.StatusForbidden, "Stream belongs to another account")
+	stopResp, body = ts.do(t, http.MethodDelete, stopPath+token, nil)
+	expectStatus(t, stopResp, body, http.StatusOK)
+
+	var rest bytes.Buffer
+	if _, err := rest.ReadFrom(reader); err != nil {
+		t.Fatalf("failed to read the end of the stream: %v", err)
+	}
+	events := parseEvents(t, append(received.Bytes(), rest.Bytes()...))
+	if names := strings.Join(eventNames(events), ","); names != "metadata,delta,stopped,done" {
+		t.Fatalf("got events %s, want the first chunk followed by stopped and done", names)
+	}
+	// Replies are not signed without a signing key, but the stream still ends with done
+	var done chatDone
+	decode(t, []byte(events[3].data), &done)
+	if done.Signature != "" {
+		t.Fatalf("got signature %q without a signing key", done.Signature)
+	}
+
+	saved := ts.db.Conversation(resp.Header.Get("X-Conversation-ID"))
+	if len(saved) != 2 || saved[1].GetContent() != "abcd" || !saved[1].GetTruncated() {
+		t.Fatalf("conversation saved as %v", saved)
+	}
+	// The stopped diagnosis is billed with an estimate, as the AI service never reported its usage
+	usage, err := ts.dbClient.ListUsage(context.Background(), grpc.ListUsageInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(usage.Totals) != 1 || usage.Totals[0].Requests != 1 || usage.Totals[0].CompletionTokens == 0 {
+		t.Fatalf("got usage %+v, want the stopped diagnosis", usage.Totals)
+	}
+
+	stopResp, body = ts.do(t, http.MethodDelete, stopPath+token, nil)
+	expectError(t, stopResp, body, http.StatusConflict, "Stream already finished")
+	stopResp, body = ts.do(t, http.MethodDelete, "/api/chat/streams/unknown?token="+token, nil)
+	expectError(t, stopResp, body, http.StatusNotFound, "Stream not found or expired")
+}
+
+func TestChatResume(t *testing.T) {
+	ts := newTestServer(t)
+	token := ts.newChatAccount(t, "alice")
+	otherToken := ts.register(t, "bob")
+
+	resp, body := ts.do(t, http.MethodPost, "/api/chat", chatRequest(token, "[mock mode=echo,chunk=4] abcdefgh"), "Accept", "text/event-stream")
+	expectStatus(t, resp, body, http.StatusOK)
+	events := parseEvents(t, body)
+	if names := strings.Join(eventNames(events), ","); names != "metadata,delta,summary,done" || len(events) != 5 {
+		t.Fatalf("got events %s, want two deltas", names)
+	}
+	streamID := resp.Header.Get("X-Stream-ID")
+	resumePath := fmt.Sprintf("/api/chat/streams/%s?token=", streamID)
+
+	// A client that lost the connection after the first chunk gets the rest of the finished stream
+	resp, body = ts.do(t, http.MethodGet, resumePath+token, nil, "Accept", "text/event-stream", "Last-Event-ID", events[1].id)
+	expectStatus(t, resp, body, http.StatusOK)
+	resumed := parseEvents(t, body)
+	if names := strings.Join(eventNames(resumed), ","); names != "delta,summary,done" || resumed[0].id != events[2].id {
+		t.Fatalf("resumed events %s from %s, want the events after %s", names, resumed[0].id, events[1].id)
+	}
+	if content := deltas(t, resumed); content != "efgh" {
+		t.Fatalf("resumed content %q, want efgh", content)
+	}
+
+	resp, body = ts.do(t, http.MethodGet, resumePath+token, nil, "Last-Event-ID", "x")
+	expectError(t, resp, body, http.StatusBadRequest, "Invalid Last-Event-ID")
+	resp, body = ts.do(t, http.MethodGet, resumePath+otherToken, nil)
+	expectError(t, resp, body, http.StatusForbidden, "Stream belongs to another account")
+
+	// Keep only the newest events, as a stream outgrowing its log would
+	stream := ts.chatStreams.get(streamID)
+	all, _, _, _ := stream.events.since(0)
+	trimmed := newEventLog(eventSize(all[len(all)-1]))
+	for _, event := range all {
+		trimmed.append(event)
+	}
+	trimmed.close()
+	stream.events = trimmed
+
+	resp, body = ts.do(t, http.MethodGet, resumePath+token, nil, "Accept", "text/event-stream", "Last-Event-ID", events[1].id)
+	expectError(t, resp, body, http.StatusGone, "Some events are no longer available")
+	resp, body = ts.do(t, http.MethodGet, resumePath+token, nil, "Accept", "text/event-stream", "Last-Event-ID", events[3].id)
+	expectStatus(t, resp, body, http.StatusOK)
+	if names := strings.Join(eventNames(parseEvents(t, body)), ","); names != "done" {
+		t.Fatalf("resumed events %s after the summary, want done", names)
+	}
+}
+
+// useCassettes replaces the AI client of the server with one recording to or replaying from dir
+func (ts *testServer) useCassettes(t *testing.T, mode, dir string) {
+	t.Helper()
+
+	cassettes, err := grpc.NewCassettes(mode, dir, false)
+	if err != nil {
+		t.Fatalf("failed to open cassettes: %v", err)
+	}
+	client, err := grpc.NewAiClient(ts.aiListener.Addr(), append(cassettes.DialOptions(), ts.aiListener.DialOption())...)
+	if err != nil {
+		t.Fatalf("failed to create AI client: %v", err)
+	}
+	ts.aiClient.Close()
+	ts.aiClient = client
+}
+
+func TestChatCassettes(t *testing.T) {
+	dir := t.TempDir()
+
+	recording := newTestServer(t)
+	recording.useCassettes(t, grpc.AiModeRecord, dir)
+	token := recording.newChatAccount(t, "alice")
+	resp, body := recording.do(t, http.MethodPost, "/api/chat", chatRequest(token, "[mock mode=echo,chunk=4] abcdefgh"), "Accept", "text/event-stream")
+	expectStatus(t, resp, body, http.StatusOK)
+	recorded := parseEvents(t, body)
+	resp, body = recording.do(t, http.MethodPost, "/api/chat", chatRequest(token, "[mock mode=echo,chunk=4,fail-after=1] abcdefgh"), "Accept", "text/event-stream")
+	expectStatus(t, resp, body, http.StatusOK)
+
+	// The replay answers without the AI service
+	replaying := newTestServer(t)
+	replaying.aiListener.Close()
+	replaying.useCassettes(t, grpc.AiModeReplay, dir)
+	token = replaying.newChatAccount(t, "alice")
+	resp, body = replaying.do(t, http.MethodPost, "/api/chat", chatRequest(token, "[mock mode=echo,chunk=4] abcdefgh"), "Accept", "text/event-stream")
+	expectStatus(t, resp, body, http.StatusOK)
+	replayed := parseEvents(t, body)
+	if got, want := strings.Join(eventNames(replayed), ","), strings.Join(eventNames(recorded), ","); got != want {
+		t.Fatalf("replayed events %s, want %s", got, want)
+	}
+	if content := deltas(t, replayed); content != "abcdefgh" {
+		t.Fatalf("replayed content %q, want abcdefgh", content)
+	}
+
+	// Recorded failures are replayed too
+	resp, body = replaying.do(t, http.MethodPost, "/api/chat", chatRequest(token, "[mock mode=echo,chunk=4,fail-after=1] abcdefgh"), "Accept", "text/event-stream")
+	expectStatus(t, resp, body, http.StatusOK)
+	if names := strings.Join(eventNames(parseEvents(t, body)), ","); names != "metadata,delta,error,done" {
+		t.Fatalf("replayed events %s, want the first chunk followed by an error and done", names)
+	}
+
+	resp, body = replaying.do(t, http.MethodPost, "/api/chat", chatRequest(token, "Não gravado"))
+	expectError(t, resp, body, http.StatusInternalServerError, "Diagnosis failed")
+}
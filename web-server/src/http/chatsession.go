@@ -0,0 +1,756 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/screening"
+	"unb.br/web-server/src/triage"
+)
+
+const (
+	// chatSessionRetention is how long a session outlives its last connection, so the client can resume it
+	chatSessionRetention = time.Minute * 5
+	// chatSessionEventBytes is how many bytes of events a session buffers for clients that reconnect
+	chatSessionEventBytes = 4 * 1024 * 1024
+	// chatPingInterval is how often idle connections are pinged, and chatPongWait how long the client has to answer
+	chatPingInterval = time.Second * 30
+	chatPongWait     = time.Second * 60
+	chatWriteWait    = time.Second * 10
+	// chatMaxCommandSize limits the size of a message sent by the client
+	chatMaxCommandSize = 64 * 1024
+)
+
+// Commands sent by chat clients
+const (
+	chatCommandTurn = "turn"
+	chatCommandStop = "stop"
+	chatCommandPing = "ping"
+)
+
+// newChatUpgrader creates the WebSocket upgrader of chat sessions, which only accepts the origins allowed by checkOrigin
+func (s *Server) newChatUpgrader() *websocket.Upgrader {
+	return &websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin:     s.checkOrigin,
+	}
+}
+
+// checkOrigin accepts requests from the server's own origin and from the configured allowed origins.
+// Browsers always send an Origin, so requests without one are not from a web page and are accepted too.
+// Browsers do not apply CORS to WebSockets, so without this check any site could open a session with a token.
+func (s *Server) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	for _, allowed := range s.config.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// chatCommand represents a message sent by a chat client
+type chatCommand struct {
+	Type    string `json:"type"`
+	Content string `json:"content"`
+	GenerationOptions
+}
+
+// chatSession is a consultation held over WebSocket connections.
+// It keeps the conversation history and buffers its events, so it survives reconnects for chatSessionRetention.
+type chatSession struct {
+	id             string
+	token          string
+	account        *grpc.Account
+	patientID      int32
+	patientInfo    grpc.PatientInfo
+	resource       string
+	conversationID string
+	events         *eventLog
+	ctx            context.Context
+	cancel         context.CancelFunc
+
+	mu      sync.Mutex
+	ai      *grpc.ChatSession
+	history []grpc.Message
+	turn    *chatTurn
+	// starting is set while a turn is being prepared, see startChatTurn
+	starting    bool
+	connections int
+	expiry      *time.Timer
+	closed      bool
+}
+
+// chatTurn is the turn being answered in a session
+type chatTurn struct {
+	id      string
+	req     ChatRequest
+	triage  *triage.Result
+	content strings.Builder
+	summary *grpc.DiagnosisSummary
+	// model is the model answering, as reported by the AI service
+	model string
+	// auditCtx is a copy of the connection's context, which outlives the request
+	auditCtx *gin.Context
+	// reservation holds the turn's estimate against the quotas until its usage is recorded
+	reservation *quotaReservation
+	// promptTokens estimates the prompt of a turn sent to the AI service, billed when the turn ends without a usage
+	promptTokens int
+	// usageReported tells whether the AI service reported the turn's usage
+	usageReported bool
+}
+
+// chatSessionRegistry holds the live chat sessions
+type chatSessionRegistry struct {
+	mu       sync.Mutex
+	sessions map[string]*chatSession
+}
+
+// newChatSessionRegistry creates an empty session registry
+func newChatSessionRegistry() *chatSessionRegistry {
+	return &chatSessionRegistry{sessions: map[string]*chatSession{}}
+}
+
+// get returns a live session by ID
+func (r *chatSessionRegistry) get(id string) *chatSession {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.sessions[id]
+}
+
+// add registers a session
+func (r *chatSessionRegistry) add(session *chatSession) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[session.id] = session
+}
+
+// remove unregisters a session
+func (r *chatSessionRegistry) remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, id)
+}
+
+// closeAll closes every session, on shutdown
+func (r *chatSessionRegistry) closeAll() {
+	r.mu.Lock()
+	sessions := r.sessions
+	r.sessions = map[string]*chatSession{}
+	r.mu.Unlock()
+
+	for _, session := range sessions {
+		session.close()
+	}
+}
+
+// handleChatWebSocket handles long-lived chat sessions over WebSocket.
+// Clients send {"type": "turn", "content": ...}, {"type": "stop"} and {"type": "ping"} and receive numbered events.
+// Reconnecting with session_id and last_seq replays the missed events and continues the same session.
+func (s *Server) handleChatWebSocket(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		s.errorLogger.Printf("Missing token in chat session request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token is required"})
+		return
+	}
+
+	var session *chatSession
+	var lastSeq int64
+	var ok bool
+	if sessionID := c.Query("session_id"); sessionID != "" {
+		if session, ok = s.resumeChatSession(c, token, sessionID); !ok {
+			return
+		}
+		if value := c.Query("last_seq"); value != "" {
+			seq, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || seq < 0 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid last_seq"})
+				return
+			}
+			lastSeq = seq
+		}
+	} else {
+		if session, ok = s.newChatSession(c, token); !ok {
+			return
+		}
+		// A new session has nothing to replay
+		lastSeq = session.events.last()
+	}
+
+	session.attach()
+	defer s.detachChatSession(session)
+
+	conn, err := s.chatUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader has already answered the request
+		s.errorLogger.Printf("Failed to upgrade chat session %s: %v", session.id, err)
+		return
+	}
+	defer conn.Close()
+
+	s.accessLogger.Printf("Chat session %s connected, resuming after event %d", session.id, lastSeq)
+	s.serveChatConnection(c.Copy(), conn, session, lastSeq)
+}
+
+// newChatSession authorizes the patient of a new session and registers it
+func (s *Server) newChatSession(c *gin.Context, token string) (*chatSession, bool) {
+	patientID, ok := parsePatientIDQuery(c)
+	if !ok {
+		return nil, false
+	}
+
+	conversationID := c.Query("conversation_id")
+	if conversationID != "" && !conversationIDPattern.MatchString(conversationID) {
+		s.errorLogger.Printf("Invalid conversation ID: %q", conversationID)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid conversation ID"})
+		return nil, false
+	}
+	if conversationID == "" {
+		conversationID = newRandomID()
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	account, patientInfo, resource, ok := s.chatPatient(ctx, c, token, patientID)
+	if !ok {
+		return nil, false
+	}
+	// Sessions are bound to an account so only its owner can resume them
+	if account == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return nil, false
+	}
+
+	sessionCtx, sessionCancel := context.WithCancel(context.Background())
+	session := &chatSession{
+		id:             newRandomID(),
+		token:          token,
+		account:        account,
+		patientID:      patientID,
+		patientInfo:    patientInfo,
+		resource:       resource,
+		conversationID: conversationID,
+		events:         newEventLog(chatSessionEventBytes),
+		ctx:            sessionCtx,
+		cancel:         sessionCancel,
+	}
+	s.chatSessions.add(session)
+	return session, true
+}
+
+// resumeChatSession finds a live session and checks that the token belongs to the account that started it
+func (s *Server) resumeChatSession(c *gin.Context, token, sessionID string) (*chatSession, bool) {
+	session := s.chatSessions.get(sessionID)
+	if session == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Session not found or expired"})
+		return nil, false
+	}
+
+	account := s.lookupAccount(c, token)
+	if account == nil {
+		s.recordAudit(c, anonymousActor, auditActionDiagnosis, session.resource, audit.OutcomeFailure, "invalid token")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return nil, false
+	}
+	if account.ID != session.account.ID {
+		s.errorLogger.Printf("User %d tried to resume chat session %s of user %d", account.ID, sessionID, session.account.ID)
+		s.recordAudit(c, accountActor(account), auditActionDiagnosis, session.resource, audit.OutcomeDenied, "session of another account")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Session belongs to another account"})
+		return nil, false
+	}
+
+	// Later turns are saved with the newest token
+	session.mu.Lock()
+	session.token = token
+	session.mu.Unlock()
+	return session, true
+}
+
+// detachChatSession drops a connection from a session, expiring the session once nobody resumed it in time
+func (s *Server) detachChatSession(session *chatSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	session.connections--
+	if session.connections > 0 || session.closed {
+		return
+	}
+	session.expiry = time.AfterFunc(chatSessionRetention, func() {
+		session.mu.Lock()
+		idle := session.connections == 0
+		session.mu.Unlock()
+		if !idle {
+			return
+		}
+
+		s.chatSessions.remove(session.id)
+		session.close()
+		s.accessLogger.Printf("Chat session %s expired", session.id)
+	})
+}
+
+// serveChatConnection reads commands from a connection and writes the session events to it until either side closes
+func (s *Server) serveChatConnection(c *gin.Context, conn *websocket.Conn, session *chatSession, lastSeq int64) {
+	replies := make(chan chatEvent, 8)
+	done := make(chan struct{})
+
+	// Read the client commands, keeping the connection alive as long as pongs arrive
+	go func() {
+		defer close(done)
+
+		conn.SetReadLimit(chatMaxCommandSize)
+		conn.SetReadDeadline(time.Now().Add(chatPongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(chatPongWait))
+		})
+
+		for {
+			var command chatCommand
+			if err := conn.ReadJSON(&command); err != nil {
+				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+					s.errorLogger.Printf("Chat session %s connection failed: %v", session.id, err)
+				}
+				return
+			}
+			conn.SetReadDeadline(time.Now().Add(chatPongWait))
+
+			if reply := s.handleChatCommand(c, session, command); reply != nil {
+				select {
+				case replies <- *reply:
+				default:
+					// The client is flooding the session, drop the reply
+				}
+			}
+		}
+	}()
+
+	write := func(event chatEvent) bool {
+		conn.SetWriteDeadline(time.Now().Add(chatWriteWait))
+		if err := conn.WriteJSON(event); err != nil {
+			s.errorLogger.Printf("Failed to write to chat session %s: %v", session.id, err)
+			return false
+		}
+		return true
+	}
+
+	if !write(chatEvent{Type: chatEventSession, SessionID: session.id, ConversationID: session.conversationID, Seq: session.events.last()}) {
+		return
+	}
+
+	ping := time.NewTicker(chatPingInterval)
+	defer ping.Stop()
+
+	for {
+		events, missed, changed, closed := session.events.since(lastSeq)
+		if missed && !write(chatEvent{Type: chatEventError, Error: "Some events are no longer available"}) {
+			return
+		}
+		for _, event := range events {
+			if !write(event) {
+				return
+			}
+			lastSeq = event.Seq
+		}
+		if closed {
+			conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "session closed"), time.Now().Add(chatWriteWait))
+			return
+		}
+
+		select {
+		case <-changed:
+		case reply := <-replies:
+			if !write(reply) {
+				return
+			}
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(chatWriteWait)); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// handleChatCommand runs a client command, returning a reply meant for that connection only, if any
+func (s *Server) handleChatCommand(c *gin.Context, session *chatSession, command chatCommand) *chatEvent {
+	switch command.Type {
+	case chatCommandTurn:
+		if strings.TrimSpace(command.Content) == "" {
+			return &chatEvent{Type: chatEventError, Error: "Content is required"}
+		}
+		if len(command.Content) > s.config.ChatMaxMessageBytes {
+			return &chatEvent{Type: chatEventError, Error: fmt.Sprintf("Content must be at most %d bytes", s.config.ChatMaxMessageBytes)}
+		}
+		if errs := s.validateGeneration(command.GenerationOptions); len(errs) > 0 {
+			return &chatEvent{Type: chatEventError, Error: fmt.Sprintf("%s %s", errs[0].Field, errs[0].Message)}
+		}
+		model, ok := s.allowedModel(session.account, command.Model)
+		if !ok {
+			s.recordAudit(c, accountActor(session.account), auditActionDiagnosis, session.resource, audit.OutcomeDenied, fmt.Sprintf("model %s not allowed", command.Model))
+			return &chatEvent{Type: chatEventError, Error: "Model not allowed"}
+		}
+		if err := s.startChatTurn(c, session, command.Content, command.GenerationOptions, model); err != "" {
+			return &chatEvent{Type: chatEventError, Error: err}
+		}
+	case chatCommandStop:
+		if !s.stopChatTurn(session) {
+			return &chatEvent{Type: chatEventError, Error: "No reply is being generated"}
+		}
+	case chatCommandPing:
+		return &chatEvent{Type: chatEventPong}
+	default:
+		return &chatEvent{Type: chatEventError, Error: "Unknown command"}
+	}
+	return nil
+}
+
+// startChatTurn sends a user message to the AI service, unless triage answers it alone.
+// It returns an error message for the client when the turn cannot start.
+// The database and AI calls that prepare the turn run without the session lock, so stop commands and the
+// events of the AI stream are not held up by them; the turn is reserved first so no other turn starts meanwhile.
+func (s *Server) startChatTurn(c *gin.Context, session *chatSession, content string, options GenerationOptions, model string) string {
+	session.mu.Lock()
+	if session.closed {
+		session.mu.Unlock()
+		return "Session closed"
+	}
+	if session.turn != nil || session.starting {
+		session.mu.Unlock()
+		return "A reply is still being generated"
+	}
+	session.starting = true
+	turn := &chatTurn{
+		id: newRandomID(),
+		req: ChatRequest{
+			Token:          session.token,
+			ConversationID: session.conversationID,
+			PatientID:      session.patientID,
+			Messages:       []Message{{Role: "user", Content: content}},
+		},
+		auditCtx: c,
+	}
+	history := append([]grpc.Message(nil), session.history...)
+	ai := session.ai
+	session.mu.Unlock()
+
+	prepared, message := s.prepareChatTurn(c, session, turn, history, ai, options, model)
+
+	session.mu.Lock()
+	session.starting = false
+	if message != "" {
+		session.mu.Unlock()
+		turn.reservation.release()
+		return message
+	}
+	if session.closed {
+		session.mu.Unlock()
+		turn.reservation.release()
+		return "Session closed"
+	}
+
+	session.events.append(chatEvent{Type: chatEventStart, TurnID: turn.id})
+	if turn.triage != nil {
+		s.accessLogger.Printf("Triage matched rules %v with severity %s", turn.triage.Rules, turn.triage.Severity)
+		turn.content.WriteString(turn.triage.Guidance)
+		session.events.append(chatEvent{Type: chatEventDelta, TurnID: turn.id, Content: turn.triage.Guidance})
+
+		if turn.triage.Action == triage.ActionReplace {
+			turn.summary = triageSummary(turn.triage)
+			summary := newDiagnosisSummary(*turn.summary)
+			session.events.append(chatEvent{Type: chatEventSummary, TurnID: turn.id, Summary: &summary})
+			session.turn = turn
+			s.finishChatTurn(session, false, audit.OutcomeSuccess)
+			session.mu.Unlock()
+			return ""
+		}
+	}
+
+	// Refused turns are kept out of the history, so they never reach the AI service
+	if prepared.blocked {
+		turn.content.WriteString(s.screening.Refusal())
+		session.events.append(chatEvent{Type: chatEventDelta, TurnID: turn.id, Content: s.screening.Refusal()})
+		session.events.append(chatEvent{Type: chatEventDone, TurnID: turn.id, Signature: s.signReply(session.account, turn.content.String())})
+		session.mu.Unlock()
+		turn.reservation.release()
+		if turn.triage != nil {
+			go func() {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+				defer cancel()
+				s.flagConversation(ctx, c, session.account, session.resource, turn.req, session.conversationID, turn.triage)
+			}()
+		}
+		return ""
+	}
+
+	// Use the AI stream opened for this turn, unless the session's stream failed in the meantime
+	if ai == nil {
+		session.ai = prepared.ai
+		go s.pumpChatSession(session, prepared.ai)
+	} else if session.ai != ai {
+		session.mu.Unlock()
+		turn.reservation.release()
+		s.recordAudit(c, accountActor(session.account), auditActionDiagnosis, session.resource, audit.OutcomeFailure, "conversation "+session.conversationID)
+		return "Diagnosis failed"
+	}
+	ai = session.ai
+	turn.promptTokens = s.estimatePrompt(prepared.input)
+	session.turn = turn
+	session.mu.Unlock()
+
+	// The turn is already current, so its events are not dropped however fast the AI service answers
+	if err := ai.SendTurn(turn.id, prepared.input); err != nil {
+		session.mu.Lock()
+		if session.turn == turn {
+			// The turn never reached the AI service, so nothing is billed
+			turn.promptTokens = 0
+			session.events.append(chatEvent{Type: chatEventError, TurnID: turn.id, Error: "Diagnosis failed"})
+			s.finishChatTurn(session, true, audit.OutcomeFailure)
+		}
+		session.mu.Unlock()
+	}
+	return ""
+}
+
+// preparedChatTurn is what startChatTurn needs to send a turn
+type preparedChatTurn struct {
+	// blocked is set when screening refused the message
+	blocked bool
+	// ai is the AI stream opened for the turn, when the session had none
+	ai    *grpc.ChatSession
+	input grpc.DiagnoseInput
+}
+
+// prepareChatTurn runs triage, the quota and screening checks, and builds the input of a turn with the recent vitals
+// and the history fitted to the token budget. It runs without the session lock and returns an error message for
+// the client when the turn cannot start; a quota reservation is left on the turn for startChatTurn to release
+// when the turn does not become current.
+func (s *Server) prepareChatTurn(c *gin.Context, session *chatSession, turn *chatTurn, history []grpc.Message, ai *grpc.ChatSession, options GenerationOptions, model string) (preparedChatTurn, string) {
+	var prepared preparedChatTurn
+
+	// Red-flag symptoms get urgent-care guidance first, and emergencies skip the AI service entirely
+	turn.triage = s.checkTriage(turn.req, session.patientInfo)
+	if turn.triage != nil && turn.triage.Action == triage.ActionReplace {
+		return prepared, ""
+	}
+
+	content := turn.req.Messages[0].Content
+	messages := append(history, grpc.Message{Role: "user", Content: content})
+
+	// Accounts that used up their monthly quota wait for the next month, only triage still answers them
+	ctx, cancel := context.WithTimeout(session.ctx, time.Second*10)
+	estimate := s.estimateDiagnosis(grpc.DiagnoseInput{PatientInfo: session.patientInfo, Messages: messages, MaxTokens: options.MaxTokens}, model)
+	reservation, status, message, _ := s.checkQuota(ctx, c, session.account, session.resource, estimate)
+	cancel()
+	if status != 0 {
+		return prepared, message
+	}
+	turn.reservation = reservation
+
+	// Messages meant for the AI service are screened first, and blocked ones get a polite refusal instead
+	ctx, cancel = context.WithTimeout(session.ctx, time.Second*10)
+	screenResult := s.screenMessage(ctx, c, session.account, session.resource, session.conversationID, content)
+	cancel()
+	if screenResult.Outcome == screening.OutcomeBlock {
+		prepared.blocked = true
+		return prepared, ""
+	}
+
+	// Open the AI stream on the first turn, or again after it failed
+	if ai == nil {
+		var err error
+		if prepared.ai, err = s.aiClient.OpenChat(session.ctx); err != nil {
+			s.errorLogger.Printf("Failed to open AI chat for session %s: %v", session.id, err)
+			s.recordAudit(c, accountActor(session.account), auditActionDiagnosis, session.resource, audit.OutcomeFailure, "conversation "+session.conversationID)
+			return prepared, "Diagnosis failed"
+		}
+	}
+
+	// Add the recent vitals and their trends as context
+	ctx, cancel = context.WithTimeout(session.ctx, time.Second*5)
+	recentVitals, vitalTrends := s.diagnosisVitalsContext(ctx, turn.req.Token, session.patientID)
+	cancel()
+
+	prepared.input = grpc.DiagnoseInput{
+		PatientInfo:  session.patientInfo,
+		Messages:     messages,
+		RecentVitals: recentVitals,
+		VitalTrends:  vitalTrends,
+	}
+	applyGeneration(&prepared.input, options, model)
+
+	// Older turns over the token budget are replaced by a summary
+	ctx, cancel = context.WithTimeout(session.ctx, time.Minute)
+	s.fitConversation(ctx, session.account, turn.req.Token, session.conversationID, &prepared.input)
+	cancel()
+
+	return prepared, ""
+}
+
+// stopChatTurn stops the turn being answered, keeping the partial answer. It reports whether a turn was running.
+func (s *Server) stopChatTurn(session *chatSession) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.turn == nil {
+		return false
+	}
+
+	// Events the AI service sends before it notices are ignored, as the turn is finished right away
+	if session.ai != nil {
+		session.ai.Stop(session.turn.id)
+	}
+	s.finishChatTurn(session, true, audit.OutcomeSuccess)
+	return true
+}
+
+// pumpChatSession moves the events of an AI chat stream into the session until the stream ends
+func (s *Server) pumpChatSession(session *chatSession, ai *grpc.ChatSession) {
+	for {
+		event, err := ai.Recv()
+		if err != nil {
+			session.mu.Lock()
+			if session.ai == ai {
+				session.ai = nil
+			}
+			if session.turn != nil && !session.closed {
+				s.errorLogger.Printf("Chat session %s stream failed: %v", session.id, err)
+				session.events.append(chatEvent{Type: chatEventError, TurnID: session.turn.id, Error: "Diagnosis failed"})
+				s.finishChatTurn(session, true, audit.OutcomeFailure)
+			}
+			session.mu.Unlock()
+			return
+		}
+
+		session.mu.Lock()
+		turn := session.turn
+		if turn == nil || turn.id != event.TurnID {
+			// A turn that was stopped or has already failed
+			session.mu.Unlock()
+			continue
+		}
+
+		switch {
+		case event.Metadata != nil:
+			turn.model = event.Metadata.Model
+			session.events.append(chatEvent{Type: chatEventMetadata, TurnID: turn.id, Model: event.Metadata.Model, Backend: event.Metadata.Backend})
+		case event.Usage != nil:
+			turn.usageReported = true
+			// The reservation is handed over, to be released once the usage is recorded
+			go func(model string, usage *grpc.TokenUsage, reservation *quotaReservation) {
+				defer reservation.release()
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+				defer cancel()
+				s.recordUsage(ctx, session.account, session.conversationID, model, usage)
+			}(turn.model, event.Usage, turn.reservation)
+			turn.reservation = nil
+		case event.Summary != nil:
+			turn.summary = event.Summary
+			summary := newDiagnosisSummary(*event.Summary)
+			session.events.append(chatEvent{Type: chatEventSummary, TurnID: turn.id, Summary: &summary})
+		case event.End:
+			s.finishChatTurn(session, event.Stopped, audit.OutcomeSuccess)
+		default:
+			turn.content.WriteString(event.Content)
+			session.events.append(chatEvent{Type: chatEventDelta, TurnID: turn.id, Content: event.Content})
+		}
+		session.mu.Unlock()
+	}
+}
+
+// finishChatTurn closes the current turn, adding it to the history, the audit log and the stored conversation.
+// The session lock must be held.
+func (s *Server) finishChatTurn(session *chatSession, stopped bool, outcome audit.Outcome) {
+	turn := session.turn
+	session.turn = nil
+	reply := turn.content.String()
+
+	// A turn stopped or failed before the AI service reported its usage is billed with an estimate,
+	// and gives its reservation back once that is recorded
+	var usage *grpc.TokenUsage
+	if !turn.usageReported && turn.promptTokens > 0 {
+		usage = partialUsage(turn.promptTokens, reply)
+	}
+	if usage == nil {
+		turn.reservation.release()
+	}
+
+	if outcome == audit.OutcomeSuccess {
+		session.events.append(chatEvent{Type: chatEventDone, TurnID: turn.id, Stopped: stopped, Signature: s.signReply(session.account, reply)})
+	}
+	session.history = append(session.history,
+		grpc.Message{Role: "user", Content: turn.req.Messages[0].Content},
+		grpc.Message{Role: "assistant", Content: reply},
+	)
+
+	detail := "conversation " + session.conversationID
+	if turn.triage != nil && turn.triage.Action == triage.ActionReplace {
+		detail += " answered by triage"
+	}
+
+	// Audit and store the turn in the background, so neither the session nor the next turn is held up by the sinks
+	// or the database
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		if usage != nil {
+			s.recordUsage(ctx, session.account, session.conversationID, turn.model, usage)
+			turn.reservation.release()
+		}
+		s.recordAudit(turn.auditCtx, accountActor(session.account), auditActionDiagnosis, session.resource, outcome, detail)
+		s.saveConversationTurn(ctx, turn.req, session.conversationID, grpc.ChatMessage{
+			Content:   reply,
+			Summary:   turn.summary,
+			Truncated: stopped,
+		})
+		if turn.triage != nil {
+			s.flagConversation(ctx, turn.auditCtx, session.account, session.resource, turn.req, session.conversationID, turn.triage)
+		}
+	}()
+}
+
+// attach counts a new connection, cancelling the expiry of an idle session
+func (cs *chatSession) attach() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	cs.connections++
+	if cs.expiry != nil {
+		cs.expiry.Stop()
+		cs.expiry = nil
+	}
+}
+
+// close ends the session and its AI stream
+func (cs *chatSession) close() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	if cs.closed {
+		return
+	}
+	cs.closed = true
+	cs.cancel()
+	cs.events.close()
+}
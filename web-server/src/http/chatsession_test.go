@@ -0,0 +1,37 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckOrigin(t *testing.T) {
+	s := &Server{config: Config{AllowedOrigins: []string{"https://app.example.com"}}}
+	open := &Server{}
+
+	tests := []struct {
+		name   string
+		server *Server
+		origin string
+		want   bool
+	}{
+		{"no origin", open, "", true},
+		{"same origin", open, "http://api.example.com", true},
+		{"other origin", open, "https://evil.example.net", false},
+		{"allowed origin", s, "https://app.example.com", true},
+		{"allowed origin over another scheme", s, "http://app.example.com", false},
+		{"origin not allowed", s, "https://evil.example.net", false},
+		{"any origin", &Server{config: Config{AllowedOrigins: []string{"*"}}}, "https://evil.example.net", true},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "http://api.example.com/api/chat/ws", nil)
+		if tt.origin != "" {
+			r.Header.Set("Origin", tt.origin)
+		}
+		if got := tt.server.checkOrigin(r); got != tt.want {
+			t.Errorf("%s: checkOrigin(%q) = %t, want %t", tt.name, tt.origin, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"context"
+
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/screening"
+)
+
+// AiService is what the handlers use of the AI service, implemented by grpc.AiClient
+type AiService interface {
+	screening.Moderator
+	StreamDiagnose(ctx context.Context, writer grpc.DiagnoseWriter, input grpc.DiagnoseInput) (*grpc.DiagnoseOutput, error)
+	OpenChat(ctx context.Context) (*grpc.ChatSession, error)
+	Summarize(ctx context.Context, input grpc.SummarizeInput) (*grpc.SummarizeOutput, error)
+	BackendStats() []grpc.BackendStats
+	Close() error
+}
+
+// DatabaseService is what the handlers use of the database service, implemented by grpc.DatabaseClient
+type DatabaseService interface {
+	audit.EventStore
+	Login(ctx context.Context, input grpc.LoginInput) (*grpc.LoginOutput, error)
+	Register(ctx context.Context, input grpc.RegisterInput) (*grpc.RegisterOutput, error)
+	GetAccount(ctx context.Context, input grpc.GetAccountInput) (*grpc.GetAccountOutput, error)
+	ExportAccount(ctx context.Context, input grpc.ExportAccountInput) (*grpc.ExportAccountOutput, error)
+	DeleteAccount(ctx context.Context, input grpc.DeleteAccountInput) (*grpc.DeleteAccountOutput, error)
+	SavePatientInfo(ctx context.Context, input grpc.SavePatientInfoInput) (*grpc.SavePatientInfoOutput, error)
+	GetPatient(ctx context.Context, input grpc.GetPatientInput) (*grpc.GetPatientOutput, error)
+	ListPatients(ctx context.Context, input grpc.ListPatientsInput) (*grpc.ListPatientsOutput, error)
+	CreatePatient(ctx context.Context, input grpc.CreatePatientInput) (*grpc.CreatePatientOutput, error)
+	GetPatientByID(ctx context.Context, input grpc.GetPatientByIDInput) (*grpc.GetPatientByIDOutput, error)
+	UpdatePatient(ctx context.Context, input grpc.UpdatePatientInput) (*grpc.UpdatePatientOutput, error)
+	DeletePatient(ctx context.Context, input grpc.DeletePatientInput) (*grpc.DeletePatientOutput, error)
+	SaveConversation(ctx context.Context, input grpc.SaveConversationInput) (*grpc.SaveConversationOutput, error)
+	FlagConversation(ctx context.Context, input grpc.FlagConversationInput) (*grpc.FlagConversationOutput, error)
+	GetConversationSummary(ctx context.Context, input grpc.GetConversationSummaryInput) (*grpc.GetConversationSummaryOutput, error)
+	SaveConversationSummary(ctx context.Context, input grpc.SaveConversationSummaryInput) (*grpc.SaveConversationSummaryOutput, error)
+	RecordVitals(ctx context.Context, input grpc.RecordVitalsInput) (*grpc.RecordVitalsOutput, error)
+	ListVitals(ctx context.Context, input grpc.ListVitalsInput) (*grpc.ListVitalsOutput, error)
+	RecordUsage(ctx context.Context, input grpc.RecordUsageInput) (*grpc.RecordUsageOutput, error)
+	ListUsage(ctx context.Context, input grpc.ListUsageInput) (*grpc.ListUsageOutput, error)
+	Close() error
+}
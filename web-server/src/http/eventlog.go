@@ -0,0 +1,139 @@
+package http
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// Types of the events streamed to chat clients
+const (
+	chatEventSession  = "session"
+	chatEventStart    = "start"
+	chatEventMetadata = "metadata"
+	chatEventDelta    = "delta"
+	chatEventSummary  = "summary"
+	chatEventDone     = "done"
+	chatEventStopped  = "stopped"
+	chatEventError    = "error"
+	chatEventPong     = "pong"
+)
+
+// chatEvent represents an event streamed to a chat client
+type chatEvent struct {
+	Type           string            `json:"type"`
+	Seq            int64             `json:"seq,omitempty"`
+	TurnID         string            `json:"turn_id,omitempty"`
+	Model          string            `json:"model,omitempty"`
+	Backend        string            `json:"backend,omitempty"`
+	Content        string            `json:"content,omitempty"`
+	Summary        *DiagnosisSummary `json:"summary,omitempty"`
+	Stopped        bool              `json:"stopped,omitempty"`
+	Signature      string            `json:"signature,omitempty"`
+	Error          string            `json:"error,omitempty"`
+	SessionID      string            `json:"session_id,omitempty"`
+	ConversationID string            `json:"conversation_id,omitempty"`
+}
+
+// eventLog buffers the newest events of a stream with increasing sequence numbers,
+// so a client that lost its connection can catch up on what it missed.
+// It is bounded by the encoded size of the events, as a few large events weigh as much as many deltas.
+type eventLog struct {
+	mu      sync.Mutex
+	events  []chatEvent
+	sizes   []int
+	size    int
+	lastSeq int64
+	limit   int
+	// changed is closed and replaced on every append, waking up the readers
+	changed chan struct{}
+	closed  bool
+}
+
+// newEventLog creates an event log keeping at most limit bytes of events, and always the newest one
+func newEventLog(limit int) *eventLog {
+	return &eventLog{
+		limit:   limit,
+		changed: make(chan struct{}),
+	}
+}
+
+// append numbers an event and adds it to the log, dropping the oldest ones when the log is full
+func (l *eventLog) append(event chatEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return
+	}
+
+	l.lastSeq++
+	event.Seq = l.lastSeq
+	size := eventSize(event)
+	l.events = append(l.events, event)
+	l.sizes = append(l.sizes, size)
+	l.size += size
+	for l.size > l.limit && len(l.events) > 1 {
+		l.size -= l.sizes[0]
+		l.events = l.events[1:]
+		l.sizes = l.sizes[1:]
+	}
+
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// since returns the events numbered after seq, whether older events were already dropped,
+// a channel closed on the next change and whether the log is closed
+func (l *eventLog) since(seq int64) ([]chatEvent, bool, <-chan struct{}, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	firstSeq := l.lastSeq - int64(len(l.events)) + 1
+	missed := seq+1 < firstSeq
+
+	start := seq + 1 - firstSeq
+	if start < 0 {
+		start = 0
+	}
+	var events []chatEvent
+	if start < int64(len(l.events)) {
+		events = append(events, l.events[start:]...)
+	}
+
+	return events, missed, l.changed, l.closed
+}
+
+// last returns the sequence number of the newest event
+func (l *eventLog) last() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lastSeq
+}
+
+// isClosed reports whether the log is closed
+func (l *eventLog) isClosed() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.closed
+}
+
+// close stops the log, waking up the readers for good
+func (l *eventLog) close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return
+	}
+	l.closed = true
+	close(l.changed)
+}
+
+// eventSize returns the encoded size of an event, as sent to the clients
+func eventSize(event chatEvent) int {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
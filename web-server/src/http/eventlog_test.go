@@ -0,0 +1,84 @@
+package http
+
+import (
+	"strings"
+	"testing"
+)
+
+// seqs returns the sequence numbers of events
+func seqs(events []chatEvent) []int64 {
+	numbers := make([]int64, len(events))
+	for i, event := range events {
+		numbers[i] = event.Seq
+	}
+	return numbers
+}
+
+func TestEventLogSince(t *testing.T) {
+	log := newEventLog(1024)
+	for _, content := range []string{"a", "b", "c"} {
+		log.append(chatEvent{Type: chatEventDelta, Content: content})
+	}
+
+	tests := []struct {
+		seq  int64
+		want []int64
+	}{
+		{0, []int64{1, 2, 3}},
+		{1, []int64{2, 3}},
+		{3, []int64{}},
+		{7, []int64{}},
+	}
+	for _, tt := range tests {
+		events, missed, _, closed := log.since(tt.seq)
+		if got := seqs(events); missed || closed || len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+			t.Errorf("since(%d) = %v, missed %t, closed %t, want %v", tt.seq, got, missed, closed, tt.want)
+		}
+	}
+}
+
+func TestEventLogDropsOldestBytes(t *testing.T) {
+	size := eventSize(chatEvent{Type: chatEventDelta, Seq: 1, Content: "abcd"})
+	log := newEventLog(size * 2)
+	for _, content := range []string{"abcd", "efgh", "ijkl"} {
+		log.append(chatEvent{Type: chatEventDelta, Content: content})
+	}
+
+	events, missed, _, _ := log.since(0)
+	if got := seqs(events); !missed || len(got) != 2 || got[0] != 2 {
+		t.Fatalf("since(0) = %v, missed %t, want events 2 and 3 with the first one missed", got, missed)
+	}
+	if _, missed, _, _ := log.since(1); missed {
+		t.Fatalf("since(1) missed events that are still in the log")
+	}
+
+	// The newest event is kept even when it is larger than the whole log
+	log.append(chatEvent{Type: chatEventDelta, Content: strings.Repeat("x", size*4)})
+	if events, _, _, _ := log.since(3); len(events) != 1 || events[0].Seq != 4 {
+		t.Fatalf("since(3) = %v, want the oversized event", seqs(events))
+	}
+}
+
+func TestEventLogClose(t *testing.T) {
+	log := newEventLog(1024)
+	_, _, changed, _ := log.since(0)
+	log.append(chatEvent{Type: chatEventDelta, Content: "a"})
+	select {
+	case <-changed:
+	default:
+		t.Fatal("append did not wake up the readers")
+	}
+
+	_, _, changed, _ = log.since(1)
+	log.close()
+	select {
+	case <-changed:
+	default:
+		t.Fatal("close did not wake up the readers")
+	}
+
+	log.append(chatEvent{Type: chatEventDelta, Content: "b"})
+	if events, _, _, closed := log.since(1); !closed || len(events) != 0 || !log.isClosed() {
+		t.Fatalf("since(1) = %v, closed %t after close, want no new events", seqs(events), closed)
+	}
+}
@@ -0,0 +1,506 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/fhir"
+	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/vitals"
+)
+
+const (
+	// maxFHIRResourceSize limits the size of an imported FHIR resource
+	maxFHIRResourceSize = 1 << 20
+	// selfFHIRPatientID is the FHIR Patient ID of the account's own profile; managed profiles use their numeric ID
+	selfFHIRPatientID = "self"
+)
+
+// fhirPatient represents the patient profile a FHIR request refers to
+type fhirPatient struct {
+	// ID is the FHIR Patient ID
+	ID string
+	// PatientID is the managed profile ID, zero for the account's own profile
+	PatientID    int32
+	Relationship string
+	Account      *grpc.Account
+	Resource     string
+	Info         grpc.PatientInfo
+	Version      int64
+}
+
+// handleFHIRReadPatient handles FHIR Patient read requests
+func (s *Server) handleFHIRReadPatient(c *gin.Context) {
+	patient, ok := s.resolveFHIRPatient(c, c.Query("token"), c.Param("id"), auditActionPatientRead)
+	if !ok {
+		return
+	}
+
+	s.recordAudit(c, accountActor(patient.Account), auditActionPatientRead, patient.Resource, audit.OutcomeSuccess, "fhir")
+
+	c.Header("ETag", formatETag(patient.Version))
+	s.writeFHIR(c, http.StatusOK, fhir.NewPatient(patient.ID, patient.Info, patient.Version))
+}
+
+// handleFHIRUpdatePatient handles FHIR Patient update requests.
+// Only the demographics are taken from the resource; weight, height and clinical history are kept.
+func (s *Server) handleFHIRUpdatePatient(c *gin.Context) {
+	var resource fhir.Patient
+	if !s.readFHIR(c, "Patient", &resource) {
+		return
+	}
+
+	id := c.Param("id")
+	if resource.ID != "" && resource.ID != id {
+		s.writeFHIRError(c, http.StatusBadRequest, "invalid", "Resource id does not match the URL")
+		return
+	}
+
+	patient, ok := s.resolveFHIRPatient(c, c.Query("token"), id, auditActionPatientUpdate)
+	if !ok {
+		return
+	}
+
+	if !ifMatchSatisfied(c.GetHeader("If-Match"), patient.Version) {
+		c.Header("ETag", formatETag(patient.Version))
+		s.writeFHIRError(c, http.StatusPreconditionFailed, "conflict", "Patient was modified by another request")
+		return
+	}
+
+	info := patient.Info
+	if err := fhir.ApplyPatient(resource, &info, time.Now()); err != nil {
+		s.writeFHIR(c, http.StatusUnprocessableEntity, fhir.OutcomeFromError(err))
+		return
+	}
+
+	version, ok := s.saveFHIRPatient(c, patient, info, "fhir Patient")
+	if !ok {
+		return
+	}
+
+	c.Header("ETag", formatETag(version))
+	s.writeFHIR(c, http.StatusOK, fhir.NewPatient(patient.ID, info, version))
+}
+
+// handleFHIREverything handles Patient $everything requests, bundling the patient with all their observations and allergies
+func (s *Server) handleFHIREverything(c *gin.Context) {
+	token := c.Query("token")
+	patient, ok := s.resolveFHIRPatient(c, token, c.Param("id"), auditActionPatientRead)
+	if !ok {
+		return
+	}
+
+	observations, ok := s.fhirObservations(c, token, patient)
+	if !ok {
+		return
+	}
+
+	resources := []fhir.Resource{fhir.NewPatient(patient.ID, patient.Info, patient.Version)}
+	for _, observation := range observations {
+		resources = append(resources, observation)
+	}
+	for _, allergy := range fhirAllergies(patient) {
+		resources = append(resources, allergy)
+	}
+
+	s.recordAudit(c, accountActor(patient.Account), auditActionPatientRead, patient.Resource, audit.OutcomeSuccess, "fhir $everything")
+	s.writeFHIR(c, http.StatusOK, fhir.NewBundle("searchset", fhirBaseURL(c), resources))
+}
+
+// handleFHIRSearchObservations handles FHIR Observation searches by patient
+func (s *Server) handleFHIRSearchObservations(c *gin.Context) {
+	token := c.Query("token")
+	patient, ok := s.resolveFHIRPatient(c, token, c.Query("patient"), auditActionVitalsRead)
+	if !ok {
+		return
+	}
+
+	observations, ok := s.fhirObservations(c, token, patient)
+	if !ok {
+		return
+	}
+
+	resources := make([]fhir.Resource, len(observations))
+	for i, observation := range observations {
+		resources[i] = observation
+	}
+
+	s.recordAudit(c, accountActor(patient.Account), auditActionVitalsRead, patient.Resource, audit.OutcomeSuccess, "fhir")
+	s.writeFHIR(c, http.StatusOK, fhir.NewBundle("searchset", fhirBaseURL(c), resources))
+}
+
+// handleFHIRCreateObservation handles FHIR Observation imports.
+// Vital signs are added to the vitals history, while body height updates the patient profile.
+func (s *Server) handleFHIRCreateObservation(c *gin.Context) {
+	var resource fhir.Observation
+	if !s.readFHIR(c, "Observation", &resource) {
+		return
+	}
+
+	if resource.Subject == nil {
+		s.writeFHIRError(c, http.StatusUnprocessableEntity, "required", "Observation.subject must reference the patient")
+		return
+	}
+	patientID, ok := fhir.ParsePatientReference(*resource.Subject)
+	if !ok {
+		s.writeFHIRError(c, http.StatusUnprocessableEntity, "invalid", "Observation.subject must reference a Patient")
+		return
+	}
+
+	values, err := fhir.ParseObservation(resource, time.Now())
+	if err != nil {
+		s.writeFHIR(c, http.StatusUnprocessableEntity, fhir.OutcomeFromError(err))
+		return
+	}
+
+	token := c.Query("token")
+
+	// Body height belongs to the patient profile
+	if values.Height != nil {
+		patient, ok := s.resolveFHIRPatient(c, token, patientID, auditActionPatientUpdate)
+		if !ok {
+			return
+		}
+
+		info := patient.Info
+		info.Height = *values.Height
+		if _, ok := s.saveFHIRPatient(c, patient, info, "fhir Observation"); !ok {
+			return
+		}
+
+		observations := fhir.ProfileObservations(patient.ID, info)
+		s.writeFHIR(c, http.StatusOK, observations[len(observations)-1])
+		return
+	}
+
+	if err := vitals.Validate(*values.Vitals); err != nil {
+		s.writeFHIRError(c, http.StatusUnprocessableEntity, "value", err.Error())
+		return
+	}
+
+	patient, ok := s.resolveFHIRPatient(c, token, patientID, auditActionVitalsRecord)
+	if !ok {
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	recordOutput, err := s.dbClient.RecordVitals(ctx, grpc.RecordVitalsInput{
+		Token:     token,
+		Vitals:    *values.Vitals,
+		PatientID: patient.PatientID,
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to record FHIR observation: %v", err)
+		s.recordAudit(c, accountActor(patient.Account), auditActionVitalsRecord, patient.Resource, audit.OutcomeFailure, "fhir")
+		s.writeFHIRError(c, http.StatusInternalServerError, "exception", "Failed to record observation")
+		return
+	}
+
+	s.recordAudit(c, accountActor(patient.Account), auditActionVitalsRecord, patient.Resource, audit.OutcomeSuccess, "fhir vitals "+recordOutput.ID)
+
+	values.Vitals.ID = recordOutput.ID
+	observation := fhir.VitalsObservations(patient.ID, *values.Vitals)[0]
+	c.Header("Location", fhirBaseURL(c)+"/Observation/"+observation.ID)
+	s.writeFHIR(c, http.StatusCreated, observation)
+}
+
+// handleFHIRSearchAllergies handles FHIR AllergyIntolerance searches by patient
+func (s *Server) handleFHIRSearchAllergies(c *gin.Context) {
+	patient, ok := s.resolveFHIRPatient(c, c.Query("token"), c.Query("patient"), auditActionPatientRead)
+	if !ok {
+		return
+	}
+
+	allergies := fhirAllergies(patient)
+	resources := make([]fhir.Resource, len(allergies))
+	for i, allergy := range allergies {
+		resources[i] = allergy
+	}
+
+	s.recordAudit(c, accountActor(patient.Account), auditActionPatientRead, patient.Resource, audit.OutcomeSuccess, "fhir AllergyIntolerance")
+	s.writeFHIR(c, http.StatusOK, fhir.NewBundle("searchset", fhirBaseURL(c), resources))
+}
+
+// handleFHIRCreateAllergy handles FHIR AllergyIntolerance imports.
+// An allergy to a substance already on file replaces the stored one.
+func (s *Server) handleFHIRCreateAllergy(c *gin.Context) {
+	var resource fhir.AllergyIntolerance
+	if !s.readFHIR(c, "AllergyIntolerance", &resource) {
+		return
+	}
+
+	patientID, ok := fhir.ParsePatientReference(resource.Patient)
+	if !ok {
+		s.writeFHIRError(c, http.StatusUnprocessableEntity, "invalid", "AllergyIntolerance.patient must reference a Patient")
+		return
+	}
+
+	allergy, err := fhir.ParseAllergyIntolerance(resource)
+	if err != nil {
+		s.writeFHIR(c, http.StatusUnprocessableEntity, fhir.OutcomeFromError(err))
+		return
+	}
+
+	patient, ok := s.resolveFHIRPatient(c, c.Query("token"), patientID, auditActionPatientUpdate)
+	if !ok {
+		return
+	}
+
+	// Copy the list so the loaded profile is not modified
+	info := patient.Info
+	info.Allergies = append([]grpc.Allergy{}, patient.Info.Allergies...)
+	index := len(info.Allergies)
+	for i, existing := range info.Allergies {
+		if strings.EqualFold(existing.Substance, allergy.Substance) {
+			index = i
+		}
+	}
+	if index == len(info.Allergies) {
+		info.Allergies = append(info.Allergies, allergy)
+	} else {
+		info.Allergies[index] = allergy
+	}
+
+	if _, ok := s.saveFHIRPatient(c, patient, info, "fhir AllergyIntolerance"); !ok {
+		return
+	}
+
+	created := fhir.NewAllergyIntolerance(fhirAllergyID(patient.ID, index), patient.ID, allergy)
+	c.Header("Location", fhirBaseURL(c)+"/AllergyIntolerance/"+created.ID)
+	s.writeFHIR(c, http.StatusCreated, created)
+}
+
+// resolveFHIRPatient loads the patient profile behind a FHIR Patient ID, checking that the token's account owns it.
+// On failure an OperationOutcome has already been written.
+func (s *Server) resolveFHIRPatient(c *gin.Context, token, id, action string) (*fhirPatient, bool) {
+	if token == "" {
+		s.errorLogger.Printf("Missing token in FHIR request")
+		s.writeFHIRError(c, http.StatusBadRequest, "required", "Token is required")
+		return nil, false
+	}
+	if id == "" {
+		s.writeFHIRError(c, http.StatusBadRequest, "required", "A patient is required")
+		return nil, false
+	}
+
+	if id != selfFHIRPatientID {
+		patientID, err := strconv.ParseInt(id, 10, 32)
+		if err != nil || patientID <= 0 {
+			s.writeFHIRError(c, http.StatusNotFound, "not-found", "Patient not found")
+			return nil, false
+		}
+
+		account, profile, status, message := s.loadOwnedPatient(c, token, int32(patientID), action)
+		if status != http.StatusOK {
+			s.writeFHIRError(c, status, fhirIssueCode(status), message)
+			return nil, false
+		}
+
+		return &fhirPatient{
+			ID:           id,
+			PatientID:    profile.ID,
+			Relationship: profile.Relationship,
+			Account:      account,
+			Resource:     profileResource(profile.ID),
+			Info:         profile.PatientInfo,
+			Version:      profile.Version,
+		}, true
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	account := s.lookupAccount(c, token)
+	getPatientOutput, err := s.dbClient.GetPatient(ctx, grpc.GetPatientInput{Token: token})
+	if err != nil || account == nil {
+		s.errorLogger.Printf("Failed to get patient for FHIR: %v", err)
+		s.recordAudit(c, accountActor(account), action, patientResource(account), audit.OutcomeFailure, "fhir")
+		if grpc.IsNotFound(err) {
+			s.writeFHIRError(c, http.StatusNotFound, "not-found", "Patient not found")
+			return nil, false
+		}
+		s.writeFHIRError(c, http.StatusUnauthorized, "login", "Invalid token")
+		return nil, false
+	}
+
+	return &fhirPatient{
+		ID:       selfFHIRPatientID,
+		Account:  account,
+		Resource: patientResource(account),
+		Info:     getPatientOutput.PatientInfo,
+		Version:  getPatientOutput.Version,
+	}, true
+}
+
+// saveFHIRPatient validates and stores a patient profile changed by a FHIR import, returning its new version.
+// On failure an OperationOutcome has already been written.
+func (s *Server) saveFHIRPatient(c *gin.Context, patient *fhirPatient, info grpc.PatientInfo, detail string) (int64, bool) {
+	// Run the same normalization and validation as the JSON API
+	normalized := patientInfoFromGrpc(info)
+	if fieldErrs := normalizePatientInfo(&normalized, ""); len(fieldErrs) > 0 {
+		outcome := fhir.OperationOutcome{ResourceType: "OperationOutcome"}
+		for _, fieldErr := range fieldErrs {
+			outcome.Issue = append(outcome.Issue, fhir.Issue{
+				Severity:    "error",
+				Code:        "value",
+				Diagnostics: fieldErr.Message,
+				Expression:  []string{fieldErr.Field},
+			})
+		}
+		s.writeFHIR(c, http.StatusUnprocessableEntity, outcome)
+		return 0, false
+	}
+	info = patientInfoToGrpc(normalized)
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	token := c.Query("token")
+	var version int64
+	var err error
+	if patient.PatientID == 0 {
+		var saveOutput *grpc.SavePatientInfoOutput
+		saveOutput, err = s.dbClient.SavePatientInfo(ctx, grpc.SavePatientInfoInput{
+			Token:           token,
+			PatientInfo:     info,
+			ExpectedVersion: patient.Version,
+		})
+		if err == nil {
+			version = saveOutput.Version
+		}
+	} else {
+		var updateOutput *grpc.UpdatePatientOutput
+		updateOutput, err = s.dbClient.UpdatePatient(ctx, grpc.UpdatePatientInput{
+			Token:           token,
+			PatientID:       patient.PatientID,
+			Relationship:    patient.Relationship,
+			PatientInfo:     info,
+			ExpectedVersion: patient.Version,
+		})
+		if err == nil {
+			version = updateOutput.Patient.Version
+		}
+	}
+
+	if err != nil {
+		s.errorLogger.Printf("Failed to save patient from FHIR: %v", err)
+		s.recordAudit(c, accountActor(patient.Account), auditActionPatientUpdate, patient.Resource, audit.OutcomeFailure, detail)
+		if grpc.IsVersionConflict(err) {
+			s.writeFHIRError(c, http.StatusPreconditionFailed, "conflict", "Patient was modified by another request")
+			return 0, false
+		}
+		s.writeFHIRError(c, http.StatusInternalServerError, "exception", "Failed to save patient")
+		return 0, false
+	}
+
+	s.recordAudit(c, accountActor(patient.Account), auditActionPatientUpdate, patient.Resource, audit.OutcomeSuccess, detail)
+	return version, true
+}
+
+// fhirObservations maps the patient's profile measurements and vitals history to observations.
+// On failure an OperationOutcome has already been written.
+func (s *Server) fhirObservations(c *gin.Context, token string, patient *fhirPatient) ([]fhir.Observation, bool) {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	listOutput, err := s.dbClient.ListVitals(ctx, grpc.ListVitalsInput{
+		Token:     token,
+		Limit:     maxVitalsListLimit,
+		PatientID: patient.PatientID,
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to list vitals for FHIR: %v", err)
+		s.recordAudit(c, accountActor(patient.Account), auditActionVitalsRead, patient.Resource, audit.OutcomeFailure, "fhir")
+		s.writeFHIRError(c, http.StatusInternalServerError, "exception", "Failed to list observations")
+		return nil, false
+	}
+
+	observations := fhir.ProfileObservations(patient.ID, patient.Info)
+	for _, v := range listOutput.Vitals {
+		observations = append(observations, fhir.VitalsObservations(patient.ID, v)...)
+	}
+	return observations, true
+}
+
+// fhirAllergies maps the patient's allergies to AllergyIntolerance resources
+func fhirAllergies(patient *fhirPatient) []fhir.AllergyIntolerance {
+	allergies := make([]fhir.AllergyIntolerance, len(patient.Info.Allergies))
+	for i, allergy := range patient.Info.Allergies {
+		allergies[i] = fhir.NewAllergyIntolerance(fhirAllergyID(patient.ID, i), patient.ID, allergy)
+	}
+	return allergies
+}
+
+// fhirAllergyID formats the ID of an allergy, which is only stable while the list is not reordered
+func fhirAllergyID(patientID string, index int) string {
+	return patientID + "-allergy-" + strconv.Itoa(index)
+}
+
+// readFHIR reads and decodes a FHIR resource from the request body, answering with an OperationOutcome when it cannot be mapped
+func (s *Server) readFHIR(c *gin.Context, resourceType string, v any) bool {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxFHIRResourceSize))
+	if err != nil {
+		s.errorLogger.Printf("Failed to read FHIR body: %v", err)
+		s.writeFHIRError(c, http.StatusBadRequest, "structure", "Invalid request")
+		return false
+	}
+
+	if err := fhir.Decode(body, resourceType, v); err != nil {
+		s.errorLogger.Printf("Invalid FHIR %s: %v", resourceType, err)
+		s.writeFHIR(c, http.StatusBadRequest, fhir.OutcomeFromError(err))
+		return false
+	}
+	return true
+}
+
+// writeFHIR writes a FHIR JSON response
+func (s *Server) writeFHIR(c *gin.Context, status int, v any) {
+	c.Header("Content-Type", fhir.ContentType+"; charset=utf-8")
+	c.JSON(status, v)
+}
+
+// writeFHIRError writes an OperationOutcome with a single issue
+func (s *Server) writeFHIRError(c *gin.Context, status int, code, message string) {
+	s.writeFHIR(c, status, fhir.NewOperationOutcome(code, message))
+}
+
+// fhirIssueCode maps an HTTP error status to an OperationOutcome issue code
+func fhirIssueCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return "required"
+	case http.StatusUnauthorized:
+		return "login"
+	case http.StatusForbidden:
+		return "forbidden"
+	case http.StatusNotFound:
+		return "not-found"
+	default:
+		return "exception"
+	}
+}
+
+// fhirBaseURL returns the base URL of the FHIR endpoints, as seen by the client
+func fhirBaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host + "/api/fhir"
+}
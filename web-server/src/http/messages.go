@@ -0,0 +1,136 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/grpc"
+)
+
+// Roles a chat message may have; anything else could override the doctor prompt of the AI service
+const (
+	roleUser      = "user"
+	roleAssistant = "assistant"
+)
+
+// Default chat limits, used when none are configured
+const (
+	defaultChatMaxMessages     = 100
+	defaultChatMaxMessageBytes = 16 * 1024
+	defaultChatMaxPayloadBytes = 512 * 1024
+)
+
+// replySignatureTrailer carries the reply signature to plain text clients, after the reply
+const replySignatureTrailer = "X-Reply-Signature"
+
+// bindChatRequest reads a chat request within the payload limit and checks its messages:
+// only user and assistant roles, alternating, ending with a user turn, within the count and size limits.
+// On failure the 400 or 413 response has already been written.
+func (s *Server) bindChatRequest(c *gin.Context, req *ChatRequest) bool {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, s.config.ChatMaxPayloadBytes)
+	if err := c.ShouldBindJSON(req); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			s.errorLogger.Printf("Chat request over %d bytes", s.config.ChatMaxPayloadBytes)
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("Request must be at most %d bytes", s.config.ChatMaxPayloadBytes)})
+			return false
+		}
+		s.errorLogger.Printf("Invalid chat request: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return false
+	}
+
+	// Limits are checked first, as a 413 tells the client to trim the history rather than fix it
+	limits := &fieldErrors{prefix: "messages"}
+	if len(req.Messages) > s.config.ChatMaxMessages {
+		limits.add("", "must have at most %d messages", s.config.ChatMaxMessages)
+	}
+	for i, message := range req.Messages {
+		if len(message.Content) > s.config.ChatMaxMessageBytes {
+			limits.add(fmt.Sprintf("[%d].content", i), "must be at most %d bytes", s.config.ChatMaxMessageBytes)
+		}
+	}
+	if len(limits.errors) > 0 {
+		s.errorLogger.Printf("Chat request over the limits: %v", limits.errors)
+		c.JSON(http.StatusRequestEntityTooLarge, ValidationErrorResponse{
+			Error:  "Messages too large",
+			Fields: limits.errors,
+		})
+		return false
+	}
+
+	errs := &fieldErrors{prefix: "messages"}
+	if len(req.Messages) == 0 {
+		errs.add("", "must have at least one message")
+	}
+	for i, message := range req.Messages {
+		field := fmt.Sprintf("[%d].role", i)
+		switch {
+		case message.Role != roleUser && message.Role != roleAssistant:
+			errs.add(field, "must be %s or %s", roleUser, roleAssistant)
+		case i > 0 && message.Role == req.Messages[i-1].Role:
+			errs.add(field, "must alternate with the previous message")
+		case i == len(req.Messages)-1 && message.Role != roleUser:
+			errs.add(field, "must be %s in the latest message", roleUser)
+		}
+	}
+	if len(errs.errors) > 0 {
+		s.errorLogger.Printf("Invalid chat messages: %v", errs.errors)
+		c.JSON(http.StatusBadRequest, ValidationErrorResponse{
+			Error:  "Invalid messages",
+			Fields: errs.errors,
+		})
+		return false
+	}
+
+	return true
+}
+
+// verifyReplies checks that the assistant messages of a history are the replies the gateway streamed to the account,
+// returning the errors for the ones that are unsigned or were altered. Nothing is checked when signing is disabled
+// or when the account could not be resolved, since replies streamed to an unresolved account are not signed either.
+func (s *Server) verifyReplies(account *grpc.Account, messages []Message) []FieldError {
+	if s.replyKey == nil || account == nil {
+		return nil
+	}
+
+	errs := &fieldErrors{prefix: "messages"}
+	for i, message := range messages {
+		if message.Role != roleAssistant {
+			continue
+		}
+
+		field := fmt.Sprintf("[%d].signature", i)
+		signature, err := base64.RawURLEncoding.DecodeString(message.Signature)
+		switch {
+		case message.Signature == "":
+			errs.add(field, "is required")
+		case err != nil || !hmac.Equal(signature, s.replyMAC(account, message.Content)):
+			errs.add(field, "does not match the reply as it was sent")
+		}
+	}
+	return errs.errors
+}
+
+// signReply signs a reply streamed to an account, returning an empty signature when signing is disabled
+func (s *Server) signReply(account *grpc.Account, content string) string {
+	if s.replyKey == nil || account == nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(s.replyMAC(account, content))
+}
+
+// replyMAC computes the HMAC of a reply, bound to the account so signatures cannot be reused by others
+func (s *Server) replyMAC(account *grpc.Account, content string) []byte {
+	mac := hmac.New(sha256.New, s.replyKey)
+	mac.Write([]byte(strconv.FormatInt(int64(account.ID), 10)))
+	mac.Write([]byte{0})
+	mac.Write([]byte(content))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"slices"
+	"testing"
+
+	"unb.br/web-server/src/grpc"
+)
+
+func TestVerifyReplies(t *testing.T) {
+	s := &Server{replyKey: []byte("test-key")}
+	account := &grpc.Account{ID: 1}
+	other := &grpc.Account{ID: 2}
+
+	history := func(signature string) []Message {
+		return []Message{
+			{Role: roleUser, Content: "Estou com febre"},
+			{Role: roleAssistant, Content: "Há quanto tempo?", Signature: signature},
+			{Role: roleUser, Content: "Dois dias"},
+		}
+	}
+	signed := s.signReply(account, "Há quanto tempo?")
+
+	tests := []struct {
+		name     string
+		account  *grpc.Account
+		messages []Message
+		fields   []string
+	}{
+		{"signed reply", account, history(signed), nil},
+		{"unsigned reply", account, history(""), []string{"messages[1].signature"}},
+		{"altered reply", account, append(history(signed)[:1], Message{Role: roleAssistant, Content: "Outra resposta", Signature: signed}), []string{"messages[1].signature"}},
+		{"reply signed for another account", other, history(signed), []string{"messages[1].signature"}},
+		{"malformed signature", account, history("not base64!"), []string{"messages[1].signature"}},
+		{"unresolved account", nil, history(""), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fieldsOf(s.verifyReplies(tt.account, tt.messages)); !slices.Equal(got, tt.fields) {
+				t.Errorf("verifyReplies() fields = %v, want %v", got, tt.fields)
+			}
+		})
+	}
+
+	if errs := (&Server{}).verifyReplies(account, history("")); len(errs) > 0 {
+		t.Errorf("verifyReplies() with signing disabled = %v, want no errors", errs)
+	}
+}
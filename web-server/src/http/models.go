@@ -0,0 +1,110 @@
+package http
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"unb.br/web-server/src/grpc"
+)
+
+const (
+	// anyRole is the allowlist entry used for roles without one of their own
+	anyRole = "*"
+	// maxTemperature is the highest sampling temperature the AI service accepts
+	maxTemperature = 2
+	// defaultMaxOutputTokens bounds max_tokens when no bound is configured
+	defaultMaxOutputTokens = 4096
+	// diagnosisModelHeader tells plain text clients which model answered
+	diagnosisModelHeader = "X-Diagnosis-Model"
+	// diagnosisBackendHeader tells plain text clients which AI backend answered
+	diagnosisBackendHeader = "X-Diagnosis-Backend"
+)
+
+// languagePattern accepts BCP 47 language tags such as "pt-BR" or "en"
+var languagePattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// GenerationOptions represents the generation parameters a client may choose for a diagnosis.
+// Unset ones are left to the AI service, except the model, which defaults to the first one allowed for the role.
+type GenerationOptions struct {
+	Model       string   `json:"model,omitempty"`
+	Temperature *float32 `json:"temperature,omitempty"`
+	MaxTokens   int32    `json:"max_tokens,omitempty"`
+	Language    string   `json:"language,omitempty"`
+}
+
+// ParseModelAllowlist parses the models each role may use, as "role=model,model;role=model".
+// The "*" role applies to the roles that are not listed.
+func ParseModelAllowlist(value string) (map[string][]string, error) {
+	allowlist := map[string][]string{}
+	for _, entry := range strings.Split(value, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		role, models, ok := strings.Cut(entry, "=")
+		role = strings.TrimSpace(role)
+		if !ok || role == "" {
+			return nil, fmt.Errorf("invalid model allowlist entry %q", entry)
+		}
+		for _, model := range strings.Split(models, ",") {
+			if model = strings.TrimSpace(model); model != "" {
+				allowlist[role] = append(allowlist[role], model)
+			}
+		}
+		if len(allowlist[role]) == 0 {
+			return nil, fmt.Errorf("no models allowed for role %q", role)
+		}
+	}
+	return allowlist, nil
+}
+
+// validateGeneration checks the ranges of the generation options, and that a model is only chosen when
+// there is an allowlist to check it against
+func (s *Server) validateGeneration(options GenerationOptions) []FieldError {
+	errs := &fieldErrors{}
+	if options.Model != "" && len(s.config.ModelAllowlist) == 0 {
+		errs.add("model", "cannot be chosen, model selection is disabled")
+	}
+	if options.Temperature != nil && (*options.Temperature < 0 || *options.Temperature > maxTemperature) {
+		errs.add("temperature", "must be between 0 and %d", maxTemperature)
+	}
+	if options.MaxTokens < 0 || int(options.MaxTokens) > s.config.MaxOutputTokens {
+		errs.add("max_tokens", "must be between 1 and %d", s.config.MaxOutputTokens)
+	}
+	if options.Language != "" && !languagePattern.MatchString(options.Language) {
+		errs.add("language", "must be a language tag such as pt-BR")
+	}
+	return errs.errors
+}
+
+// allowedModel resolves the model of a diagnosis from the allowlist of the account's role,
+// reporting false when the requested model is not in it. validateGeneration rejects requested models
+// when there is no allowlist.
+func (s *Server) allowedModel(account *grpc.Account, requested string) (string, bool) {
+	var role string
+	if account != nil {
+		role = account.Role
+	}
+	models, ok := s.config.ModelAllowlist[role]
+	if !ok {
+		models = s.config.ModelAllowlist[anyRole]
+	}
+
+	if requested == "" {
+		if len(models) == 0 {
+			// Without an allowlist the AI service picks its default model
+			return "", true
+		}
+		return models[0], true
+	}
+	return requested, slices.Contains(models, requested)
+}
+
+// applyGeneration sets the generation options and the resolved model on a diagnosis
+func applyGeneration(input *grpc.DiagnoseInput, options GenerationOptions, model string) {
+	input.Model = model
+	input.Temperature = options.Temperature
+	input.MaxTokens = options.MaxTokens
+	input.Language = options.Language
+}
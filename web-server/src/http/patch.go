@@ -0,0 +1,220 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/grpc"
+)
+
+const (
+	// maxPatchSize limits the size of a merge patch document
+	maxPatchSize = 1 << 20
+	// mergePatchContentType is the media type of JSON Merge Patch documents
+	mergePatchContentType = "application/merge-patch+json"
+)
+
+// handlePatchPatient handles partial patient updates with a JSON Merge Patch (RFC 7396) body.
+// The update is applied on top of the stored version and rejected with 412 if it changed meanwhile.
+func (s *Server) handlePatchPatient(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		s.errorLogger.Printf("Missing token in patch patient request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token is required"})
+		return
+	}
+
+	if c.ContentType() != mergePatchContentType {
+		s.errorLogger.Printf("Unsupported patch content type: %s", c.ContentType())
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type must be " + mergePatchContentType})
+		return
+	}
+
+	// Read the merge patch document, which must be a JSON object
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxPatchSize))
+	if err != nil {
+		s.errorLogger.Printf("Failed to read patch body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	var patch map[string]any
+	if err := json.Unmarshal(body, &patch); err != nil || patch == nil {
+		s.errorLogger.Printf("Invalid merge patch: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Body must be a JSON Merge Patch object"})
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Load the current profile and its version
+	account := s.lookupAccount(c, token)
+	getPatientOutput, err := s.dbClient.GetPatient(ctx, grpc.GetPatientInput{Token: token})
+	if err != nil {
+		s.errorLogger.Printf("Failed to get patient for patch: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionPatientUpdate, patientResource(account), audit.OutcomeFailure, "patch")
+		if grpc.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Patient not found"})
+			return
+		}
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return
+	}
+
+	expectedVersion, ok := s.checkIfMatch(c, getPatientOutput.Version)
+	if !ok {
+		return
+	}
+
+	// Apply the patch to the JSON representation of the current profile
+	current, err := toJSONObject(patientInfoFromGrpc(getPatientOutput.PatientInfo))
+	if err != nil {
+		s.errorLogger.Printf("Failed to encode patient for patch: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update patient"})
+		return
+	}
+
+	merged, err := json.Marshal(mergePatch(current, patch))
+	if err != nil {
+		s.errorLogger.Printf("Failed to encode patched patient: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update patient"})
+		return
+	}
+
+	var patient PatientInfo
+	if err := json.Unmarshal(merged, &patient); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Patch produces an invalid patient: " + err.Error()})
+		return
+	}
+
+	if fieldErrs := normalizePatientInfo(&patient, "patient."); len(fieldErrs) > 0 {
+		c.JSON(http.StatusBadRequest, ValidationErrorResponse{
+			Error:  "Validation failed",
+			Fields: fieldErrs,
+		})
+		return
+	}
+
+	s.savePatient(c, token, account, patient, expectedVersion, "patch")
+}
+
+// savePatient stores a validated patient profile and answers with its new version.
+// Version conflicts are answered with 412 Precondition Failed.
+func (s *Server) savePatient(c *gin.Context, token string, account *grpc.Account, patient PatientInfo, expectedVersion int64, detail string) {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	savePatientInput := grpc.SavePatientInfoInput{
+		Token:           token,
+		PatientInfo:     patientInfoToGrpc(patient),
+		ExpectedVersion: expectedVersion,
+	}
+
+	savePatientOutput, err := s.dbClient.SavePatientInfo(ctx, savePatientInput)
+	if err != nil {
+		s.errorLogger.Printf("Failed to save patient: %v", err)
+		if grpc.IsVersionConflict(err) {
+			s.recordAudit(c, accountActor(account), auditActionPatientUpdate, patientResource(account), audit.OutcomeFailure, detail+": version conflict")
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": "Patient was modified by another request"})
+			return
+		}
+		s.recordAudit(c, accountActor(account), auditActionPatientUpdate, patientResource(account), audit.OutcomeFailure, detail)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save patient"})
+		return
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionPatientUpdate, patientResource(account), audit.OutcomeSuccess, detail)
+
+	c.Header("ETag", formatETag(savePatientOutput.Version))
+	c.JSON(http.StatusOK, PatientInfoResponse{
+		Success: savePatientOutput.Success,
+		Version: savePatientOutput.Version,
+	})
+}
+
+// checkIfMatch compares the If-Match header with the current version and answers 412 on mismatch.
+// It returns the version the save must expect, which is the current one when the header is absent or "*".
+func (s *Server) checkIfMatch(c *gin.Context, currentVersion int64) (int64, bool) {
+	if ifMatchSatisfied(c.GetHeader("If-Match"), currentVersion) {
+		return currentVersion, true
+	}
+
+	c.Header("ETag", formatETag(currentVersion))
+	c.JSON(http.StatusPreconditionFailed, gin.H{"error": "Patient was modified by another request"})
+	return 0, false
+}
+
+// ifMatchSatisfied reports whether an If-Match header value accepts the current version
+func ifMatchSatisfied(ifMatch string, currentVersion int64) bool {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+
+	for _, tag := range strings.Split(ifMatch, ",") {
+		if version, ok := parseETag(tag); ok && version == currentVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// formatETag formats a patient version as a strong entity tag
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseETag extracts the patient version from an entity tag
+func parseETag(tag string) (int64, bool) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		return 0, false
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	return version, err == nil
+}
+
+// mergePatch applies a JSON Merge Patch (RFC 7396) to a decoded JSON document
+func mergePatch(target any, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+	return targetObject
+}
+
+// toJSONObject converts a value to its decoded JSON object form
+func toJSONObject(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var object map[string]any
+	err = json.Unmarshal(data, &object)
+	return object, err
+}
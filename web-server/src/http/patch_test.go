@@ -0,0 +1,72 @@
+package http
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	// Examples from RFC 7396, appendix A
+	tests := []struct {
+		target string
+		patch  string
+		want   string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, tt := range tests {
+		var target, patch, want any
+		json.Unmarshal([]byte(tt.target), &target)
+		json.Unmarshal([]byte(tt.patch), &patch)
+		json.Unmarshal([]byte(tt.want), &want)
+
+		if got := mergePatch(target, patch); !reflect.DeepEqual(got, want) {
+			t.Errorf("mergePatch(%s, %s) = %v, want %s", tt.target, tt.patch, got, tt.want)
+		}
+	}
+}
+
+func TestIfMatchSatisfied(t *testing.T) {
+	tests := []struct {
+		ifMatch string
+		want    bool
+	}{
+		{"", true},
+		{"*", true},
+		{` * `, true},
+		{`"3"`, true},
+		{`W/"3"`, true},
+		{`"2"`, false},
+		{`"1", "3"`, true},
+		{`"1","2"`, false},
+		{`3`, false},
+		{`"three"`, false},
+	}
+
+	for _, tt := range tests {
+		if got := ifMatchSatisfied(tt.ifMatch, 3); got != tt.want {
+			t.Errorf("ifMatchSatisfied(%q, 3) = %t, want %t", tt.ifMatch, got, tt.want)
+		}
+	}
+}
+
+func TestETagRoundTrip(t *testing.T) {
+	for _, version := range []int64{0, 1, 42, 1 << 40} {
+		tag := formatETag(version)
+		if got, ok := parseETag(tag); !ok || got != version {
+			t.Errorf("parseETag(%s) = %d, %t, want %d", tag, got, ok, version)
+		}
+	}
+}
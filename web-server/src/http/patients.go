@@ -0,0 +1,343 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/grpc"
+)
+
+// relationships lists how a managed patient can relate to the account holder
+var relationships = map[string]bool{
+	"self":    true,
+	"child":   true,
+	"parent":  true,
+	"spouse":  true,
+	"sibling": true,
+	"other":   true,
+}
+
+// PatientProfile represents a patient profile managed by the account
+type PatientProfile struct {
+	ID           int32           `json:"id"`
+	Relationship string          `json:"relationship"`
+	Patient      PatientInfo     `json:"patient"`
+	Derived      *DerivedMetrics `json:"derived,omitempty"`
+	Version      int64           `json:"version"`
+}
+
+// PatientProfileRequest represents a patient profile create or update request
+type PatientProfileRequest struct {
+	Token        string      `json:"token" binding:"required"`
+	Relationship string      `json:"relationship"`
+	Patient      PatientInfo `json:"patient" binding:"required"`
+}
+
+// PatientProfilesResponse represents a patient profile list response
+type PatientProfilesResponse struct {
+	Patients []PatientProfile `json:"patients"`
+}
+
+// handleListPatients handles patient profile list requests
+func (s *Server) handleListPatients(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		s.errorLogger.Printf("Missing token in list patients request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token is required"})
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	account := s.lookupAccount(c, token)
+	listOutput, err := s.dbClient.ListPatients(ctx, grpc.ListPatientsInput{Token: token})
+	if err != nil || account == nil {
+		s.errorLogger.Printf("Failed to list patients: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionPatientRead, "patients", audit.OutcomeFailure, "list")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return
+	}
+
+	// Only return the profiles owned by the caller, whatever the database sent back
+	response := PatientProfilesResponse{
+		Patients: []PatientProfile{},
+	}
+	for _, profile := range listOutput.Patients {
+		if profile.OwnerID != account.ID {
+			s.errorLogger.Printf("Dropping patient %d not owned by user %d from list", profile.ID, account.ID)
+			continue
+		}
+		response.Patients = append(response.Patients, newPatientProfile(profile))
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionPatientRead, "patients", audit.OutcomeSuccess, "list")
+	c.JSON(http.StatusOK, response)
+}
+
+// handleCreatePatient handles patient profile creation requests
+func (s *Server) handleCreatePatient(c *gin.Context) {
+	var req PatientProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.errorLogger.Printf("Invalid create patient request: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	if !s.validatePatientProfile(c, &req) {
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	account := s.lookupAccount(c, req.Token)
+	if account == nil {
+		s.recordAudit(c, anonymousActor, auditActionPatientCreate, "patients", audit.OutcomeFailure, "")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return
+	}
+
+	createOutput, err := s.dbClient.CreatePatient(ctx, grpc.CreatePatientInput{
+		Token:        req.Token,
+		Relationship: req.Relationship,
+		PatientInfo:  patientInfoToGrpc(req.Patient),
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to create patient: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionPatientCreate, "patients", audit.OutcomeFailure, "")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create patient"})
+		return
+	}
+
+	profile := createOutput.Patient
+	s.recordAudit(c, accountActor(account), auditActionPatientCreate, profileResource(profile.ID), audit.OutcomeSuccess, "")
+
+	c.Header("ETag", formatETag(profile.Version))
+	c.JSON(http.StatusCreated, newPatientProfile(profile))
+}
+
+// handleGetPatientProfile handles single patient profile requests
+func (s *Server) handleGetPatientProfile(c *gin.Context) {
+	patientID, ok := parsePatientID(c)
+	if !ok {
+		return
+	}
+
+	account, profile, ok := s.authorizePatient(c, c.Query("token"), patientID, auditActionPatientRead)
+	if !ok {
+		return
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionPatientRead, profileResource(profile.ID), audit.OutcomeSuccess, "")
+
+	c.Header("ETag", formatETag(profile.Version))
+	c.JSON(http.StatusOK, newPatientProfile(*profile))
+}
+
+// handleUpdatePatientProfile handles patient profile replacement requests, honoring If-Match
+func (s *Server) handleUpdatePatientProfile(c *gin.Context) {
+	patientID, ok := parsePatientID(c)
+	if !ok {
+		return
+	}
+
+	var req PatientProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.errorLogger.Printf("Invalid update patient request: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	if !s.validatePatientProfile(c, &req) {
+		return
+	}
+
+	account, profile, ok := s.authorizePatient(c, req.Token, patientID, auditActionPatientUpdate)
+	if !ok {
+		return
+	}
+
+	expectedVersion, ok := s.checkIfMatch(c, profile.Version)
+	if !ok {
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	updateOutput, err := s.dbClient.UpdatePatient(ctx, grpc.UpdatePatientInput{
+		Token:           req.Token,
+		PatientID:       patientID,
+		Relationship:    req.Relationship,
+		PatientInfo:     patientInfoToGrpc(req.Patient),
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to update patient %d: %v", patientID, err)
+		s.recordAudit(c, accountActor(account), auditActionPatientUpdate, profileResource(patientID), audit.OutcomeFailure, "")
+		if grpc.IsVersionConflict(err) {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": "Patient was modified by another request"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update patient"})
+		return
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionPatientUpdate, profileResource(patientID), audit.OutcomeSuccess, "")
+
+	c.Header("ETag", formatETag(updateOutput.Patient.Version))
+	c.JSON(http.StatusOK, newPatientProfile(updateOutput.Patient))
+}
+
+// handleDeletePatientProfile handles patient profile deletion requests
+func (s *Server) handleDeletePatientProfile(c *gin.Context) {
+	patientID, ok := parsePatientID(c)
+	if !ok {
+		return
+	}
+
+	token := c.Query("token")
+	account, _, ok := s.authorizePatient(c, token, patientID, auditActionPatientDelete)
+	if !ok {
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	deleteOutput, err := s.dbClient.DeletePatient(ctx, grpc.DeletePatientInput{
+		Token:     token,
+		PatientID: patientID,
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to delete patient %d: %v", patientID, err)
+		s.recordAudit(c, accountActor(account), auditActionPatientDelete, profileResource(patientID), audit.OutcomeFailure, "")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete patient"})
+		return
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionPatientDelete, profileResource(patientID), audit.OutcomeSuccess, "")
+	c.JSON(http.StatusOK, PatientInfoResponse{
+		Success: deleteOutput.Success,
+	})
+}
+
+// authorizePatient loads a patient profile and checks that the token's account owns it.
+// On failure the error response has already been written.
+func (s *Server) authorizePatient(c *gin.Context, token string, patientID int32, action string) (*grpc.Account, *grpc.PatientProfile, bool) {
+	account, profile, status, message := s.loadOwnedPatient(c, token, patientID, action)
+	if status != http.StatusOK {
+		c.JSON(status, gin.H{"error": message})
+		return nil, nil, false
+	}
+	return account, profile, true
+}
+
+// loadOwnedPatient loads a patient profile owned by the token's account.
+// Failures are audited and reported as an HTTP status with a message, leaving the response format to the caller.
+func (s *Server) loadOwnedPatient(c *gin.Context, token string, patientID int32, action string) (*grpc.Account, *grpc.PatientProfile, int, string) {
+	if token == "" {
+		s.errorLogger.Printf("Missing token in patient %d request", patientID)
+		return nil, nil, http.StatusBadRequest, "Token is required"
+	}
+
+	account := s.lookupAccount(c, token)
+	if account == nil {
+		s.recordAudit(c, anonymousActor, action, profileResource(patientID), audit.OutcomeFailure, "invalid token")
+		return nil, nil, http.StatusUnauthorized, "Invalid token"
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	getOutput, err := s.dbClient.GetPatientByID(ctx, grpc.GetPatientByIDInput{
+		Token:     token,
+		PatientID: patientID,
+	})
+	if err != nil {
+		if grpc.IsPermissionDenied(err) {
+			s.recordAudit(c, accountActor(account), action, profileResource(patientID), audit.OutcomeDenied, "not the owner")
+			return nil, nil, http.StatusForbidden, "Patient belongs to another account"
+		}
+		s.recordAudit(c, accountActor(account), action, profileResource(patientID), audit.OutcomeFailure, "")
+		if grpc.IsNotFound(err) {
+			return nil, nil, http.StatusNotFound, "Patient not found"
+		}
+		return nil, nil, http.StatusInternalServerError, "Failed to get patient"
+	}
+
+	// The gateway enforces ownership itself instead of trusting the database alone
+	if getOutput.Patient.OwnerID != account.ID {
+		s.errorLogger.Printf("User %d tried to use patient %d owned by user %d", account.ID, patientID, getOutput.Patient.OwnerID)
+		s.recordAudit(c, accountActor(account), action, profileResource(patientID), audit.OutcomeDenied, "not the owner")
+		return nil, nil, http.StatusForbidden, "Patient belongs to another account"
+	}
+
+	return account, &getOutput.Patient, http.StatusOK, ""
+}
+
+// validatePatientProfile normalizes a profile request and writes the validation errors, if any
+func (s *Server) validatePatientProfile(c *gin.Context, req *PatientProfileRequest) bool {
+	fieldErrs := normalizePatientInfo(&req.Patient, "patient.")
+
+	if req.Relationship == "" {
+		req.Relationship = "other"
+	}
+	if !relationships[req.Relationship] {
+		fieldErrs = append(fieldErrs, FieldError{
+			Field:   "relationship",
+			Message: "must be one of self, child, parent, spouse, sibling or other",
+		})
+	}
+
+	if len(fieldErrs) > 0 {
+		c.JSON(http.StatusBadRequest, ValidationErrorResponse{
+			Error:  "Validation failed",
+			Fields: fieldErrs,
+		})
+		return false
+	}
+	return true
+}
+
+// parsePatientID reads the patient ID from the route, answering 400 when it is malformed
+func parsePatientID(c *gin.Context) (int32, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid patient ID"})
+		return 0, false
+	}
+	return int32(id), true
+}
+
+// profileResource formats a patient profile as an audit resource
+func profileResource(patientID int32) string {
+	return fmt.Sprintf("patient:%d", patientID)
+}
+
+// newPatientProfile converts a patient profile from the gRPC client format
+func newPatientProfile(profile grpc.PatientProfile) PatientProfile {
+	patient := patientInfoFromGrpc(profile.PatientInfo)
+	return PatientProfile{
+		ID:           profile.ID,
+		Relationship: profile.Relationship,
+		Patient:      patient,
+		Derived:      derivePatientMetrics(patient),
+		Version:      profile.Version,
+	}
+}
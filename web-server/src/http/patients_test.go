@@ -0,0 +1,174 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// testPatient returns a patient that passes validation
+func testPatient(name string) PatientInfo {
+	return PatientInfo{Name: name, Age: 30, Gender: "feminino", Weight: 60, Height: 165}
+}
+
+func TestOwnPatient(t *testing.T) {
+	ts := newTestServer(t)
+	token := ts.register(t, "alice")
+
+	// Nothing is stored before the first save
+	resp, body := ts.do(t, http.MethodGet, "/api/patient?token="+token, nil)
+	expectError(t, resp, body, http.StatusUnauthorized, "Invalid token")
+	resp, body = ts.do(t, http.MethodPatch, "/api/patient?token="+token, `{"age": 31}`, "Content-Type", mergePatchContentType)
+	expectError(t, resp, body, http.StatusNotFound, "Patient not found")
+
+	resp, body = ts.do(t, http.MethodPost, "/api/patient", PatientInfoRequest{Token: token, Patient: testPatient("Alice")})
+	expectStatus(t, resp, body, http.StatusOK)
+	var saved PatientInfoResponse
+	decode(t, body, &saved)
+	if !saved.Success || saved.Version != 1 || resp.Header.Get("ETag") != `"1"` {
+		t.Fatalf("save returned %+v with ETag %s, want version 1", saved, resp.Header.Get("ETag"))
+	}
+
+	resp, body = ts.do(t, http.MethodGet, "/api/patient?token="+token, nil)
+	expectStatus(t, resp, body, http.StatusOK)
+	var got GetPatientResponse
+	decode(t, body, &got)
+	if got.Patient.Name != "Alice" || got.Version != 1 || got.Derived == nil {
+		t.Fatalf("got %+v, want Alice at version 1 with derived metrics", got)
+	}
+
+	// A merge patch applies on top of the stored version
+	resp, body = ts.do(t, http.MethodPatch, "/api/patient?token="+token, `{"age": 31}`, "Content-Type", mergePatchContentType, "If-Match", `"1"`)
+	expectStatus(t, resp, body, http.StatusOK)
+	decode(t, body, &saved)
+	if saved.Version != 2 {
+		t.Fatalf("patch returned version %d, want 2", saved.Version)
+	}
+	resp, body = ts.do(t, http.MethodGet, "/api/patient?token="+token, nil)
+	expectStatus(t, resp, body, http.StatusOK)
+	decode(t, body, &got)
+	if got.Patient.Age != 31 || got.Patient.Name != "Alice" {
+		t.Fatalf("got %+v after the patch, want Alice aged 31", got.Patient)
+	}
+
+	// If-Match accepts a list of entity tags
+	resp, body = ts.do(t, http.MethodPost, "/api/patient", PatientInfoRequest{Token: token, Patient: testPatient("Alice")}, "If-Match", `"1", "2"`)
+	expectStatus(t, resp, body, http.StatusOK)
+	decode(t, body, &saved)
+	if saved.Version != 3 || resp.Header.Get("ETag") != `"3"` {
+		t.Fatalf("save with a list If-Match returned version %d with ETag %s, want 3", saved.Version, resp.Header.Get("ETag"))
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		body    any
+		headers []string
+		status  int
+		message string
+	}{
+		{"get without token", http.MethodGet, "/api/patient", nil, nil, http.StatusBadRequest, "Token is required"},
+		{"get with invalid token", http.MethodGet, "/api/patient?token=invalid", nil, nil, http.StatusUnauthorized, "Invalid token"},
+		{"save with invalid token", http.MethodPost, "/api/patient", PatientInfoRequest{Token: "invalid", Patient: testPatient("Alice")}, nil, http.StatusInternalServerError, "Failed to save patient"},
+		{"save invalid patient", http.MethodPost, "/api/patient", PatientInfoRequest{Token: token, Patient: PatientInfo{Name: "Alice", Age: 200}}, nil, http.StatusBadRequest, "Validation failed"},
+		{"save without token", http.MethodPost, "/api/patient", map[string]any{"patient": testPatient("Alice")}, nil, http.StatusBadRequest, "Invalid request"},
+		{"save with stale version", http.MethodPost, "/api/patient", PatientInfoRequest{Token: token, Patient: testPatient("Alice")}, []string{"If-Match", `"1"`}, http.StatusPreconditionFailed, "Patient was modified by another request"},
+		{"save with invalid If-Match", http.MethodPost, "/api/patient", PatientInfoRequest{Token: token, Patient: testPatient("Alice")}, []string{"If-Match", "one"}, http.StatusPreconditionFailed, "Patient was modified by another request"},
+		{"patch with stale version", http.MethodPatch, "/api/patient?token=" + token, `{"age": 32}`, []string{"Content-Type", mergePatchContentType, "If-Match", `"1"`}, http.StatusPreconditionFailed, "Patient was modified by another request"},
+		{"patch with an array", http.MethodPatch, "/api/patient?token=" + token, `[]`, []string{"Content-Type", mergePatchContentType}, http.StatusBadRequest, "Body must be a JSON Merge Patch object"},
+		{"patch into an invalid patient", http.MethodPatch, "/api/patient?token=" + token, `{"gender": "unknown"}`, []string{"Content-Type", mergePatchContentType}, http.StatusBadRequest, "Validation failed"},
+		{"patch with invalid token", http.MethodPatch, "/api/patient?token=invalid", `{"age": 32}`, []string{"Content-Type", mergePatchContentType}, http.StatusUnauthorized, "Invalid token"},
+		{"patch as plain JSON", http.MethodPatch, "/api/patient?token=" + token, `{"age": 32}`, nil, http.StatusUnsupportedMediaType, "Content-Type must be application/merge-patch+json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := ts.do(t, tt.method, tt.path, tt.body, tt.headers...)
+			expectError(t, resp, body, tt.status, tt.message)
+		})
+	}
+}
+
+func TestManagedPatients(t *testing.T) {
+	ts := newTestServer(t)
+	token := ts.register(t, "alice")
+	otherToken := ts.register(t, "bob")
+
+	resp, body := ts.do(t, http.MethodPost, "/api/patients", PatientProfileRequest{Token: token, Relationship: "child", Patient: testPatient("Carol")})
+	expectStatus(t, resp, body, http.StatusCreated)
+	var created PatientProfile
+	decode(t, body, &created)
+	if created.ID == 0 || created.Relationship != "child" || created.Version != 1 || resp.Header.Get("ETag") != `"1"` {
+		t.Fatalf("create returned %+v with ETag %s", created, resp.Header.Get("ETag"))
+	}
+	path := fmt.Sprintf("/api/patients/%d", created.ID)
+
+	// Profiles are only listed to their owner
+	var list PatientProfilesResponse
+	resp, body = ts.do(t, http.MethodGet, "/api/patients?token="+token, nil)
+	expectStatus(t, resp, body, http.StatusOK)
+	decode(t, body, &list)
+	if len(list.Patients) != 1 || list.Patients[0].Patient.Name != "Carol" {
+		t.Fatalf("list returned %+v, want Carol", list.Patients)
+	}
+	resp, body = ts.do(t, http.MethodGet, "/api/patients?token="+otherToken, nil)
+	expectStatus(t, resp, body, http.StatusOK)
+	decode(t, body, &list)
+	if len(list.Patients) != 0 {
+		t.Fatalf("list of another account returned %+v", list.Patients)
+	}
+
+	var got PatientProfile
+	resp, body = ts.do(t, http.MethodGet, path+"?token="+token, nil)
+	expectStatus(t, resp, body, http.StatusOK)
+	decode(t, body, &got)
+	if got.Patient.Name != "Carol" || got.Version != 1 {
+		t.Fatalf("got %+v, want Carol at version 1", got)
+	}
+
+	updated := PatientProfileRequest{Token: token, Relationship: "child", Patient: testPatient("Caroline")}
+	resp, body = ts.do(t, http.MethodPut, path, updated, "If-Match", `"1"`)
+	expectStatus(t, resp, body, http.StatusOK)
+	decode(t, body, &got)
+	if got.Patient.Name != "Caroline" || got.Version != 2 || resp.Header.Get("ETag") != `"2"` {
+		t.Fatalf("update returned %+v with ETag %s, want Caroline at version 2", got, resp.Header.Get("ETag"))
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		body    any
+		headers []string
+		status  int
+		message string
+	}{
+		{"list without token", http.MethodGet, "/api/patients", nil, nil, http.StatusBadRequest, "Token is required"},
+		{"list with invalid token", http.MethodGet, "/api/patients?token=invalid", nil, nil, http.StatusUnauthorized, "Invalid token"},
+		{"create with invalid token", http.MethodPost, "/api/patients", PatientProfileRequest{Token: "invalid", Patient: testPatient("Dan")}, nil, http.StatusUnauthorized, "Invalid token"},
+		{"create with invalid relationship", http.MethodPost, "/api/patients", PatientProfileRequest{Token: token, Relationship: "friend", Patient: testPatient("Dan")}, nil, http.StatusBadRequest, "Validation failed"},
+		{"create invalid patient", http.MethodPost, "/api/patients", PatientProfileRequest{Token: token, Patient: PatientInfo{Name: "Dan"}}, nil, http.StatusBadRequest, "Validation failed"},
+		{"get with invalid ID", http.MethodGet, "/api/patients/abc?token=" + token, nil, nil, http.StatusBadRequest, "Invalid patient ID"},
+		{"get missing patient", http.MethodGet, "/api/patients/999?token=" + token, nil, nil, http.StatusNotFound, "Patient not found"},
+		{"get without token", http.MethodGet, path, nil, nil, http.StatusBadRequest, "Token is required"},
+		{"get with invalid token", http.MethodGet, path + "?token=invalid", nil, nil, http.StatusUnauthorized, "Invalid token"},
+		{"get from another account", http.MethodGet, path + "?token=" + otherToken, nil, nil, http.StatusForbidden, "Patient belongs to another account"},
+		{"update with stale version", http.MethodPut, path, updated, []string{"If-Match", `"1"`}, http.StatusPreconditionFailed, "Patient was modified by another request"},
+		{"update from another account", http.MethodPut, path, PatientProfileRequest{Token: otherToken, Patient: testPatient("Eve")}, nil, http.StatusForbidden, "Patient belongs to another account"},
+		{"update with malformed JSON", http.MethodPut, path, `{"token":`, nil, http.StatusBadRequest, "Invalid request"},
+		{"delete from another account", http.MethodDelete, path + "?token=" + otherToken, nil, nil, http.StatusForbidden, "Patient belongs to another account"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := ts.do(t, tt.method, tt.path, tt.body, tt.headers...)
+			expectError(t, resp, body, tt.status, tt.message)
+		})
+	}
+
+	resp, body = ts.do(t, http.MethodDelete, path+"?token="+token, nil)
+	expectStatus(t, resp, body, http.StatusOK)
+	resp, body = ts.do(t, http.MethodGet, path+"?token="+token, nil)
+	expectError(t, resp, body, http.StatusNotFound, "Patient not found")
+	resp, body = ts.do(t, http.MethodDelete, path+"?token="+token, nil)
+	expectError(t, resp, body, http.StatusNotFound, "Patient not found")
+}
@@ -0,0 +1,91 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/screening"
+)
+
+// initScreening builds the input screening pipeline: the heuristics, the denylist and,
+// when enabled, the moderation of the AI service
+func (s *Server) initScreening() error {
+	if s.screening != nil {
+		return nil
+	}
+
+	config, err := screening.Load(s.config.ScreeningRules)
+	if err != nil {
+		return err
+	}
+	denylist, err := screening.NewDenylist(config.Denylist)
+	if err != nil {
+		return err
+	}
+
+	checks := []screening.Check{screening.Heuristics{}, denylist}
+	if s.config.ScreeningModeration {
+		checks = append(checks, screening.NewModeration(s.aiClient))
+	}
+	s.screening = screening.New(config.Refusal, checks...)
+	return nil
+}
+
+// screenMessage runs a user message through the screening pipeline and audits the outcome of every check
+func (s *Server) screenMessage(ctx context.Context, c *gin.Context, account *grpc.Account, resource, conversationID, text string) screening.Result {
+	if s.screening == nil {
+		return screening.Result{Outcome: screening.OutcomeAllow}
+	}
+
+	result := s.screening.Screen(ctx, text)
+	for _, verdict := range result.Verdicts {
+		if verdict.Err != nil {
+			s.errorLogger.Printf("Screening check %s failed, allowing the message: %v", verdict.Check, verdict.Err)
+		}
+	}
+
+	outcome := audit.OutcomeSuccess
+	if result.Outcome == screening.OutcomeBlock {
+		outcome = audit.OutcomeDenied
+	}
+	if result.Outcome != screening.OutcomeAllow {
+		s.accessLogger.Printf("Screening of conversation %s: %s (%s)", conversationID, result.Outcome, result.Detail())
+	}
+	s.recordAudit(c, accountActor(account), auditActionScreen, resource, outcome,
+		fmt.Sprintf("conversation %s %s: %s", conversationID, result.Outcome, result.Detail()))
+	return result
+}
+
+// refuseChat streams the refusal to a blocked message, after the triage guidance if any, without asking the AI service
+func (s *Server) refuseChat(c *gin.Context, account *grpc.Account, guidance string) {
+	writer := newChatWriter(c)
+	writer.signed = s.replyKey != nil
+
+	reply := guidance + s.screening.Refusal()
+	if err := writer.WriteContent(reply); err != nil {
+		s.errorLogger.Printf("Failed to write refusal: %v", err)
+		return
+	}
+	writer.WriteDone(s.signReply(account, reply))
+}
+
+// withoutRefusedTurns leaves out the blocked user messages and their refusals, so they never reach the AI service
+func (s *Server) withoutRefusedTurns(messages []Message) []Message {
+	if s.screening == nil {
+		return messages
+	}
+
+	kept := make([]Message, 0, len(messages))
+	for i := 0; i < len(messages); i++ {
+		if i+1 < len(messages) && messages[i+1].Role == roleAssistant && strings.HasSuffix(messages[i+1].Content, s.screening.Refusal()) {
+			i++
+			continue
+		}
+		kept = append(kept, messages[i])
+	}
+	return kept
+}
@@ -2,38 +2,135 @@ package http
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/encryption"
 	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/safety"
+	"unb.br/web-server/src/screening"
+	"unb.br/web-server/src/triage"
 )
 
+var (
+	// conversationIDPattern restricts client-provided conversation IDs to safe identifiers
+	conversationIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+	// requestIDPattern restricts client-provided request IDs to safe identifiers
+	requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,128}$`)
+)
+
+// requestIDKey is the gin context key holding the request ID
+const requestIDKey = "request_id"
+
+// Config represents the HTTP server configuration
+type Config struct {
+	AiServerAddr string
+	DbServerAddr string
+	// DbServiceToken authenticates the web server to the database server's audit and usage RPCs
+	DbServiceToken string
+	// AllowedOrigins lists the web origins allowed to call the API and to open chat sessions, such as
+	// "https://app.example.com"; empty keeps the API open to any origin and chat sessions to the server's own
+	AllowedOrigins []string
+	// AiBackends lists the AI servers in failover order, replacing AiServerAddr when set
+	AiBackends []grpc.Backend
+	// AiFailoverWindow is how long a diagnosis can still move to the next AI backend after it started
+	AiFailoverWindow time.Duration
+	// AiMode records the AI service's answers to cassettes or replays them ("record", "replay"); empty or "live" calls the service
+	AiMode string
+	// AiCassetteDir holds the cassettes of the record and replay modes
+	AiCassetteDir string
+	// AiReplayRealTime keeps the recorded timing of the events when replaying, instead of sending them at once
+	AiReplayRealTime bool
+	// AuditSinks lists the audit sinks to write to ("file", "database"), the first one serves queries
+	AuditSinks []string
+	AuditFile  string
+	// AuditKey authenticates the head of the audit file, empty leaves it unauthenticated
+	AuditKey string
+	// EncryptionKeyfile enables field-level encryption of patient data when set
+	EncryptionKeyfile string
+	// TriageRules is the path of the red-flag triage rules, empty for the built-in ones
+	TriageRules string
+	// ScreeningRules is the path of the input screening denylist and refusal, empty for the built-in ones
+	ScreeningRules string
+	// ScreeningModeration adds the AI service's moderation to the input screening
+	ScreeningModeration bool
+	// OutputFilterRules is the path of the output filter rules and disclaimer, empty for the built-in ones
+	OutputFilterRules string
+	// DiagnosisCacheTTL enables replaying identical diagnoses of a user for this long, zero disables the cache
+	DiagnosisCacheTTL time.Duration
+	// DiagnosisCacheMaxBytes bounds the memory used by the diagnosis cache
+	DiagnosisCacheMaxBytes int
+	// ChatStreamRetention is how long a finished /api/chat stream can still be resumed
+	ChatStreamRetention time.Duration
+	// ContextTokenBudget caps the estimated tokens sent with each diagnosis, zero disables the cap
+	ContextTokenBudget int
+	// ChatMaxMessages, ChatMaxMessageBytes and ChatMaxPayloadBytes limit the size of chat requests
+	ChatMaxMessages     int
+	ChatMaxMessageBytes int
+	ChatMaxPayloadBytes int64
+	// ReplySigningKey signs streamed replies, so altered assistant messages are rejected; empty disables signing
+	ReplySigningKey string
+	// ModelAllowlist lists the models each role may choose, see ParseModelAllowlist; empty leaves the model to the AI service
+	ModelAllowlist map[string][]string
+	// MaxOutputTokens bounds the max_tokens a client may ask for
+	MaxOutputTokens int
+	// UsagePrices prices the tokens of each model, see ParseUsagePrices
+	UsagePrices map[string]ModelPrice
+	// MonthlyTokenQuota and MonthlyCostQuota (USD) limit the usage of each account per month, zero disables them
+	MonthlyTokenQuota int64
+	MonthlyCostQuota  float64
+	// QuotaFailOpen allows diagnoses when the usage to check the quotas against cannot be read,
+	// instead of refusing them with a 503
+	QuotaFailOpen bool
+}
+
 // Server represents the HTTP server
 type Server struct {
 	router       *gin.Engine
-	aiClient     *grpc.AiClient
-	dbClient     *grpc.DatabaseClient
-	aiServerAddr string
-	dbServerAddr string
-	accessLogger *log.Logger
-	errorLogger  *log.Logger
+	aiClient     AiService
+	dbClient     DatabaseService
+	config       Config
+	auditLogger  *audit.Logger
+	auditFile    *audit.FileSink
+	triage       *triage.Triage
+	screening    *screening.Pipeline
+	chatSessions *chatSessionRegistry
+	chatStreams  *chatStreamRegistry
+	chatUpgrader *websocket.Upgrader
+	// quotaReservations holds the estimates of the diagnoses in progress, see checkQuota
+	quotaReservations *quotaReservations
+	replyKey          []byte
+	accessLogger      *log.Logger
+	errorLogger       *log.Logger
 }
 
 // ChatRequest represents a chat request
 type ChatRequest struct {
-	Token    string    `json:"token" binding:"required"`
-	Messages []Message `json:"messages" binding:"required"`
+	Token          string `json:"token" binding:"required"`
+	ConversationID string `json:"conversation_id"`
+	// PatientID selects a managed patient profile; zero means the account's own profile
+	PatientID int32     `json:"patient_id"`
+	Messages  []Message `json:"messages" binding:"required"`
+	GenerationOptions
 }
 
 // Message represents a chat message
 type Message struct {
 	Role    string `json:"role" binding:"required"`
 	Content string `json:"content" binding:"required"`
+	// Signature is the one sent with an assistant reply, required when reply signing is enabled
+	Signature string `json:"signature,omitempty"`
 }
 
 // ChatResponse represents a chat response
@@ -60,11 +157,52 @@ type AuthResponse struct {
 
 // PatientInfo represents a patient info
 type PatientInfo struct {
-	Name   string  `json:"name"`
-	Age    int32   `json:"age"`
-	Gender string  `json:"gender"`
-	Weight float32 `json:"weight"`
-	Height float32 `json:"height"`
+	Name          string               `json:"name"`
+	Age           int32                `json:"age"`
+	Gender        string               `json:"gender"`
+	Weight        float32              `json:"weight"`
+	Height        float32              `json:"height"`
+	WeightUnit    string               `json:"weight_unit,omitempty"`
+	HeightUnit    string               `json:"height_unit,omitempty"`
+	Allergies     []Allergy            `json:"allergies"`
+	Medications   []Medication         `json:"medications"`
+	Conditions    []Condition          `json:"conditions"`
+	Surgeries     []Surgery            `json:"surgeries"`
+	FamilyHistory []FamilyHistoryEntry `json:"family_history"`
+}
+
+// Allergy represents a patient allergy
+type Allergy struct {
+	Substance string `json:"substance"`
+	Reaction  string `json:"reaction"`
+	Severity  string `json:"severity"`
+}
+
+// Medication represents a medication the patient currently takes
+type Medication struct {
+	Name      string `json:"name"`
+	Dosage    string `json:"dosage"`
+	Frequency string `json:"frequency"`
+}
+
+// Condition represents a chronic condition
+type Condition struct {
+	Name          string `json:"name"`
+	DiagnosedYear int32  `json:"diagnosed_year"`
+	Notes         string `json:"notes"`
+}
+
+// Surgery represents a past surgery
+type Surgery struct {
+	Procedure string `json:"procedure"`
+	Year      int32  `json:"year"`
+	Notes     string `json:"notes"`
+}
+
+// FamilyHistoryEntry represents a condition found in the patient's family
+type FamilyHistoryEntry struct {
+	Relative  string `json:"relative"`
+	Condition string `json:"condition"`
 }
 
 // PatientInfoRequest represents a patient info save request
@@ -75,16 +213,19 @@ type PatientInfoRequest struct {
 
 // PatientInfoResponse represents a patient info response
 type PatientInfoResponse struct {
-	Success bool `json:"success"`
+	Success bool  `json:"success"`
+	Version int64 `json:"version"`
 }
 
 // GetPatientResponse represents a get patient response
 type GetPatientResponse struct {
-	Patient PatientInfo `json:"patient"`
+	Patient PatientInfo     `json:"patient"`
+	Derived *DerivedMetrics `json:"derived,omitempty"`
+	Version int64           `json:"version"`
 }
 
 // NewServer creates a new HTTP server
-func NewServer(aiServerAddr, dbServerAddr string) *Server {
+func NewServer(config Config) *Server {
 	// Create loggers
 	accessLogger := log.New(os.Stdout, "[HTTP-ACCESS] ", log.LstdFlags)
 	errorLogger := log.New(os.Stderr, "[HTTP-ERROR] ", log.LstdFlags)
@@ -93,14 +234,39 @@ func NewServer(aiServerAddr, dbServerAddr string) *Server {
 	router := gin.New()
 	router.Use(gin.Recovery())
 
+	if config.ChatStreamRetention <= 0 {
+		config.ChatStreamRetention = defaultChatStreamRetention
+	}
+	if config.ChatMaxMessages <= 0 {
+		config.ChatMaxMessages = defaultChatMaxMessages
+	}
+	if config.ChatMaxMessageBytes <= 0 {
+		config.ChatMaxMessageBytes = defaultChatMaxMessageBytes
+	}
+	if config.ChatMaxPayloadBytes <= 0 {
+		config.ChatMaxPayloadBytes = defaultChatMaxPayloadBytes
+	}
+	if config.DiagnosisCacheMaxBytes <= 0 {
+		config.DiagnosisCacheMaxBytes = defaultDiagnosisCacheMaxBytes
+	}
+	if config.MaxOutputTokens <= 0 {
+		config.MaxOutputTokens = defaultMaxOutputTokens
+	}
+
 	// Create server
 	s := &Server{
-		router:       router,
-		aiServerAddr: aiServerAddr,
-		dbServerAddr: dbServerAddr,
-		accessLogger: accessLogger,
-		errorLogger:  errorLogger,
+		router:            router,
+		config:            config,
+		chatSessions:      newChatSessionRegistry(),
+		chatStreams:       newChatStreamRegistry(),
+		quotaReservations: newQuotaReservations(),
+		accessLogger:      accessLogger,
+		errorLogger:       errorLogger,
+	}
+	if config.ReplySigningKey != "" {
+		s.replyKey = []byte(config.ReplySigningKey)
 	}
+	s.chatUpgrader = s.newChatUpgrader()
 
 	// Setup routes
 	s.setupRoutes()
@@ -110,15 +276,20 @@ func NewServer(aiServerAddr, dbServerAddr string) *Server {
 
 // setupRoutes sets up the routes for the server
 func (s *Server) setupRoutes() {
-	// Add logging middleware
+	// Add request ID and logging middleware
+	s.router.Use(s.requestIDMiddleware())
 	s.router.Use(s.loggerMiddleware())
 
-	// Add CORS middleware
+	// Add CORS middleware, open to any origin unless allowed origins are configured
+	allowOrigins := []string{"*"}
+	if len(s.config.AllowedOrigins) > 0 {
+		allowOrigins = s.config.AllowedOrigins
+	}
 	s.router.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"*"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowOrigins:     allowOrigins,
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Authorization", "X-Request-ID", "If-Match"},
+		ExposeHeaders:    []string{"Content-Length", "X-Conversation-ID", "X-Request-ID", "ETag", "X-Triage-Severity", "X-Stream-ID", "X-Screening-Outcome", "X-Diagnosis-Model", "X-Diagnosis-Backend", "Retry-After"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -129,8 +300,44 @@ func (s *Server) setupRoutes() {
 		api.POST("/login", s.handleLogin)
 		api.POST("/register", s.handleRegister)
 		api.POST("/chat", s.handleChat)
+		api.GET("/chat/ws", s.handleChatWebSocket)
+		api.GET("/chat/streams/:id", s.handleResumeChatStream)
+		api.DELETE("/chat/streams/:id", s.handleStopChatStream)
 		api.GET("/patient", s.handleGetPatient)
 		api.POST("/patient", s.handleSavePatient)
+		api.PATCH("/patient", s.handlePatchPatient)
+		api.GET("/patients", s.handleListPatients)
+		api.POST("/patients", s.handleCreatePatient)
+		api.GET("/patients/:id", s.handleGetPatientProfile)
+		api.PUT("/patients/:id", s.handleUpdatePatientProfile)
+		api.DELETE("/patients/:id", s.handleDeletePatientProfile)
+		api.GET("/me/export", s.handleExportAccount)
+		api.GET("/me/usage", s.handleMyUsage)
+		api.DELETE("/me", s.handleDeleteAccount)
+		api.POST("/vitals", s.handleRecordVitals)
+		api.GET("/vitals", s.handleListVitals)
+		api.GET("/vitals/summary", s.handleVitalsSummary)
+	}
+
+	// FHIR R4 endpoints for exchanging patient data with EHR systems
+	fhirAPI := api.Group("/fhir")
+	{
+		fhirAPI.GET("/Patient/:id", s.handleFHIRReadPatient)
+		fhirAPI.PUT("/Patient/:id", s.handleFHIRUpdatePatient)
+		fhirAPI.GET("/Patient/:id/$everything", s.handleFHIREverything)
+		fhirAPI.GET("/Observation", s.handleFHIRSearchObservations)
+		fhirAPI.POST("/Observation", s.handleFHIRCreateObservation)
+		fhirAPI.GET("/AllergyIntolerance", s.handleFHIRSearchAllergies)
+		fhirAPI.POST("/AllergyIntolerance", s.handleFHIRCreateAllergy)
+	}
+
+	// Admin routes
+	admin := api.Group("/admin")
+	{
+		admin.GET("/audit", s.handleQueryAudit)
+		admin.GET("/audit/verify", s.handleVerifyAudit)
+		admin.GET("/usage", s.handleAdminUsage)
+		admin.GET("/ai-backends", s.handleAdminBackends)
 	}
 }
 
@@ -138,23 +345,71 @@ func (s *Server) setupRoutes() {
 func (s *Server) initClients() error {
 	// Initialize AI client if not already initialized
 	if s.aiClient == nil {
-		client, err := grpc.NewAiClient(s.aiServerAddr)
+		backends := s.config.AiBackends
+		if len(backends) == 0 {
+			backends = []grpc.Backend{{Name: grpc.BackendPrimary, Role: grpc.BackendPrimary, Addr: s.config.AiServerAddr}}
+		}
+		// Record or replay the AI service's answers, if enabled
+		cassettes, err := grpc.NewCassettes(s.config.AiMode, s.config.AiCassetteDir, s.config.AiReplayRealTime)
+		if err != nil {
+			return fmt.Errorf("failed to open AI cassettes: %v", err)
+		}
+		client, err := grpc.NewFailoverAiClient(backends, s.config.AiFailoverWindow, cassettes.DialOptions()...)
 		if err != nil {
 			return fmt.Errorf("failed to create AI client: %v", err)
 		}
+
+		// Check the streamed diagnoses for dosages and definitive statements, and add the disclaimer footer
+		filter, err := safety.Load(s.config.OutputFilterRules)
+		if err != nil {
+			client.Close()
+			return fmt.Errorf("failed to load output filter rules: %v", err)
+		}
+		client.SetOutputFilter(filter)
+
+		// Replay identical diagnoses of a user, if enabled
+		if s.config.DiagnosisCacheTTL > 0 {
+			client.SetDiagnosisCache(grpc.NewDiagnosisCache(s.config.DiagnosisCacheTTL, s.config.DiagnosisCacheMaxBytes))
+			s.accessLogger.Printf("Diagnosis cache enabled for %s", s.config.DiagnosisCacheTTL)
+		}
+
 		s.aiClient = client
 	}
 
 	// Initialize DB client if not already initialized
 	if s.dbClient == nil {
-		client, err := grpc.NewDatabaseClient(s.dbServerAddr)
+		client, err := grpc.NewDatabaseClient(s.config.DbServerAddr)
 		if err != nil {
 			return fmt.Errorf("failed to create Database client: %v", err)
 		}
+		client.SetServiceToken(s.config.DbServiceToken)
+
+		// Encrypt sensitive fields before they reach the database, if a keyfile is configured
+		if s.config.EncryptionKeyfile != "" {
+			keyring, err := encryption.LoadKeyring(s.config.EncryptionKeyfile)
+			if err != nil {
+				client.Close()
+				return fmt.Errorf("failed to load encryption keyfile: %v", err)
+			}
+			client.SetFieldCipher(keyring)
+			s.accessLogger.Printf("Field encryption enabled with key %s", keyring.ActiveKeyID())
+		}
+
 		s.dbClient = client
 	}
 
-	return nil
+	// Load the red-flag triage rules
+	if err := s.initTriage(); err != nil {
+		return fmt.Errorf("failed to load triage rules: %v", err)
+	}
+
+	// Load the input screening rules
+	if err := s.initScreening(); err != nil {
+		return fmt.Errorf("failed to load screening rules: %v", err)
+	}
+
+	// Initialize the audit logger, which may depend on the DB client
+	return s.initAudit()
 }
 
 // Run starts the HTTP server
@@ -170,12 +425,31 @@ func (s *Server) Run(addr string) error {
 
 // Close closes the server and its clients
 func (s *Server) Close() {
+	s.chatSessions.closeAll()
 	if s.aiClient != nil {
 		s.aiClient.Close()
 	}
 	if s.dbClient != nil {
 		s.dbClient.Close()
 	}
+	if s.auditLogger != nil {
+		s.auditLogger.Close()
+	}
+}
+
+// requestIDMiddleware returns a gin.HandlerFunc that tags each request with an ID.
+// A well-formed X-Request-ID from the client is kept so requests can be traced across services.
+func (s *Server) requestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader("X-Request-ID")
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = newRandomID()
+		}
+
+		c.Set(requestIDKey, requestID)
+		c.Header("X-Request-ID", requestID)
+		c.Next()
+	}
 }
 
 // loggerMiddleware returns a gin.HandlerFunc for logging requests
@@ -193,10 +467,11 @@ func (s *Server) loggerMiddleware() gin.HandlerFunc {
 		method := c.Request.Method
 		statusCode := c.Writer.Status()
 
-		s.accessLogger.Printf("%3d | %13v | %15s | %s %s",
+		s.accessLogger.Printf("%3d | %13v | %15s | %s | %s %s",
 			statusCode,
 			latency,
 			clientIP,
+			c.GetString(requestIDKey),
 			method,
 			path,
 		)
@@ -224,13 +499,17 @@ func (s *Server) handleLogin(c *gin.Context) {
 		Password: req.Password,
 	}
 
+	actor := "username:" + req.Username
 	loginOutput, err := s.dbClient.Login(ctx, loginInput)
 	if err != nil {
 		s.errorLogger.Printf("Login failed: %v", err)
+		s.recordAudit(c, actor, auditActionLogin, "account", audit.OutcomeFailure, "invalid credentials")
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
 		return
 	}
 
+	s.recordAudit(c, actor, auditActionLogin, "account", audit.OutcomeSuccess, "")
+
 	c.JSON(http.StatusOK, AuthResponse{
 		Token: loginOutput.Token,
 	})
@@ -257,13 +536,17 @@ func (s *Server) handleRegister(c *gin.Context) {
 		Password: req.Password,
 	}
 
+	actor := "username:" + req.Username
 	registerOutput, err := s.dbClient.Register(ctx, registerInput)
 	if err != nil {
 		s.errorLogger.Printf("Registration failed: %v", err)
+		s.recordAudit(c, actor, auditActionRegister, "account", audit.OutcomeFailure, "registration failed")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Registration failed"})
 		return
 	}
 
+	s.recordAudit(c, actor, auditActionRegister, "account", audit.OutcomeSuccess, "")
+
 	c.JSON(http.StatusOK, AuthResponse{
 		Token: registerOutput.Token,
 	})
@@ -272,9 +555,21 @@ func (s *Server) handleRegister(c *gin.Context) {
 // handleChat handles chat requests
 func (s *Server) handleChat(c *gin.Context) {
 	var req ChatRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		s.errorLogger.Printf("Invalid chat request: %v", err)
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+	if !s.bindChatRequest(c, &req) {
+		return
+	}
+
+	if req.ConversationID != "" && !conversationIDPattern.MatchString(req.ConversationID) {
+		s.errorLogger.Printf("Invalid conversation ID: %q", req.ConversationID)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid conversation ID"})
+		return
+	}
+	if errs := s.validateGeneration(req.GenerationOptions); len(errs) > 0 {
+		s.errorLogger.Printf("Invalid generation options: %v", errs)
+		c.JSON(http.StatusBadRequest, ValidationErrorResponse{
+			Error:  "Invalid generation options",
+			Fields: errs,
+		})
 		return
 	}
 
@@ -289,49 +584,186 @@ func (s *Server) handleChat(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
 	defer cancel()
 
-	getPatientInput := grpc.GetPatientInput{
-		Token: req.Token,
+	account, patientInfo, resource, ok := s.chatPatient(ctx, c, req.Token, req.PatientID)
+	if !ok {
+		return
 	}
 
-	getPatientOutput, err := s.dbClient.GetPatient(ctx, getPatientInput)
-	if err != nil {
-		s.errorLogger.Printf("Failed to get patient info: %v", err)
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+	// Assistant messages must be the replies as they were streamed
+	if errs := s.verifyReplies(account, req.Messages); len(errs) > 0 {
+		s.errorLogger.Printf("Chat request with altered assistant messages: %v", errs)
+		s.recordAudit(c, accountActor(account), auditActionDiagnosis, resource, audit.OutcomeDenied, "altered assistant messages")
+		c.JSON(http.StatusBadRequest, ValidationErrorResponse{
+			Error:  "Invalid messages",
+			Fields: errs,
+		})
 		return
 	}
 
-	// Convert HTTP messages to gRPC messages
-	grpcMessages := make([]grpc.Message, len(req.Messages))
-	for i, msg := range req.Messages {
+	// The model must be one the account's role may use
+	model, ok := s.allowedModel(account, req.Model)
+	if !ok {
+		s.errorLogger.Printf("Model %q not allowed for %s", req.Model, accountActor(account))
+		s.recordAudit(c, accountActor(account), auditActionDiagnosis, resource, audit.OutcomeDenied, fmt.Sprintf("model %s not allowed", req.Model))
+		c.JSON(http.StatusForbidden, gin.H{"error": "Model not allowed"})
+		return
+	}
+
+	// Convert HTTP messages to gRPC messages, leaving out the turns refused by screening
+	messages := s.withoutRefusedTurns(req.Messages)
+	grpcMessages := make([]grpc.Message, len(messages))
+	for i, msg := range messages {
 		grpcMessages[i] = grpc.Message{
 			Role:    msg.Role,
 			Content: msg.Content,
 		}
 	}
 
+	// Add the recent vitals and their trends as context
+	recentVitals, vitalTrends := s.diagnosisVitalsContext(ctx, req.Token, req.PatientID)
+
 	// Now diagnose using the AI service with streaming
-	patientInfo := grpc.PatientInfo{
-		Name:   getPatientOutput.PatientInfo.Name,
-		Age:    getPatientOutput.PatientInfo.Age,
-		Gender: getPatientOutput.PatientInfo.Gender,
-		Weight: getPatientOutput.PatientInfo.Weight,
-		Height: getPatientOutput.PatientInfo.Height,
+	diagnosisInput := grpc.DiagnoseInput{
+		PatientInfo:  patientInfo,
+		Messages:     grpcMessages,
+		RecentVitals: recentVitals,
+		VitalTrends:  vitalTrends,
+		// Cached diagnoses are only replayed to the same account and patient
+		CacheScope: diagnosisCacheScope(account, resource),
 	}
+	applyGeneration(&diagnosisInput, req.GenerationOptions, model)
 
-	diagnosisInput := grpc.DiagnoseInput{
-		PatientInfo: patientInfo,
-		Messages:    grpcMessages,
+	// Tell the client which conversation this turn belongs to before streaming starts
+	conversationID := req.ConversationID
+	if conversationID == "" {
+		conversationID = newRandomID()
+	}
+	c.Header("X-Conversation-ID", conversationID)
+
+	// Red-flag symptoms get urgent-care guidance first, and emergencies skip the AI service entirely
+	triageResult := s.checkTriage(req, patientInfo)
+	if triageResult != nil {
+		s.accessLogger.Printf("Triage matched rules %v with severity %s", triageResult.Rules, triageResult.Severity)
+		c.Header("X-Triage-Severity", triageResult.Severity)
 	}
 
-	// Stream the response directly to the client
-	if err := s.aiClient.StreamDiagnose(ctx, c, diagnosisInput); err != nil {
-		s.errorLogger.Printf("Diagnosis streaming failed: %v", err)
-		// If headers haven't been sent yet, return an error response
-		if !c.Writer.Written() {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Diagnosis failed"})
+	// The estimate of an allowed diagnosis stays reserved against the quotas until its usage is recorded
+	var reservation *quotaReservation
+	if triageResult == nil || triageResult.Action != triage.ActionReplace {
+		// Accounts that used up their monthly quota wait for the next month, only triage still answers them
+		allowed, status, message, resets := s.checkQuota(ctx, c, account, resource, s.estimateDiagnosis(diagnosisInput, model))
+		if status != 0 {
+			if status == http.StatusTooManyRequests {
+				c.Header("Retry-After", strconv.Itoa(int(time.Until(resets).Seconds())+1))
+			}
+			c.JSON(status, gin.H{"error": message, "resets_at": resets})
+			return
 		}
-		return
+		reservation = allowed
+
+		// Messages meant for the AI service are screened first, and blocked ones get a polite refusal instead
+		screenResult := s.screenMessage(ctx, c, account, resource, conversationID, latestMessage)
+		if screenResult.Outcome != screening.OutcomeAllow {
+			c.Header("X-Screening-Outcome", screenResult.Outcome)
+		}
+		if screenResult.Outcome == screening.OutcomeBlock {
+			guidance := ""
+			if triageResult != nil {
+				guidance = triageResult.Guidance
+				s.flagConversation(ctx, c, account, resource, req, conversationID, triageResult)
+			}
+			reservation.release()
+			s.refuseChat(c, account, guidance)
+			return
+		}
+	}
+
+	// Older turns over the token budget are replaced by a summary
+	s.fitConversation(ctx, account, req.Token, conversationID, &diagnosisInput)
+
+	// Register the stream so the client can stop it with DELETE /api/chat/streams/:id
+	// and resume it with GET /api/chat/streams/:id after a network drop
+	stream := s.chatStreams.start(account, resource, conversationID)
+	c.Header("X-Stream-ID", stream.id)
+
+	// The diagnosis runs on its own, so it completes and is saved even if the client goes away
+	go s.produceChatStream(c.Copy(), stream, req, diagnosisInput, triageResult, reservation)
+
+	s.followChatStream(c, stream, 0)
+}
+
+// diagnosisCacheScope ties cached diagnoses to an account and one of its patients; an unknown account is not cached
+func diagnosisCacheScope(account *grpc.Account, resource string) string {
+	if account == nil {
+		return ""
+	}
+	return fmt.Sprintf("user:%d/%s", account.ID, resource)
+}
+
+// chatPatient loads the patient a chat is about, the account's own profile or a managed one,
+// writing the error response when the token cannot use it
+func (s *Server) chatPatient(ctx context.Context, c *gin.Context, token string, patientID int32) (*grpc.Account, grpc.PatientInfo, string, bool) {
+	if patientID != 0 {
+		// Managed profiles are only available to the account that owns them
+		account, profile, ok := s.authorizePatient(c, token, patientID, auditActionDiagnosis)
+		if !ok {
+			return nil, grpc.PatientInfo{}, "", false
+		}
+		return account, profile.PatientInfo, profileResource(profile.ID), true
+	}
+
+	getPatientInput := grpc.GetPatientInput{
+		Token: token,
+	}
+
+	account := s.lookupAccount(c, token)
+	getPatientOutput, err := s.dbClient.GetPatient(ctx, getPatientInput)
+	if err != nil {
+		s.errorLogger.Printf("Failed to get patient info: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionDiagnosis, patientResource(account), audit.OutcomeFailure, "patient lookup failed")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return nil, grpc.PatientInfo{}, "", false
 	}
+	return account, getPatientOutput.PatientInfo, patientResource(account), true
+}
+
+// saveConversationTurn appends the latest user message and the assistant reply to the conversation history.
+// The reply only needs its content, summary and truncated flag. Failures are only logged, since the reply has already been streamed to the client.
+func (s *Server) saveConversationTurn(ctx context.Context, req ChatRequest, conversationID string, reply grpc.ChatMessage) {
+	now := time.Now()
+	messages := make([]grpc.ChatMessage, 0, 2)
+	if len(req.Messages) > 0 {
+		latest := req.Messages[len(req.Messages)-1]
+		messages = append(messages, grpc.ChatMessage{
+			Role:      latest.Role,
+			Content:   latest.Content,
+			CreatedAt: now,
+		})
+	}
+	reply.Role = "assistant"
+	reply.CreatedAt = now
+	messages = append(messages, reply)
+
+	saveConversationInput := grpc.SaveConversationInput{
+		Token:          req.Token,
+		ConversationID: conversationID,
+		Messages:       messages,
+		PatientID:      req.PatientID,
+	}
+
+	if _, err := s.dbClient.SaveConversation(ctx, saveConversationInput); err != nil {
+		s.errorLogger.Printf("Failed to save conversation %s: %v", conversationID, err)
+	}
+}
+
+// newRandomID generates a random identifier for conversations and requests
+func newRandomID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand never fails on supported platforms, fall back to the clock just in case
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
 }
 
 // handleGetPatient handles get patient requests
@@ -354,21 +786,23 @@ func (s *Server) handleGetPatient(c *gin.Context) {
 		Token: token,
 	}
 
+	account := s.lookupAccount(c, token)
 	getPatientOutput, err := s.dbClient.GetPatient(ctx, getPatientInput)
 	if err != nil {
 		s.errorLogger.Printf("Failed to get patient: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionPatientRead, patientResource(account), audit.OutcomeFailure, "")
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
 		return
 	}
 
+	s.recordAudit(c, accountActor(account), auditActionPatientRead, patientResource(account), audit.OutcomeSuccess, "")
+
+	patient := patientInfoFromGrpc(getPatientOutput.PatientInfo)
+	c.Header("ETag", formatETag(getPatientOutput.Version))
 	c.JSON(http.StatusOK, GetPatientResponse{
-		Patient: PatientInfo{
-			Name:   getPatientOutput.PatientInfo.Name,
-			Age:    getPatientOutput.PatientInfo.Age,
-			Gender: getPatientOutput.PatientInfo.Gender,
-			Weight: getPatientOutput.PatientInfo.Weight,
-			Height: getPatientOutput.PatientInfo.Height,
-		},
+		Patient: patient,
+		Derived: derivePatientMetrics(patient),
+		Version: getPatientOutput.Version,
 	})
 }
 
@@ -381,32 +815,128 @@ func (s *Server) handleSavePatient(c *gin.Context) {
 		return
 	}
 
+	// Convert to canonical units and reject out-of-range values
+	if fieldErrs := normalizePatientInfo(&req.Patient, "patient."); len(fieldErrs) > 0 {
+		s.errorLogger.Printf("Invalid patient info: %d field errors", len(fieldErrs))
+		c.JSON(http.StatusBadRequest, ValidationErrorResponse{
+			Error:  "Validation failed",
+			Fields: fieldErrs,
+		})
+		return
+	}
+
+	account := s.lookupAccount(c, req.Token)
+
+	// A full replacement only checks the version when the client sends If-Match
+	var expectedVersion int64
+	if ifMatch := c.GetHeader("If-Match"); ifMatch != "" && ifMatch != "*" {
+		// Create context with timeout
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		getPatientOutput, err := s.dbClient.GetPatient(ctx, grpc.GetPatientInput{Token: req.Token})
+		if err != nil {
+			s.errorLogger.Printf("Failed to get patient for If-Match: %v", err)
+			s.recordAudit(c, accountActor(account), auditActionPatientUpdate, patientResource(account), audit.OutcomeFailure, "replace")
+			if grpc.IsNotFound(err) {
+				c.JSON(http.StatusPreconditionFailed, gin.H{"error": "Patient does not exist yet"})
+				return
+			}
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			return
+		}
+
+		var ok bool
+		if expectedVersion, ok = s.checkIfMatch(c, getPatientOutput.Version); !ok {
+			return
+		}
+	}
+
 	s.accessLogger.Printf("Save patient request for: %s", req.Patient.Name)
 
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-	defer cancel()
+	s.savePatient(c, req.Token, account, req.Patient, expectedVersion, "replace")
+}
 
-	// Call the gRPC service
-	savePatientInput := grpc.SavePatientInfoInput{
-		Token: req.Token,
-		PatientInfo: grpc.PatientInfo{
-			Name:   req.Patient.Name,
-			Age:    req.Patient.Age,
-			Gender: req.Patient.Gender,
-			Weight: req.Patient.Weight,
-			Height: req.Patient.Height,
-		},
-	}
-
-	savePatientOutput, err := s.dbClient.SavePatientInfo(ctx, savePatientInput)
-	if err != nil {
-		s.errorLogger.Printf("Failed to save patient: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save patient"})
-		return
+// patientInfoToGrpc converts a patient info to the gRPC client format
+func patientInfoToGrpc(info PatientInfo) grpc.PatientInfo {
+	allergies := make([]grpc.Allergy, len(info.Allergies))
+	for i, allergy := range info.Allergies {
+		allergies[i] = grpc.Allergy(allergy)
 	}
 
-	c.JSON(http.StatusOK, PatientInfoResponse{
-		Success: savePatientOutput.Success,
-	})
+	medications := make([]grpc.Medication, len(info.Medications))
+	for i, medication := range info.Medications {
+		medications[i] = grpc.Medication(medication)
+	}
+
+	conditions := make([]grpc.Condition, len(info.Conditions))
+	for i, condition := range info.Conditions {
+		conditions[i] = grpc.Condition(condition)
+	}
+
+	surgeries := make([]grpc.Surgery, len(info.Surgeries))
+	for i, surgery := range info.Surgeries {
+		surgeries[i] = grpc.Surgery(surgery)
+	}
+
+	familyHistory := make([]grpc.FamilyHistoryEntry, len(info.FamilyHistory))
+	for i, entry := range info.FamilyHistory {
+		familyHistory[i] = grpc.FamilyHistoryEntry(entry)
+	}
+
+	return grpc.PatientInfo{
+		Name:          info.Name,
+		Age:           info.Age,
+		Gender:        info.Gender,
+		Weight:        info.Weight,
+		Height:        info.Height,
+		Allergies:     allergies,
+		Medications:   medications,
+		Conditions:    conditions,
+		Surgeries:     surgeries,
+		FamilyHistory: familyHistory,
+	}
+}
+
+// patientInfoFromGrpc converts a patient info from the gRPC client format
+func patientInfoFromGrpc(info grpc.PatientInfo) PatientInfo {
+	allergies := make([]Allergy, len(info.Allergies))
+	for i, allergy := range info.Allergies {
+		allergies[i] = Allergy(allergy)
+	}
+
+	medications := make([]Medication, len(info.Medications))
+	for i, medication := range info.Medications {
+		medications[i] = Medication(medication)
+	}
+
+	conditions := make([]Condition, len(info.Conditions))
+	for i, condition := range info.Conditions {
+		conditions[i] = Condition(condition)
+	}
+
+	surgeries := make([]Surgery, len(info.Surgeries))
+	for i, surgery := range info.Surgeries {
+		surgeries[i] = Surgery(surgery)
+	}
+
+	familyHistory := make([]FamilyHistoryEntry, len(info.FamilyHistory))
+	for i, entry := range info.FamilyHistory {
+		familyHistory[i] = FamilyHistoryEntry(entry)
+	}
+
+	return PatientInfo{
+		Name:          info.Name,
+		Age:           info.Age,
+		Gender:        info.Gender,
+		Weight:        info.Weight,
+		Height:        info.Height,
+		WeightUnit:    unitKilogram,
+		HeightUnit:    unitCentimeter,
+		Allergies:     allergies,
+		Medications:   medications,
+		Conditions:    conditions,
+		Surgeries:     surgeries,
+		FamilyHistory: familyHistory,
+	}
 }
@@ -0,0 +1,206 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/mockai"
+	"unb.br/web-server/src/mockdb"
+)
+
+// testServer is a server wired to the in-memory database and the mock AI service
+type testServer struct {
+	*Server
+	db         *mockdb.Server
+	ai         *mockai.Server
+	aiListener *mockai.Listener
+	url        string
+}
+
+// newTestServer starts a server over the in-memory services, stopped when the test ends
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	db := mockdb.NewServer()
+	dbListener := mockdb.Serve(db)
+	t.Cleanup(dbListener.Close)
+	ai := mockai.NewServer(mockai.DefaultScript())
+	aiListener := mockai.Serve(ai)
+	t.Cleanup(aiListener.Close)
+
+	s := NewServer(Config{
+		AuditSinks: []string{"file"},
+		AuditFile:  filepath.Join(t.TempDir(), "audit.log"),
+	})
+	s.accessLogger.SetOutput(io.Discard)
+	s.errorLogger.SetOutput(io.Discard)
+
+	var err error
+	if s.dbClient, err = grpc.NewDatabaseClient(dbListener.Addr(), dbListener.DialOption()); err != nil {
+		t.Fatalf("failed to create database client: %v", err)
+	}
+	if s.aiClient, err = grpc.NewAiClient(aiListener.Addr(), aiListener.DialOption()); err != nil {
+		t.Fatalf("failed to create AI client: %v", err)
+	}
+	if err := s.initClients(); err != nil {
+		t.Fatalf("failed to initialize the server: %v", err)
+	}
+	t.Cleanup(s.Close)
+
+	httpServer := httptest.NewServer(s.router)
+	t.Cleanup(httpServer.Close)
+
+	return &testServer{Server: s, db: db, ai: ai, aiListener: aiListener, url: httpServer.URL}
+}
+
+// do sends a request with a JSON body, unless body is nil, and returns the response with its body read.
+// Headers are given as name and value pairs.
+func (ts *testServer) do(t *testing.T, method, path string, body any, headers ...string) (*http.Response, []byte) {
+	t.Helper()
+
+	var reader io.Reader
+	switch body := body.(type) {
+	case nil:
+	case string:
+		reader = bytes.NewBufferString(body)
+	default:
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("failed to encode request: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, ts.url+path, reader)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	if reader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read response of %s %s: %v", method, path, err)
+	}
+	return resp, data
+}
+
+// register creates an account through the API and returns its token
+func (ts *testServer) register(t *testing.T, username string) string {
+	t.Helper()
+
+	resp, body := ts.do(t, http.MethodPost, "/api/register", RegisterRequest{Username: username, Password: "secret"})
+	expectStatus(t, resp, body, http.StatusOK)
+	var auth AuthResponse
+	decode(t, body, &auth)
+	if auth.Token == "" {
+		t.Fatalf("register returned no token")
+	}
+	return auth.Token
+}
+
+// expectStatus fails the test when the response does not have the status
+func expectStatus(t *testing.T, resp *http.Response, body []byte, status int) {
+	t.Helper()
+	if resp.StatusCode != status {
+		t.Fatalf("%s %s: got status %d, want %d: %s", resp.Request.Method, resp.Request.URL.Path, resp.StatusCode, status, body)
+	}
+}
+
+// expectError fails the test when the response does not have the status and error message
+func expectError(t *testing.T, resp *http.Response, body []byte, status int, message string) {
+	t.Helper()
+	expectStatus(t, resp, body, status)
+	var errResp struct {
+		Error string `json:"error"`
+	}
+	decode(t, body, &errResp)
+	if errResp.Error != message {
+		t.Fatalf("%s %s: got error %q, want %q", resp.Request.Method, resp.Request.URL.Path, errResp.Error, message)
+	}
+}
+
+// decode decodes a JSON response body
+func decode(t *testing.T, body []byte, v any) {
+	t.Helper()
+	if err := json.Unmarshal(body, v); err != nil {
+		t.Fatalf("failed to decode %s: %v", body, err)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	ts := newTestServer(t)
+	registered := ts.register(t, "alice")
+
+	resp, body := ts.do(t, http.MethodPost, "/api/login", LoginRequest{Username: "alice", Password: "secret"})
+	expectStatus(t, resp, body, http.StatusOK)
+	var auth AuthResponse
+	decode(t, body, &auth)
+	if auth.Token == "" || auth.Token == registered {
+		t.Fatalf("login returned token %q, want a new one", auth.Token)
+	}
+
+	// The new token works like the one from the registration
+	resp, body = ts.do(t, http.MethodGet, "/api/patients?token="+auth.Token, nil)
+	expectStatus(t, resp, body, http.StatusOK)
+
+	tests := []struct {
+		name    string
+		body    any
+		status  int
+		message string
+	}{
+		{"wrong password", LoginRequest{Username: "alice", Password: "wrong"}, http.StatusUnauthorized, "Invalid credentials"},
+		{"unknown user", LoginRequest{Username: "bob", Password: "secret"}, http.StatusUnauthorized, "Invalid credentials"},
+		{"missing password", gin.H{"username": "alice"}, http.StatusBadRequest, "Invalid request"},
+		{"malformed JSON", `{"username": "alice",`, http.StatusBadRequest, "Invalid request"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := ts.do(t, http.MethodPost, "/api/login", tt.body)
+			expectError(t, resp, body, tt.status, tt.message)
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := ts.register(t, "alice")
+	resp, body := ts.do(t, http.MethodGet, "/api/patients?token="+token, nil)
+	expectStatus(t, resp, body, http.StatusOK)
+
+	tests := []struct {
+		name    string
+		body    any
+		status  int
+		message string
+	}{
+		{"taken username", RegisterRequest{Username: "alice", Password: "other"}, http.StatusInternalServerError, "Registration failed"},
+		{"missing username", gin.H{"password": "secret"}, http.StatusBadRequest, "Invalid request"},
+		{"malformed JSON", `[]`, http.StatusBadRequest, "Invalid request"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := ts.do(t, http.MethodPost, "/api/register", tt.body)
+			expectError(t, resp, body, tt.status, tt.message)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/grpc"
+)
+
+// DiagnosisSummary represents the structured part of a diagnosis
+type DiagnosisSummary struct {
+	Differentials []Differential `json:"differentials"`
+	TriageLevel   string         `json:"triage_level"`
+	Specialties   []string       `json:"specialties"`
+	RedFlags      []string       `json:"red_flags"`
+	Disclaimers   []string       `json:"disclaimers"`
+	NextSteps     []string       `json:"next_steps"`
+}
+
+// DiagnosisMetadata represents how a diagnosis is generated; the model is left out when the AI service does not report it
+type DiagnosisMetadata struct {
+	Model   string `json:"model,omitempty"`
+	Backend string `json:"backend"`
+}
+
+// Differential represents a candidate condition with its likelihood between 0 and 1
+type Differential struct {
+	Condition  string  `json:"condition"`
+	Likelihood float32 `json:"likelihood"`
+	Rationale  string  `json:"rationale"`
+}
+
+// chatDone is the data of the event ending a reply
+type chatDone struct {
+	Signature string `json:"signature,omitempty"`
+}
+
+// chatWriter streams a chat reply to the client.
+// Clients accepting text/event-stream get "metadata", "delta", "summary", "stopped", "done" and "error" events with JSON data
+// and the sequence number as event ID, the others get the markdown as plain text, without the summary.
+type chatWriter struct {
+	c       *gin.Context
+	events  bool
+	started bool
+	// signed tells whether the reply ends with a signature, sent to plain text clients as a trailer
+	signed bool
+	// id is the sequence number sent with the next events
+	id int64
+}
+
+// newChatWriter creates a chat writer, choosing the format from the Accept header
+func newChatWriter(c *gin.Context) *chatWriter {
+	return &chatWriter{
+		c:      c,
+		events: strings.Contains(c.GetHeader("Accept"), "text/event-stream"),
+	}
+}
+
+// WriteMetadata streams the model and the backend answering the diagnosis,
+// sent to plain text clients as headers when still possible
+func (w *chatWriter) WriteMetadata(model, backend string) error {
+	if w.events {
+		w.start()
+		return w.event("metadata", DiagnosisMetadata{Model: model, Backend: backend})
+	}
+	if w.started {
+		return nil
+	}
+	if model != "" {
+		w.c.Header(diagnosisModelHeader, model)
+	}
+	if backend != "" {
+		w.c.Header(diagnosisBackendHeader, backend)
+	}
+	return nil
+}
+
+// WriteContent streams a markdown delta
+func (w *chatWriter) WriteContent(content string) error {
+	w.start()
+	if w.events {
+		return w.event("delta", gin.H{"content": content})
+	}
+
+	if _, err := w.c.Writer.WriteString(content); err != nil {
+		return err
+	}
+	// Flush to ensure the client receives data immediately
+	w.c.Writer.Flush()
+	return nil
+}
+
+// WriteSummary streams the structured diagnosis as the final event
+func (w *chatWriter) WriteSummary(summary DiagnosisSummary) error {
+	if !w.events {
+		return nil
+	}
+	w.start()
+	return w.event("summary", summary)
+}
+
+// WriteStopped ends a reply that was stopped on request, telling event stream clients that it is truncated
+func (w *chatWriter) WriteStopped() error {
+	w.start()
+	if !w.events {
+		return nil
+	}
+	return w.event("stopped", gin.H{"truncated": true})
+}
+
+// WriteDone ends a reply, with its signature when replies are signed.
+// The client sends the signature back with the reply in later requests.
+func (w *chatWriter) WriteDone(signature string) error {
+	w.start()
+	if w.events {
+		return w.event("done", chatDone{Signature: signature})
+	}
+	if signature != "" {
+		w.c.Writer.Header().Set(replySignatureTrailer, signature)
+	}
+	return nil
+}
+
+// WriteError reports a failure after the stream has started, which plain text clients can only see as a cut reply
+func (w *chatWriter) WriteError(message string) {
+	if w.events && w.started {
+		w.event("error", gin.H{"error": message})
+	}
+}
+
+// start sends the headers before the first write
+func (w *chatWriter) start() {
+	if w.started {
+		return
+	}
+	w.started = true
+
+	if w.events {
+		w.c.Header("Content-Type", "text/event-stream")
+		w.c.Header("Cache-Control", "no-cache")
+	} else {
+		w.c.Header("Content-Type", "text/plain")
+		if w.signed {
+			w.c.Header("Trailer", replySignatureTrailer)
+		}
+	}
+	w.c.Header("X-Content-Type-Options", "nosniff")
+	w.c.Status(http.StatusOK)
+}
+
+// event writes a server-sent event
+func (w *chatWriter) event(name string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if w.id > 0 {
+		if _, err := fmt.Fprintf(w.c.Writer, "id: %d\n", w.id); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w.c.Writer, "event: %s\ndata: %s\n\n", name, payload); err != nil {
+		return err
+	}
+	w.c.Writer.Flush()
+	return nil
+}
+
+// newDiagnosisSummary converts a structured diagnosis from the gRPC format
+func newDiagnosisSummary(summary grpc.DiagnosisSummary) DiagnosisSummary {
+	differentials := make([]Differential, len(summary.Differentials))
+	for i, differential := range summary.Differentials {
+		differentials[i] = Differential{
+			Condition:  differential.Condition,
+			Likelihood: differential.Likelihood,
+			Rationale:  differential.Rationale,
+		}
+	}
+
+	return DiagnosisSummary{
+		Differentials: differentials,
+		TriageLevel:   summary.TriageLevel,
+		Specialties:   nonNil(summary.Specialties),
+		RedFlags:      nonNil(summary.RedFlags),
+		Disclaimers:   nonNil(summary.Disclaimers),
+		NextSteps:     nonNil(summary.NextSteps),
+	}
+}
+
+// nonNil keeps empty lists as [] rather than null in JSON
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,95 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/grpc"
+)
+
+// testChatWriter is a chat writer over a recorder, streaming events when asked to
+func testChatWriter(events bool) (*chatWriter, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/chat", nil)
+	if events {
+		c.Request.Header.Set("Accept", "text/event-stream")
+	}
+	return newChatWriter(c), recorder
+}
+
+func TestNewDiagnosisSummary(t *testing.T) {
+	summary := newDiagnosisSummary(grpc.DiagnosisSummary{
+		Differentials: []grpc.Differential{{Condition: "Gripe", Likelihood: 0.7, Rationale: "febre e dor no corpo"}},
+		TriageLevel:   "routine",
+		Specialties:   []string{"clínica geral"},
+	})
+
+	data, err := json.Marshal(summary)
+	if err != nil {
+		t.Fatalf("failed to encode summary: %v", err)
+	}
+	want := `{"differentials":[{"condition":"Gripe","likelihood":0.7,"rationale":"febre e dor no corpo"}],"triage_level":"routine",` +
+		`"specialties":["clínica geral"],"red_flags":[],"disclaimers":[],"next_steps":[]}`
+	if string(data) != want {
+		t.Fatalf("summary = %s, want %s", data, want)
+	}
+}
+
+func TestWriteSummary(t *testing.T) {
+	summary := DiagnosisSummary{TriageLevel: "urgent", Specialties: []string{"cardiologia"}}
+
+	w, recorder := testChatWriter(true)
+	if err := w.WriteContent("Procure atendimento"); err != nil {
+		t.Fatalf("WriteContent() = %v", err)
+	}
+	if err := w.WriteSummary(summary); err != nil {
+		t.Fatalf("WriteSummary() = %v", err)
+	}
+	events := parseEvents(t, recorder.Body.Bytes())
+	if len(events) != 2 || events[1].name != "summary" {
+		t.Fatalf("events = %+v, want the delta followed by the summary", events)
+	}
+	var got DiagnosisSummary
+	decode(t, []byte(events[1].data), &got)
+	if got.TriageLevel != "urgent" || len(got.Specialties) != 1 {
+		t.Errorf("summary event = %+v, want %+v", got, summary)
+	}
+
+	// Plain text clients only get the markdown
+	w, recorder = testChatWriter(false)
+	w.WriteContent("Procure atendimento")
+	if err := w.WriteSummary(summary); err != nil {
+		t.Fatalf("WriteSummary() = %v", err)
+	}
+	if body := recorder.Body.String(); body != "Procure atendimento" {
+		t.Errorf("plain text body = %q, want the markdown alone", body)
+	}
+}
+
+func TestWriteDone(t *testing.T) {
+	tests := []struct {
+		name      string
+		signature string
+		want      string
+	}{
+		{"unsigned", "", `{}`},
+		{"signed", "abc", `{"signature":"abc"}`},
+	}
+
+	for _, tt := range tests {
+		w, recorder := testChatWriter(true)
+		w.WriteContent("Procure atendimento")
+		if err := w.WriteDone(tt.signature); err != nil {
+			t.Fatalf("%s: WriteDone() = %v", tt.name, err)
+		}
+		events := parseEvents(t, recorder.Body.Bytes())
+		if len(events) != 2 || events[1].name != "done" || events[1].data != tt.want {
+			t.Errorf("%s: events = %+v, want the delta followed by done with %s", tt.name, events, tt.want)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/triage"
+)
+
+const (
+	// chatStreamTimeout bounds a diagnosis, whether or not a client is still following it
+	chatStreamTimeout = time.Minute * 5
+	// chatStreamEventBytes is how many bytes of events a stream buffers for clients that reconnect,
+	// well above a whole diagnosis so that only runaway streams lose their first events
+	chatStreamEventBytes = 4 * 1024 * 1024
+	// defaultChatStreamRetention is how long a finished stream stays available when none is configured
+	defaultChatStreamRetention = time.Minute * 2
+	// defaultDiagnosisCacheMaxBytes bounds the diagnosis cache when no bound is configured
+	defaultDiagnosisCacheMaxBytes = 64 * 1024 * 1024
+)
+
+// StopStreamResponse represents a stop stream response
+type StopStreamResponse struct {
+	Success bool `json:"success"`
+}
+
+// chatStream is a diagnosis streamed by /api/chat.
+// Its events are buffered so a client that lost the connection can resume it, and it can be stopped from another request.
+type chatStream struct {
+	id             string
+	account        *grpc.Account
+	resource       string
+	conversationID string
+	events         *eventLog
+	ctx            context.Context
+	cancel         context.CancelFunc
+	stopped        atomic.Bool
+}
+
+// chatStreamRegistry holds the running streams and the finished ones still within the retention window
+type chatStreamRegistry struct {
+	mu      sync.Mutex
+	streams map[string]*chatStream
+	// eventBytes bounds the events buffered by each stream
+	eventBytes int
+}
+
+// newChatStreamRegistry creates an empty stream registry
+func newChatStreamRegistry() *chatStreamRegistry {
+	return &chatStreamRegistry{streams: map[string]*chatStream{}, eventBytes: chatStreamEventBytes}
+}
+
+// start registers a new stream
+func (r *chatStreamRegistry) start(account *grpc.Account, resource, conversationID string) *chatStream {
+	ctx, cancel := context.WithTimeout(context.Background(), chatStreamTimeout)
+	stream := &chatStream{
+		id:             newRandomID(),
+		account:        account,
+		resource:       resource,
+		conversationID: conversationID,
+		events:         newEventLog(r.eventBytes),
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.streams[stream.id] = stream
+	return stream
+}
+
+// get returns a stream by ID
+func (r *chatStreamRegistry) get(id string) *chatStream {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.streams[id]
+}
+
+// finish closes a stream and forgets it once the retention window is over
+func (r *chatStreamRegistry) finish(stream *chatStream, retention time.Duration) {
+	stream.events.close()
+	stream.cancel()
+
+	time.AfterFunc(retention, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.streams, stream.id)
+	})
+}
+
+// stop cancels the stream's gRPC call
+func (cs *chatStream) stop() {
+	cs.stopped.Store(true)
+	cs.cancel()
+}
+
+// wasStopped reports whether the stream ended because it was stopped on request
+func (cs *chatStream) wasStopped() bool {
+	return cs.stopped.Load()
+}
+
+// streamRecorder writes a diagnosis into the events of a stream
+type streamRecorder struct {
+	events *eventLog
+}
+
+// WriteMetadata records the model and the backend answering the diagnosis
+func (r *streamRecorder) WriteMetadata(metadata grpc.DiagnoseMetadata) error {
+	r.events.append(chatEvent{Type: chatEventMetadata, Model: metadata.Model, Backend: metadata.Backend})
+	return nil
+}
+
+// WriteContent records a markdown delta
+func (r *streamRecorder) WriteContent(content string) error {
+	r.events.append(chatEvent{Type: chatEventDelta, Content: content})
+	return nil
+}
+
+// WriteSummary records the structured diagnosis
+func (r *streamRecorder) WriteSummary(summary grpc.DiagnosisSummary) error {
+	converted := newDiagnosisSummary(summary)
+	r.events.append(chatEvent{Type: chatEventSummary, Summary: &converted})
+	return nil
+}
+
+// produceChatStream runs a diagnosis into a stream, then audits and saves it, releasing its quota reservation
+// once the usage is recorded. The context is a copy of the request's, as the request may be gone by the time
+// the diagnosis ends.
+func (s *Server) produceChatStream(c *gin.Context, stream *chatStream, req ChatRequest, input grpc.DiagnoseInput, triageResult *triage.Result, reservation *quotaReservation) {
+	defer s.chatStreams.finish(stream, s.config.ChatStreamRetention)
+	defer reservation.release()
+
+	// Saving uses its own context, since stopping the stream cancels the stream's one
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	recorder := &streamRecorder{events: stream.events}
+	actor := accountActor(stream.account)
+	conversation := "conversation " + stream.conversationID
+
+	guidance := ""
+	if triageResult != nil {
+		guidance = triageResult.Guidance
+		recorder.WriteContent(guidance)
+
+		if triageResult.Action == triage.ActionReplace {
+			summary := triageSummary(triageResult)
+			recorder.WriteSummary(*summary)
+			s.recordAudit(c, actor, auditActionDiagnosis, stream.resource, audit.OutcomeSuccess, conversation+" answered by triage")
+			s.saveConversationTurn(ctx, req, stream.conversationID, grpc.ChatMessage{Content: guidance, Summary: summary})
+			s.flagConversation(ctx, c, stream.account, stream.resource, req, stream.conversationID, triageResult)
+			stream.events.append(chatEvent{Type: chatEventDone, Signature: s.signReply(stream.account, guidance)})
+			return
+		}
+	}
+
+	// Stream the response into the buffer, followed by the structured summary
+	diagnosisOutput, err := s.aiClient.StreamDiagnose(stream.ctx, recorder, input)
+	usage := diagnosisOutput.Usage
+	if err != nil && usage == nil && diagnosisOutput.Backend != "" {
+		// The AI service started answering, so the diagnosis is billed even though it never reported its usage
+		usage = partialUsage(s.estimatePrompt(input), diagnosisOutput.Content)
+	}
+	s.recordUsage(ctx, stream.account, stream.conversationID, diagnosisOutput.Model, usage)
+	reply := grpc.ChatMessage{
+		Content: guidance + diagnosisOutput.Content,
+		Summary: diagnosisOutput.Summary,
+	}
+	if err != nil {
+		if stream.wasStopped() {
+			s.accessLogger.Printf("Diagnosis stream %s stopped by the client", stream.id)
+			s.recordAudit(c, actor, auditActionDiagnosis, stream.resource, audit.OutcomeSuccess, conversation+" stopped")
+			stream.events.append(chatEvent{Type: chatEventStopped})
+		} else {
+			s.errorLogger.Printf("Diagnosis streaming failed: %v", err)
+			s.recordAudit(c, actor, auditActionDiagnosis, stream.resource, audit.OutcomeFailure, conversation)
+			stream.events.append(chatEvent{Type: chatEventError, Error: "Diagnosis failed"})
+		}
+
+		// Keep whatever reached the client, marked as truncated
+		if reply.Content == "" {
+			return
+		}
+		reply.Truncated = true
+	} else {
+		s.recordAudit(c, actor, auditActionDiagnosis, stream.resource, audit.OutcomeSuccess, conversation)
+	}
+
+	// Store the latest turn in the conversation history
+	s.saveConversationTurn(ctx, req, stream.conversationID, reply)
+	if triageResult != nil {
+		s.flagConversation(ctx, c, stream.account, stream.resource, req, stream.conversationID, triageResult)
+	}
+	stream.events.append(chatEvent{Type: chatEventDone, Signature: s.signReply(stream.account, reply.Content)})
+}
+
+// followChatStream writes the events of a stream after lastSeq to the client, then the live ones until the stream ends.
+// A client that would miss events gets 410 Gone, or an error event once the stream has started, rather than a reply with holes.
+func (s *Server) followChatStream(c *gin.Context, stream *chatStream, lastSeq int64) {
+	writer := newChatWriter(c)
+	writer.signed = s.replyKey != nil
+
+	for {
+		events, missed, changed, closed := stream.events.since(lastSeq)
+		if missed {
+			s.errorLogger.Printf("Client of diagnosis stream %s missed events after %d", stream.id, lastSeq)
+			if !writer.started {
+				c.JSON(http.StatusGone, gin.H{"error": "Some events are no longer available"})
+				return
+			}
+			writer.WriteError("Some events are no longer available")
+			return
+		}
+
+		for _, event := range events {
+			writer.id = event.Seq
+			if !s.writeChatEvent(c, writer, event) {
+				return
+			}
+			lastSeq = event.Seq
+		}
+		if closed {
+			return
+		}
+
+		select {
+		case <-changed:
+		case <-c.Request.Context().Done():
+			// The client went away, it can resume the stream later
+			return
+		}
+	}
+}
+
+// writeChatEvent writes a stream event to the client, reporting whether the client can take more
+func (s *Server) writeChatEvent(c *gin.Context, writer *chatWriter, event chatEvent) bool {
+	var err error
+	switch event.Type {
+	case chatEventMetadata:
+		err = writer.WriteMetadata(event.Model, event.Backend)
+	case chatEventDelta:
+		err = writer.WriteContent(event.Content)
+	case chatEventSummary:
+		err = writer.WriteSummary(*event.Summary)
+	case chatEventStopped:
+		err = writer.WriteStopped()
+	case chatEventDone:
+		err = writer.WriteDone(event.Signature)
+	case chatEventError:
+		// If headers haven't been sent yet, return an error response
+		if !writer.started {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": event.Error})
+			return false
+		}
+		writer.WriteError(event.Error)
+	}
+
+	if err != nil {
+		s.errorLogger.Printf("Failed to write chat stream event: %v", err)
+		return false
+	}
+	return true
+}
+
+// handleResumeChatStream handles reconnects to a diagnosis stream.
+// The events after the Last-Event-ID header are replayed, then the stream continues live if it is still running.
+func (s *Server) handleResumeChatStream(c *gin.Context) {
+	stream, _, ok := s.ownedChatStream(c, auditActionDiagnosis)
+	if !ok {
+		return
+	}
+
+	var lastSeq int64
+	if value := c.GetHeader("Last-Event-ID"); value != "" {
+		seq, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || seq < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Last-Event-ID"})
+			return
+		}
+		lastSeq = seq
+	}
+
+	s.accessLogger.Printf("Resuming diagnosis stream %s after event %d", stream.id, lastSeq)
+	c.Header("X-Conversation-ID", stream.conversationID)
+	c.Header("X-Stream-ID", stream.id)
+	s.followChatStream(c, stream, lastSeq)
+}
+
+// handleStopChatStream handles requests to stop a diagnosis being streamed by /api/chat.
+// The partial answer is kept in the conversation history, marked as truncated.
+func (s *Server) handleStopChatStream(c *gin.Context) {
+	stream, account, ok := s.ownedChatStream(c, auditActionDiagnosisStop)
+	if !ok {
+		return
+	}
+
+	if stream.events.isClosed() {
+		c.JSON(http.StatusConflict, gin.H{"error": "Stream already finished"})
+		return
+	}
+
+	stream.stop()
+	s.recordAudit(c, accountActor(account), auditActionDiagnosisStop, stream.resource, audit.OutcomeSuccess, "stream "+stream.id)
+	c.JSON(http.StatusOK, StopStreamResponse{
+		Success: true,
+	})
+}
+
+// ownedChatStream finds the stream in the path and checks that the token's account started it.
+// On failure the error response has already been written.
+func (s *Server) ownedChatStream(c *gin.Context, action string) (*chatStream, *grpc.Account, bool) {
+	token := c.Query("token")
+	if token == "" {
+		s.errorLogger.Printf("Missing token in chat stream request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token is required"})
+		return nil, nil, false
+	}
+
+	streamID := c.Param("id")
+	account := s.lookupAccount(c, token)
+	if account == nil {
+		s.recordAudit(c, anonymousActor, action, "stream:"+streamID, audit.OutcomeFailure, "invalid token")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return nil, nil, false
+	}
+
+	stream := s.chatStreams.get(streamID)
+	if stream == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Stream not found or expired"})
+		return nil, nil, false
+	}
+
+	// Only the account that started the stream may use it
+	if stream.account == nil || stream.account.ID != account.ID {
+		s.errorLogger.Printf("User %d tried to use stream %s of another account", account.ID, streamID)
+		s.recordAudit(c, accountActor(account), action, stream.resource, audit.OutcomeDenied, "stream "+streamID)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Stream belongs to another account"})
+		return nil, nil, false
+	}
+
+	return stream, account, true
+}
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/triage"
+)
+
+// initTriage loads the red-flag triage rules, falling back to the built-in ones
+func (s *Server) initTriage() error {
+	if s.triage != nil {
+		return nil
+	}
+
+	rules, err := triage.Load(s.config.TriageRules)
+	if err != nil {
+		return err
+	}
+	s.triage = rules
+	return nil
+}
+
+// checkTriage runs the newest user message through the red-flag rules, returning nil when none matches
+func (s *Server) checkTriage(req ChatRequest, patientInfo grpc.PatientInfo) *triage.Result {
+	if s.triage == nil || len(req.Messages) == 0 {
+		return nil
+	}
+
+	latest := req.Messages[len(req.Messages)-1]
+	if latest.Role != "user" {
+		return nil
+	}
+	return s.triage.Check(latest.Content, patientInfo)
+}
+
+// triageSummary builds the structured diagnosis of a reply answered by triage alone
+func triageSummary(result *triage.Result) *grpc.DiagnosisSummary {
+	return &grpc.DiagnosisSummary{
+		TriageLevel: result.Severity,
+		RedFlags:    result.Rules,
+	}
+}
+
+// flagConversation marks a conversation for clinician review after a triage match and audits it.
+// Failures are only logged, since the guidance has already been streamed to the client.
+func (s *Server) flagConversation(ctx context.Context, c *gin.Context, account *grpc.Account, resource string, req ChatRequest, conversationID string, result *triage.Result) {
+	rules := strings.Join(result.Rules, ",")
+
+	flagInput := grpc.FlagConversationInput{
+		Token:          req.Token,
+		ConversationID: conversationID,
+		PatientID:      req.PatientID,
+		Reason:         "red-flag triage: " + rules,
+		RuleIDs:        result.Rules,
+		Severity:       result.Severity,
+	}
+
+	outcome := audit.OutcomeSuccess
+	if _, err := s.dbClient.FlagConversation(ctx, flagInput); err != nil {
+		s.errorLogger.Printf("Failed to flag conversation %s: %v", conversationID, err)
+		outcome = audit.OutcomeFailure
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionTriageFlag, resource, outcome,
+		fmt.Sprintf("conversation %s severity %s rules %s", conversationID, result.Severity, rules))
+}
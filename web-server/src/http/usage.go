@@ -0,0 +1,481 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/budget"
+	"unb.br/web-server/src/grpc"
+)
+
+// usageResource is the audit resource of usage queries
+const usageResource = "usage"
+
+// ModelPrice represents what a model costs, in USD per million tokens
+type ModelPrice struct {
+	Prompt     float64
+	Completion float64
+}
+
+// UsageTotals represents tokens used and their estimated cost in USD
+type UsageTotals struct {
+	PromptTokens     int64   `json:"prompt_tokens"`
+	CompletionTokens int64   `json:"completion_tokens"`
+	TotalTokens      int64   `json:"total_tokens"`
+	Requests         int32   `json:"requests"`
+	Cost             float64 `json:"cost"`
+}
+
+// ModelUsage represents the usage of a model
+type ModelUsage struct {
+	Model string `json:"model"`
+	UsageTotals
+}
+
+// ConversationUsage represents the usage of a conversation
+type ConversationUsage struct {
+	ConversationID string `json:"conversation_id"`
+	UsageTotals
+}
+
+// UserUsage represents the usage of an account
+type UserUsage struct {
+	AccountID int32 `json:"account_id"`
+	UsageTotals
+}
+
+// UsageQuota represents the monthly quotas of an account and what is left of them; disabled quotas are left out
+type UsageQuota struct {
+	Tokens          int64     `json:"tokens,omitempty"`
+	TokensRemaining *int64    `json:"tokens_remaining,omitempty"`
+	Cost            float64   `json:"cost,omitempty"`
+	CostRemaining   *float64  `json:"cost_remaining,omitempty"`
+	ResetsAt        time.Time `json:"resets_at"`
+}
+
+// UsageResponse represents the usage of the requesting account
+type UsageResponse struct {
+	Since         time.Time           `json:"since"`
+	Until         time.Time           `json:"until"`
+	Total         UsageTotals         `json:"total"`
+	Models        []ModelUsage        `json:"models"`
+	Conversations []ConversationUsage `json:"conversations"`
+	Quota         *UsageQuota         `json:"quota,omitempty"`
+}
+
+// AdminUsageResponse represents the usage of every account
+type AdminUsageResponse struct {
+	Since  time.Time    `json:"since"`
+	Until  time.Time    `json:"until"`
+	Total  UsageTotals  `json:"total"`
+	Users  []UserUsage  `json:"users"`
+	Models []ModelUsage `json:"models"`
+}
+
+// ParseUsagePrices parses the price of each model, as "model=prompt/completion;model=prompt/completion"
+// in USD per million tokens. Models without a price cost nothing.
+func ParseUsagePrices(value string) (map[string]ModelPrice, error) {
+	prices := map[string]ModelPrice{}
+	for _, entry := range strings.Split(value, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		model, price, ok := strings.Cut(entry, "=")
+		prompt, completion, split := strings.Cut(price, "/")
+		model = strings.TrimSpace(model)
+		if !ok || !split || model == "" {
+			return nil, fmt.Errorf("invalid usage price %q", entry)
+		}
+
+		var parsed ModelPrice
+		var err error
+		if parsed.Prompt, err = strconv.ParseFloat(strings.TrimSpace(prompt), 64); err != nil || parsed.Prompt < 0 {
+			return nil, fmt.Errorf("invalid prompt price for model %q", model)
+		}
+		if parsed.Completion, err = strconv.ParseFloat(strings.TrimSpace(completion), 64); err != nil || parsed.Completion < 0 {
+			return nil, fmt.Errorf("invalid completion price for model %q", model)
+		}
+		prices[model] = parsed
+	}
+	return prices, nil
+}
+
+// add sums a usage total, pricing it with the model's price
+func (t *UsageTotals) add(total grpc.UsageTotal, price ModelPrice) {
+	t.PromptTokens += total.PromptTokens
+	t.CompletionTokens += total.CompletionTokens
+	t.TotalTokens += total.PromptTokens + total.CompletionTokens
+	t.Requests += total.Requests
+	t.Cost += (float64(total.PromptTokens)*price.Prompt + float64(total.CompletionTokens)*price.Completion) / 1e6
+}
+
+// monthStart returns the start of the UTC month of a time, when monthly quotas reset
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// recordUsage records the tokens a diagnosis used; usage the AI service did not report is not recorded
+func (s *Server) recordUsage(ctx context.Context, account *grpc.Account, conversationID, model string, usage *grpc.TokenUsage) {
+	if account == nil || usage == nil {
+		return
+	}
+
+	_, err := s.dbClient.RecordUsage(ctx, grpc.RecordUsageInput{
+		Record: grpc.UsageRecord{
+			AccountID:        account.ID,
+			ConversationID:   conversationID,
+			Model:            model,
+			PromptTokens:     usage.PromptTokens,
+			CompletionTokens: usage.CompletionTokens,
+			Time:             time.Now().UTC(),
+		},
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to record usage of conversation %s: %v", conversationID, err)
+	}
+}
+
+// quotaReservations holds what the diagnoses of each account that passed the quota check may use until their
+// usage is recorded, so concurrent diagnoses cannot all pass the check against the same recorded usage
+type quotaReservations struct {
+	mu sync.Mutex
+	// reserved sums the estimates of each account's diagnoses, Requests counting them
+	reserved map[int32]UsageTotals
+}
+
+// quotaReservation is the estimate a diagnosis reserved; a nil reservation reserves nothing
+type quotaReservation struct {
+	reservations *quotaReservations
+	accountID    int32
+	estimate     UsageTotals
+	released     bool
+}
+
+// newQuotaReservations creates empty quota reservations
+func newQuotaReservations() *quotaReservations {
+	return &quotaReservations{reserved: map[int32]UsageTotals{}}
+}
+
+// reserve adds the estimate of a diagnosis to its account, returning the reservation and what the account's other
+// diagnoses had reserved before it. Of two concurrent diagnoses, the one reserving last always counts the other.
+func (r *quotaReservations) reserve(accountID int32, estimate UsageTotals) (*quotaReservation, UsageTotals) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	others := r.reserved[accountID]
+	total := others
+	total.PromptTokens += estimate.PromptTokens
+	total.CompletionTokens += estimate.CompletionTokens
+	total.TotalTokens += estimate.TotalTokens
+	total.Cost += estimate.Cost
+	total.Requests++
+	r.reserved[accountID] = total
+	return &quotaReservation{reservations: r, accountID: accountID, estimate: estimate}, others
+}
+
+// release gives back a reservation once the diagnosis recorded its usage or ended without any
+func (q *quotaReservation) release() {
+	if q == nil {
+		return
+	}
+	r := q.reservations
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if q.released {
+		return
+	}
+	q.released = true
+
+	total := r.reserved[q.accountID]
+	total.Requests--
+	if total.Requests <= 0 {
+		// Drop the account rather than keep the rounding errors of the costs
+		delete(r.reserved, q.accountID)
+		return
+	}
+	total.PromptTokens -= q.estimate.PromptTokens
+	total.CompletionTokens -= q.estimate.CompletionTokens
+	total.TotalTokens -= q.estimate.TotalTokens
+	total.Cost -= q.estimate.Cost
+	r.reserved[q.accountID] = total
+}
+
+// estimatePrompt estimates the prompt tokens of a diagnosis, within the context budget
+func (s *Server) estimatePrompt(input grpc.DiagnoseInput) int {
+	prompt := budget.EstimateContext(input)
+	for _, message := range input.Messages {
+		prompt += budget.EstimateMessage(message)
+	}
+	if s.config.ContextTokenBudget > 0 {
+		prompt = min(prompt, s.config.ContextTokenBudget)
+	}
+	return prompt
+}
+
+// partialUsage estimates the usage of a diagnosis that was stopped or failed before the AI service reported it:
+// the model provider still bills its prompt and what it generated so far
+func partialUsage(promptTokens int, content string) *grpc.TokenUsage {
+	return &grpc.TokenUsage{PromptTokens: int32(promptTokens), CompletionTokens: int32(budget.EstimateTokens(content))}
+}
+
+// estimateDiagnosis estimates what a diagnosis may use: its prompt, within the context budget,
+// and the most tokens it may generate
+func (s *Server) estimateDiagnosis(input grpc.DiagnoseInput, model string) UsageTotals {
+	prompt := s.estimatePrompt(input)
+	completion := int(input.MaxTokens)
+	if completion <= 0 {
+		completion = s.config.MaxOutputTokens
+	}
+
+	var estimate UsageTotals
+	estimate.add(grpc.UsageTotal{Model: model, PromptTokens: int64(prompt), CompletionTokens: int64(completion)}, s.config.UsagePrices[model])
+	return estimate
+}
+
+// checkQuota audits and reports a monthly quota the account used up, with the status to reject the diagnosis with:
+// 429 for the token quota, 402 for the cost quota. Diagnoses in progress count with their estimate, which an
+// allowed diagnosis reserves until its usage is recorded; the reservation must then be released.
+// Usage that cannot be read allows the diagnosis with QuotaFailOpen, and answers 503 otherwise.
+func (s *Server) checkQuota(ctx context.Context, c *gin.Context, account *grpc.Account, resource string, estimate UsageTotals) (*quotaReservation, int, string, time.Time) {
+	now := time.Now().UTC()
+	resets := monthStart(now).AddDate(0, 1, 0)
+	if account == nil || (s.config.MonthlyTokenQuota <= 0 && s.config.MonthlyCostQuota <= 0) {
+		return nil, 0, "", resets
+	}
+
+	// Reserve before reading the usage, so every other diagnosis is either reserved or recorded by then
+	reservation, others := s.quotaReservations.reserve(account.ID, estimate)
+	used, err := s.monthUsage(ctx, account, now)
+	if err != nil {
+		if s.config.QuotaFailOpen {
+			s.errorLogger.Printf("Failed to check the quota of user %d, allowing the diagnosis: %v", account.ID, err)
+			return reservation, 0, "", resets
+		}
+		reservation.release()
+		s.errorLogger.Printf("Failed to check the quota of user %d, refusing the diagnosis: %v", account.ID, err)
+		s.recordAudit(c, accountActor(account), auditActionDiagnosis, resource, audit.OutcomeFailure, "quota check failed")
+		return nil, http.StatusServiceUnavailable, "Usage quota cannot be checked", resets
+	}
+	used.TotalTokens += others.TotalTokens
+	used.Cost += others.Cost
+
+	switch {
+	case s.config.MonthlyTokenQuota > 0 && used.TotalTokens >= s.config.MonthlyTokenQuota:
+		reservation.release()
+		s.recordAudit(c, accountActor(account), auditActionDiagnosis, resource, audit.OutcomeDenied, "monthly token quota exceeded")
+		return nil, http.StatusTooManyRequests, "Monthly token quota exceeded", resets
+	case s.config.MonthlyCostQuota > 0 && used.Cost >= s.config.MonthlyCostQuota:
+		reservation.release()
+		s.recordAudit(c, accountActor(account), auditActionDiagnosis, resource, audit.OutcomeDenied, "monthly cost quota exceeded")
+		return nil, http.StatusPaymentRequired, "Monthly cost quota exceeded", resets
+	}
+	return reservation, 0, "", resets
+}
+
+// monthUsage sums the usage of an account in the month of now
+func (s *Server) monthUsage(ctx context.Context, account *grpc.Account, now time.Time) (UsageTotals, error) {
+	var used UsageTotals
+	output, err := s.dbClient.ListUsage(ctx, grpc.ListUsageInput{AccountID: account.ID, Since: monthStart(now)})
+	if err != nil {
+		return used, err
+	}
+	for _, total := range output.Totals {
+		used.add(total, s.config.UsagePrices[total.Model])
+	}
+	return used, nil
+}
+
+// usagePeriod reads the since and until query parameters, by default the current month.
+// On failure the 400 response has already been written.
+func usagePeriod(c *gin.Context) (time.Time, time.Time, bool) {
+	now := time.Now().UTC()
+	since, until := monthStart(now), now
+
+	var err error
+	if value := c.Query("since"); value != "" {
+		if since, err = time.Parse(time.RFC3339, value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "since must be an RFC 3339 timestamp"})
+			return since, until, false
+		}
+	}
+	if value := c.Query("until"); value != "" {
+		if until, err = time.Parse(time.RFC3339, value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "until must be an RFC 3339 timestamp"})
+			return since, until, false
+		}
+	}
+	if !until.After(since) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "until must be after since"})
+		return since, until, false
+	}
+	return since, until, true
+}
+
+// handleMyUsage handles requests for the usage of the requesting account, per model and conversation
+func (s *Server) handleMyUsage(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		s.errorLogger.Printf("Missing token in usage request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token is required"})
+		return
+	}
+
+	since, until, ok := usagePeriod(c)
+	if !ok {
+		return
+	}
+
+	account := s.lookupAccount(c, token)
+	if account == nil {
+		s.recordAudit(c, anonymousActor, auditActionUsageRead, usageResource, audit.OutcomeFailure, "invalid token")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	output, err := s.dbClient.ListUsage(ctx, grpc.ListUsageInput{AccountID: account.ID, Since: since, Until: until})
+	if err != nil {
+		s.errorLogger.Printf("Failed to list usage: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionUsageRead, usageResource, audit.OutcomeFailure, "")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get usage"})
+		return
+	}
+
+	response := UsageResponse{Since: since, Until: until}
+	models := map[string]*ModelUsage{}
+	conversations := map[string]*ConversationUsage{}
+	for _, total := range output.Totals {
+		price := s.config.UsagePrices[total.Model]
+		response.Total.add(total, price)
+
+		if models[total.Model] == nil {
+			models[total.Model] = &ModelUsage{Model: total.Model}
+		}
+		models[total.Model].add(total, price)
+
+		if conversations[total.ConversationID] == nil {
+			conversations[total.ConversationID] = &ConversationUsage{ConversationID: total.ConversationID}
+		}
+		conversations[total.ConversationID].add(total, price)
+	}
+	response.Models = sortedModelUsage(models)
+	response.Conversations = make([]ConversationUsage, 0, len(conversations))
+	for _, usage := range conversations {
+		response.Conversations = append(response.Conversations, *usage)
+	}
+	sort.Slice(response.Conversations, func(i, j int) bool {
+		return response.Conversations[i].TotalTokens > response.Conversations[j].TotalTokens
+	})
+
+	// The quota is always about the current month, whatever the period asked for
+	if s.config.MonthlyTokenQuota > 0 || s.config.MonthlyCostQuota > 0 {
+		response.Quota = s.usageQuota(ctx, account)
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionUsageRead, usageResource, audit.OutcomeSuccess, "")
+	c.JSON(http.StatusOK, response)
+}
+
+// usageQuota reports the monthly quotas of an account and what is left of them
+func (s *Server) usageQuota(ctx context.Context, account *grpc.Account) *UsageQuota {
+	now := time.Now().UTC()
+	quota := &UsageQuota{
+		Tokens:   s.config.MonthlyTokenQuota,
+		Cost:     s.config.MonthlyCostQuota,
+		ResetsAt: monthStart(now).AddDate(0, 1, 0),
+	}
+
+	used, err := s.monthUsage(ctx, account, now)
+	if err != nil {
+		s.errorLogger.Printf("Failed to get the quota usage of user %d: %v", account.ID, err)
+		return quota
+	}
+	if quota.Tokens > 0 {
+		remaining := max(quota.Tokens-used.TotalTokens, 0)
+		quota.TokensRemaining = &remaining
+	}
+	if quota.Cost > 0 {
+		remaining := max(quota.Cost-used.Cost, 0)
+		quota.CostRemaining = &remaining
+	}
+	return quota
+}
+
+// handleAdminUsage handles requests from administrators for the usage of every account, per user and model
+func (s *Server) handleAdminUsage(c *gin.Context) {
+	account, ok := s.requireAdmin(c, auditActionUsageQuery, usageResource)
+	if !ok {
+		return
+	}
+
+	since, until, ok := usagePeriod(c)
+	if !ok {
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	output, err := s.dbClient.ListUsage(ctx, grpc.ListUsageInput{Since: since, Until: until})
+	if err != nil {
+		s.errorLogger.Printf("Failed to list usage: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionUsageQuery, usageResource, audit.OutcomeFailure, "")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get usage"})
+		return
+	}
+
+	response := AdminUsageResponse{Since: since, Until: until}
+	models := map[string]*ModelUsage{}
+	users := map[int32]*UserUsage{}
+	for _, total := range output.Totals {
+		price := s.config.UsagePrices[total.Model]
+		response.Total.add(total, price)
+
+		if models[total.Model] == nil {
+			models[total.Model] = &ModelUsage{Model: total.Model}
+		}
+		models[total.Model].add(total, price)
+
+		if users[total.AccountID] == nil {
+			users[total.AccountID] = &UserUsage{AccountID: total.AccountID}
+		}
+		users[total.AccountID].add(total, price)
+	}
+	response.Models = sortedModelUsage(models)
+	response.Users = make([]UserUsage, 0, len(users))
+	for _, usage := range users {
+		response.Users = append(response.Users, *usage)
+	}
+	sort.Slice(response.Users, func(i, j int) bool {
+		return response.Users[i].Cost > response.Users[j].Cost ||
+			(response.Users[i].Cost == response.Users[j].Cost && response.Users[i].TotalTokens > response.Users[j].TotalTokens)
+	})
+
+	s.recordAudit(c, accountActor(account), auditActionUsageQuery, usageResource, audit.OutcomeSuccess, "")
+	c.JSON(http.StatusOK, response)
+}
+
+// sortedModelUsage lists the usage of each model by model name
+func sortedModelUsage(models map[string]*ModelUsage) []ModelUsage {
+	list := make([]ModelUsage, 0, len(models))
+	for _, usage := range models {
+		list = append(list, *usage)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Model < list[j].Model
+	})
+	return list
+}
@@ -0,0 +1,107 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/grpc"
+)
+
+// quotaContext is the request context checkQuota audits with
+func quotaContext() *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/chat", nil)
+	return c
+}
+
+func TestCheckQuotaCountsReservations(t *testing.T) {
+	ts := newTestServer(t)
+	ts.config.MonthlyTokenQuota = 1000
+	account := &grpc.Account{ID: 1}
+	estimate := UsageTotals{TotalTokens: 600}
+	ctx := context.Background()
+
+	// The second diagnosis counts the first one, still in progress, and the third is over the quota
+	first, status, _, _ := ts.checkQuota(ctx, quotaContext(), account, "patient", estimate)
+	if status != 0 || first == nil {
+		t.Fatalf("first checkQuota() status = %d, want it allowed with a reservation", status)
+	}
+	second, status, _, _ := ts.checkQuota(ctx, quotaContext(), account, "patient", estimate)
+	if status != 0 {
+		t.Fatalf("second checkQuota() status = %d, want it allowed", status)
+	}
+	if third, status, _, _ := ts.checkQuota(ctx, quotaContext(), account, "patient", estimate); status != http.StatusTooManyRequests || third != nil {
+		t.Fatalf("third checkQuota() status = %d, want 429 without a reservation", status)
+	}
+
+	// Other accounts have their own reservations
+	if other, status, _, _ := ts.checkQuota(ctx, quotaContext(), &grpc.Account{ID: 2}, "patient", estimate); status != 0 {
+		t.Fatalf("checkQuota() of another account status = %d, want it allowed", status)
+	} else {
+		other.release()
+	}
+
+	// Releasing twice gives the reservation back once
+	first.release()
+	first.release()
+	if reserved := ts.quotaReservations.reserved[1]; reserved.TotalTokens != 600 || reserved.Requests != 1 {
+		t.Fatalf("reserved after a release = %+v, want the second diagnosis only", reserved)
+	}
+	second.release()
+	if len(ts.quotaReservations.reserved) != 0 {
+		t.Fatalf("reserved after releasing everything = %+v, want nothing", ts.quotaReservations.reserved)
+	}
+
+	// Recorded usage takes the place of the reservations
+	ts.recordUsage(ctx, account, "conversation", "gpt-4.1", &grpc.TokenUsage{PromptTokens: 700, CompletionTokens: 300})
+	if _, status, _, _ := ts.checkQuota(ctx, quotaContext(), account, "patient", estimate); status != http.StatusTooManyRequests {
+		t.Fatalf("checkQuota() over the recorded usage status = %d, want 429", status)
+	}
+}
+
+func TestCheckQuotaUnreadableUsage(t *testing.T) {
+	ts := newTestServer(t)
+	ts.config.MonthlyTokenQuota = 1000
+	account := &grpc.Account{ID: 1}
+
+	// A cancelled context makes reading the usage fail
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ts.config.QuotaFailOpen = true
+	reservation, status, _, _ := ts.checkQuota(ctx, quotaContext(), account, "patient", UsageTotals{TotalTokens: 10})
+	if status != 0 {
+		t.Fatalf("checkQuota() failing open status = %d, want it allowed", status)
+	}
+	reservation.release()
+
+	ts.config.QuotaFailOpen = false
+	if _, status, message, _ := ts.checkQuota(ctx, quotaContext(), account, "patient", UsageTotals{TotalTokens: 10}); status != http.StatusServiceUnavailable {
+		t.Fatalf("checkQuota() failing closed = %d %q, want 503", status, message)
+	}
+	if len(ts.quotaReservations.reserved) != 0 {
+		t.Errorf("reserved = %+v, want the refused diagnosis released", ts.quotaReservations.reserved)
+	}
+}
+
+func TestEstimateDiagnosis(t *testing.T) {
+	s := &Server{config: Config{
+		MaxOutputTokens: 1000,
+		UsagePrices:     map[string]ModelPrice{"gpt-4.1": {Prompt: 2, Completion: 8}},
+	}}
+	input := grpc.DiagnoseInput{Messages: []grpc.Message{{Role: "user", Content: "dor de cabeça há dois dias"}}}
+
+	estimate := s.estimateDiagnosis(input, "gpt-4.1")
+	if estimate.CompletionTokens != 1000 || estimate.PromptTokens <= 0 || estimate.Cost <= 0.008 {
+		t.Fatalf("estimateDiagnosis() = %+v, want the prompt and the most output tokens, priced", estimate)
+	}
+
+	input.MaxTokens = 100
+	s.config.ContextTokenBudget = 5
+	if estimate := s.estimateDiagnosis(input, "free"); estimate.CompletionTokens != 100 || estimate.PromptTokens != 5 || estimate.Cost != 0 {
+		t.Errorf("estimateDiagnosis() = %+v, want 5 prompt and 100 output tokens at no cost", estimate)
+	}
+}
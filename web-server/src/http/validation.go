@@ -0,0 +1,239 @@
+package http
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Canonical gender values, matching the options offered by the client
+const (
+	genderMale           = "masculino"
+	genderFemale         = "feminino"
+	genderOther          = "outro"
+	genderPreferNotToSay = "prefiro_nao_informar"
+)
+
+// Canonical units stored by the database
+const (
+	unitKilogram   = "kg"
+	unitPound      = "lb"
+	unitCentimeter = "cm"
+	unitInch       = "in"
+)
+
+const (
+	minPatientAge      = 0
+	maxPatientAge      = 130
+	minPatientWeightKg = 0.5
+	maxPatientWeightKg = 500
+	minPatientHeightCm = 20
+	maxPatientHeightCm = 272
+	maxNameLength      = 100
+	maxClinicalText    = 200
+	maxClinicalEntries = 50
+	poundsToKilograms  = 0.45359237
+	inchesToCentimeter = 2.54
+)
+
+// genderAliases maps accepted spellings to the canonical gender values
+var genderAliases = map[string]string{
+	"masculino":            genderMale,
+	"male":                 genderMale,
+	"m":                    genderMale,
+	"feminino":             genderFemale,
+	"female":               genderFemale,
+	"f":                    genderFemale,
+	"outro":                genderOther,
+	"other":                genderOther,
+	"prefiro_nao_informar": genderPreferNotToSay,
+	"prefiro não informar": genderPreferNotToSay,
+	"prefiro nao informar": genderPreferNotToSay,
+	"prefer_not_to_say":    genderPreferNotToSay,
+	"prefer not to say":    genderPreferNotToSay,
+}
+
+// FieldError represents a validation error on a single field
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationErrorResponse represents a validation failure response
+type ValidationErrorResponse struct {
+	Error  string       `json:"error"`
+	Fields []FieldError `json:"fields"`
+}
+
+// DerivedMetrics represents values computed from the patient info
+type DerivedMetrics struct {
+	BMI         float32 `json:"bmi"`
+	BMICategory string  `json:"bmi_category,omitempty"`
+}
+
+// fieldErrors collects validation errors under a common field prefix
+type fieldErrors struct {
+	prefix string
+	errors []FieldError
+}
+
+// add records an error for a field
+func (f *fieldErrors) add(field, format string, args ...any) {
+	f.errors = append(f.errors, FieldError{
+		Field:   f.prefix + field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// text trims a free-text field and checks its length, reporting blank values when required
+func (f *fieldErrors) text(field string, value *string, required bool, maxLength int) {
+	*value = strings.TrimSpace(*value)
+	if required && *value == "" {
+		f.add(field, "is required")
+		return
+	}
+	if utf8.RuneCountInString(*value) > maxLength {
+		f.add(field, "must be at most %d characters", maxLength)
+	}
+}
+
+// year checks an optional year, zero meaning unknown
+func (f *fieldErrors) year(field string, value int32) {
+	if value != 0 && (value < 1900 || int(value) > time.Now().Year()) {
+		f.add(field, "must be between 1900 and %d", time.Now().Year())
+	}
+}
+
+// normalizePatientInfo converts the patient info to canonical units and values, then validates it.
+// The info is modified in place; the returned errors use JSON field paths under prefix.
+func normalizePatientInfo(info *PatientInfo, prefix string) []FieldError {
+	errs := &fieldErrors{prefix: prefix}
+
+	errs.text("name", &info.Name, true, maxNameLength)
+
+	if info.Age < minPatientAge || info.Age > maxPatientAge {
+		errs.add("age", "must be between %d and %d", minPatientAge, maxPatientAge)
+	}
+
+	if gender, ok := genderAliases[strings.ToLower(strings.TrimSpace(info.Gender))]; ok {
+		info.Gender = gender
+	} else {
+		errs.add("gender", "must be one of %s, %s, %s or %s", genderMale, genderFemale, genderOther, genderPreferNotToSay)
+	}
+
+	// Convert weight and height to the canonical units
+	switch strings.ToLower(info.WeightUnit) {
+	case "", unitKilogram:
+	case unitPound:
+		info.Weight = roundTo(info.Weight*poundsToKilograms, 2)
+	default:
+		errs.add("weight_unit", "must be %s or %s", unitKilogram, unitPound)
+	}
+	info.WeightUnit = unitKilogram
+
+	switch strings.ToLower(info.HeightUnit) {
+	case "", unitCentimeter:
+	case unitInch:
+		info.Height = roundTo(info.Height*inchesToCentimeter, 2)
+	default:
+		errs.add("height_unit", "must be %s or %s", unitCentimeter, unitInch)
+	}
+	info.HeightUnit = unitCentimeter
+
+	if info.Weight < minPatientWeightKg || info.Weight > maxPatientWeightKg {
+		errs.add("weight", "must be between %g and %g kg", minPatientWeightKg, float64(maxPatientWeightKg))
+	}
+	if info.Height < minPatientHeightCm || info.Height > maxPatientHeightCm {
+		errs.add("height", "must be between %d and %d cm", minPatientHeightCm, maxPatientHeightCm)
+	}
+
+	// Validate the clinical history lists
+	lists := []struct {
+		field string
+		count int
+	}{
+		{"allergies", len(info.Allergies)},
+		{"medications", len(info.Medications)},
+		{"conditions", len(info.Conditions)},
+		{"surgeries", len(info.Surgeries)},
+		{"family_history", len(info.FamilyHistory)},
+	}
+	for _, list := range lists {
+		if list.count > maxClinicalEntries {
+			errs.add(list.field, "must have at most %d entries", maxClinicalEntries)
+		}
+	}
+
+	for i := range info.Allergies {
+		allergy := &info.Allergies[i]
+		field := fmt.Sprintf("allergies[%d].", i)
+		errs.text(field+"substance", &allergy.Substance, true, maxClinicalText)
+		errs.text(field+"reaction", &allergy.Reaction, false, maxClinicalText)
+		errs.text(field+"severity", &allergy.Severity, false, maxClinicalText)
+	}
+	for i := range info.Medications {
+		medication := &info.Medications[i]
+		field := fmt.Sprintf("medications[%d].", i)
+		errs.text(field+"name", &medication.Name, true, maxClinicalText)
+		errs.text(field+"dosage", &medication.Dosage, false, maxClinicalText)
+		errs.text(field+"frequency", &medication.Frequency, false, maxClinicalText)
+	}
+	for i := range info.Conditions {
+		condition := &info.Conditions[i]
+		field := fmt.Sprintf("conditions[%d].", i)
+		errs.text(field+"name", &condition.Name, true, maxClinicalText)
+		errs.year(field+"diagnosed_year", condition.DiagnosedYear)
+		errs.text(field+"notes", &condition.Notes, false, maxClinicalText)
+	}
+	for i := range info.Surgeries {
+		surgery := &info.Surgeries[i]
+		field := fmt.Sprintf("surgeries[%d].", i)
+		errs.text(field+"procedure", &surgery.Procedure, true, maxClinicalText)
+		errs.year(field+"year", surgery.Year)
+		errs.text(field+"notes", &surgery.Notes, false, maxClinicalText)
+	}
+	for i := range info.FamilyHistory {
+		entry := &info.FamilyHistory[i]
+		field := fmt.Sprintf("family_history[%d].", i)
+		errs.text(field+"relative", &entry.Relative, true, maxClinicalText)
+		errs.text(field+"condition", &entry.Condition, true, maxClinicalText)
+	}
+
+	return errs.errors
+}
+
+// derivePatientMetrics computes the BMI and, for adults, its WHO category
+func derivePatientMetrics(info PatientInfo) *DerivedMetrics {
+	if info.Weight <= 0 || info.Height <= 0 {
+		return nil
+	}
+
+	heightMeters := info.Height / 100
+	derived := &DerivedMetrics{
+		BMI: roundTo(info.Weight/(heightMeters*heightMeters), 1),
+	}
+
+	// The adult cut-offs do not apply to children and teenagers
+	if info.Age >= 18 {
+		switch {
+		case derived.BMI < 18.5:
+			derived.BMICategory = "underweight"
+		case derived.BMI < 25:
+			derived.BMICategory = "normal"
+		case derived.BMI < 30:
+			derived.BMICategory = "overweight"
+		default:
+			derived.BMICategory = "obese"
+		}
+	}
+
+	return derived
+}
+
+// roundTo rounds a value to the given number of decimal places
+func roundTo(value float32, places int) float32 {
+	factor := math.Pow(10, float64(places))
+	return float32(math.Round(float64(value)*factor) / factor)
+}
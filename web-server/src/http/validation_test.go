@@ -0,0 +1,134 @@
+package http
+
+import (
+	"strings"
+	"testing"
+)
+
+// validPatient returns patient info that passes validation
+func validPatient() PatientInfo {
+	return PatientInfo{Name: "Maria", Age: 40, Gender: "feminino", Weight: 70, Height: 170}
+}
+
+// fieldsOf returns the fields named in validation errors
+func fieldsOf(errs []FieldError) []string {
+	fields := make([]string, len(errs))
+	for i, err := range errs {
+		fields[i] = err.Field
+	}
+	return fields
+}
+
+func TestNormalizePatientInfoCanonicalizes(t *testing.T) {
+	info := validPatient()
+	info.Name = "  Maria  "
+	info.Gender = " Female "
+	info.Weight, info.WeightUnit = 154, "LB"
+	info.Height, info.HeightUnit = 65, "in"
+	info.Allergies = []Allergy{{Substance: " penicilina ", Reaction: " urticária "}}
+
+	if errs := normalizePatientInfo(&info, "patient."); len(errs) > 0 {
+		t.Fatalf("normalizePatientInfo() errors = %v, want none", errs)
+	}
+
+	if info.Name != "Maria" || info.Gender != genderFemale {
+		t.Errorf("name, gender = %q, %q, want Maria, %s", info.Name, info.Gender, genderFemale)
+	}
+	if info.Weight != 69.85 || info.WeightUnit != unitKilogram {
+		t.Errorf("weight = %g %s, want 69.85 kg", info.Weight, info.WeightUnit)
+	}
+	if info.Height != 165.1 || info.HeightUnit != unitCentimeter {
+		t.Errorf("height = %g %s, want 165.1 cm", info.Height, info.HeightUnit)
+	}
+	if allergy := info.Allergies[0]; allergy.Substance != "penicilina" || allergy.Reaction != "urticária" {
+		t.Errorf("allergy = %+v, want trimmed texts", allergy)
+	}
+}
+
+func TestNormalizePatientInfoGenderAliases(t *testing.T) {
+	for alias, want := range map[string]string{
+		"M":                    genderMale,
+		"masculino":            genderMale,
+		"f":                    genderFemale,
+		"Other":                genderOther,
+		"prefiro não informar": genderPreferNotToSay,
+		"prefer_not_to_say":    genderPreferNotToSay,
+	} {
+		info := validPatient()
+		info.Gender = alias
+		if errs := normalizePatientInfo(&info, ""); len(errs) > 0 || info.Gender != want {
+			t.Errorf("gender %q = %q with errors %v, want %q", alias, info.Gender, errs, want)
+		}
+	}
+}
+
+func TestNormalizePatientInfoRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*PatientInfo)
+		field  string
+	}{
+		{"blank name", func(p *PatientInfo) { p.Name = "   " }, "patient.name"},
+		{"long name", func(p *PatientInfo) { p.Name = strings.Repeat("a", maxNameLength+1) }, "patient.name"},
+		{"negative age", func(p *PatientInfo) { p.Age = -1 }, "patient.age"},
+		{"age over limit", func(p *PatientInfo) { p.Age = maxPatientAge + 1 }, "patient.age"},
+		{"unknown gender", func(p *PatientInfo) { p.Gender = "x" }, "patient.gender"},
+		{"unknown weight unit", func(p *PatientInfo) { p.WeightUnit = "st" }, "patient.weight_unit"},
+		{"unknown height unit", func(p *PatientInfo) { p.HeightUnit = "ft" }, "patient.height_unit"},
+		{"weight too low", func(p *PatientInfo) { p.Weight = 0.1 }, "patient.weight"},
+		{"weight in pounds too high", func(p *PatientInfo) { p.Weight, p.WeightUnit = 1200, "lb" }, "patient.weight"},
+		{"height too high", func(p *PatientInfo) { p.Height = 300 }, "patient.height"},
+		{"allergy without substance", func(p *PatientInfo) { p.Allergies = []Allergy{{Reaction: "rash"}} }, "patient.allergies[0].substance"},
+		{"condition year in the future", func(p *PatientInfo) { p.Conditions = []Condition{{Name: "asma", DiagnosedYear: 3000}} }, "patient.conditions[0].diagnosed_year"},
+		{"surgery year too old", func(p *PatientInfo) { p.Surgeries = []Surgery{{Procedure: "apendicectomia", Year: 1800}} }, "patient.surgeries[0].year"},
+		{"family history without condition", func(p *PatientInfo) { p.FamilyHistory = []FamilyHistoryEntry{{Relative: "mãe"}} }, "patient.family_history[0].condition"},
+		{"too many medications", func(p *PatientInfo) {
+			p.Medications = make([]Medication, maxClinicalEntries+1)
+			for i := range p.Medications {
+				p.Medications[i].Name = "dipirona"
+			}
+		}, "patient.medications"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := validPatient()
+			tt.modify(&info)
+
+			errs := normalizePatientInfo(&info, "patient.")
+			if fields := fieldsOf(errs); len(fields) != 1 || fields[0] != tt.field {
+				t.Fatalf("normalizePatientInfo() fields = %v, want [%s]", fields, tt.field)
+			}
+		})
+	}
+}
+
+func TestDerivePatientMetrics(t *testing.T) {
+	tests := []struct {
+		name     string
+		age      int32
+		weight   float32
+		height   float32
+		bmi      float32
+		category string
+	}{
+		{"underweight adult", 30, 50, 180, 15.4, "underweight"},
+		{"normal adult", 30, 70, 175, 22.9, "normal"},
+		{"overweight adult", 30, 85, 175, 27.8, "overweight"},
+		{"obese adult", 30, 110, 175, 35.9, "obese"},
+		{"child has no category", 10, 35, 140, 17.9, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			derived := derivePatientMetrics(PatientInfo{Age: tt.age, Weight: tt.weight, Height: tt.height})
+			if derived == nil || derived.BMI != tt.bmi || derived.BMICategory != tt.category {
+				t.Fatalf("derivePatientMetrics() = %+v, want BMI %g %q", derived, tt.bmi, tt.category)
+			}
+		})
+	}
+
+	if derived := derivePatientMetrics(PatientInfo{Age: 30, Weight: 70}); derived != nil {
+		t.Errorf("derivePatientMetrics() without height = %+v, want nil", derived)
+	}
+}
@@ -0,0 +1,353 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"unb.br/web-server/src/audit"
+	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/vitals"
+)
+
+const (
+	defaultVitalsListLimit = 100
+	maxVitalsListLimit     = 1000
+	// vitalsSummaryWindow is the default period covered by /api/vitals/summary
+	vitalsSummaryWindow = time.Hour * 24 * 30
+	// diagnosisVitalsWindow is the period of vitals sent to the AI service as context
+	diagnosisVitalsWindow = time.Hour * 24 * 30
+	// diagnosisRecentVitals is the number of individual readings sent to the AI service
+	diagnosisRecentVitals = 5
+)
+
+// VitalSigns represents a set of measurements taken at the same time
+type VitalSigns struct {
+	ID          string    `json:"id,omitempty"`
+	RecordedAt  time.Time `json:"recorded_at"`
+	Systolic    *int32    `json:"systolic,omitempty"`
+	Diastolic   *int32    `json:"diastolic,omitempty"`
+	HeartRate   *int32    `json:"heart_rate,omitempty"`
+	Temperature *float32  `json:"temperature,omitempty"`
+	SpO2        *int32    `json:"spo2,omitempty"`
+	Glucose     *float32  `json:"glucose,omitempty"`
+	Weight      *float32  `json:"weight,omitempty"`
+}
+
+// RecordVitalsRequest represents a vitals record request
+type RecordVitalsRequest struct {
+	Token string `json:"token" binding:"required"`
+	// PatientID selects a managed patient profile; zero means the account's own profile
+	PatientID int32      `json:"patient_id"`
+	Vitals    VitalSigns `json:"vitals" binding:"required"`
+}
+
+// RecordVitalsResponse represents a vitals record response
+type RecordVitalsResponse struct {
+	ID string `json:"id"`
+}
+
+// VitalsListResponse represents a vitals list response
+type VitalsListResponse struct {
+	Vitals []VitalSigns `json:"vitals"`
+}
+
+// VitalsSummaryResponse represents a vitals aggregate response
+type VitalsSummaryResponse struct {
+	From   time.Time      `json:"from"`
+	To     time.Time      `json:"to"`
+	Trends []vitals.Trend `json:"trends"`
+}
+
+// handleRecordVitals handles vitals record requests
+func (s *Server) handleRecordVitals(c *gin.Context) {
+	var req RecordVitalsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.errorLogger.Printf("Invalid record vitals request: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	// Readings without a timestamp were taken just now
+	now := time.Now().UTC()
+	if req.Vitals.RecordedAt.IsZero() {
+		req.Vitals.RecordedAt = now
+	}
+	if req.Vitals.RecordedAt.After(now.Add(time.Minute * 5)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "recorded_at cannot be in the future"})
+		return
+	}
+
+	input := vitalSignsToGrpc(req.Vitals)
+	if err := vitals.Validate(input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	account, resource, ok := s.authorizeVitals(c, req.Token, req.PatientID, auditActionVitalsRecord)
+	if !ok {
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	recordOutput, err := s.dbClient.RecordVitals(ctx, grpc.RecordVitalsInput{
+		Token:     req.Token,
+		Vitals:    input,
+		PatientID: req.PatientID,
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to record vitals: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionVitalsRecord, resource, audit.OutcomeFailure, "")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionVitalsRecord, resource, audit.OutcomeSuccess, "vitals "+recordOutput.ID)
+	c.JSON(http.StatusOK, RecordVitalsResponse{
+		ID: recordOutput.ID,
+	})
+}
+
+// handleListVitals handles vitals list requests, filtered by the optional from/to range
+func (s *Server) handleListVitals(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		s.errorLogger.Printf("Missing token in list vitals request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token is required"})
+		return
+	}
+
+	from, to, ok := parseTimeRange(c)
+	if !ok {
+		return
+	}
+
+	limit := defaultVitalsListLimit
+	if value := c.Query("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 || limit > maxVitalsListLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("limit must be between 1 and %d", maxVitalsListLimit)})
+			return
+		}
+	}
+
+	patientID, ok := parsePatientIDQuery(c)
+	if !ok {
+		return
+	}
+
+	account, resource, ok := s.authorizeVitals(c, token, patientID, auditActionVitalsRead)
+	if !ok {
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	listOutput, err := s.dbClient.ListVitals(ctx, grpc.ListVitalsInput{
+		Token:     token,
+		Since:     from,
+		Until:     to,
+		Limit:     int32(limit),
+		PatientID: patientID,
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to list vitals: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionVitalsRead, resource, audit.OutcomeFailure, "")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionVitalsRead, resource, audit.OutcomeSuccess, "")
+
+	response := VitalsListResponse{
+		Vitals: make([]VitalSigns, len(listOutput.Vitals)),
+	}
+	for i, v := range listOutput.Vitals {
+		response.Vitals[i] = vitalSignsFromGrpc(v)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// handleVitalsSummary handles vitals aggregate requests, covering the last 30 days by default
+func (s *Server) handleVitalsSummary(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		s.errorLogger.Printf("Missing token in vitals summary request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token is required"})
+		return
+	}
+
+	from, to, ok := parseTimeRange(c)
+	if !ok {
+		return
+	}
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	if from.IsZero() {
+		from = to.Add(-vitalsSummaryWindow)
+	}
+
+	patientID, ok := parsePatientIDQuery(c)
+	if !ok {
+		return
+	}
+
+	account, resource, ok := s.authorizeVitals(c, token, patientID, auditActionVitalsRead)
+	if !ok {
+		return
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Call the gRPC service
+	listOutput, err := s.dbClient.ListVitals(ctx, grpc.ListVitalsInput{
+		Token:     token,
+		Since:     from,
+		Until:     to,
+		PatientID: patientID,
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to list vitals for summary: %v", err)
+		s.recordAudit(c, accountActor(account), auditActionVitalsRead, resource, audit.OutcomeFailure, "summary")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return
+	}
+
+	s.recordAudit(c, accountActor(account), auditActionVitalsRead, resource, audit.OutcomeSuccess, "summary")
+	c.JSON(http.StatusOK, VitalsSummaryResponse{
+		From:   from,
+		To:     to,
+		Trends: vitals.Summarize(listOutput.Vitals),
+	})
+}
+
+// diagnosisVitalsContext fetches the patient's recent vitals and trends for the AI prompt.
+// Vitals are optional context, so failures are logged and an empty context is returned.
+func (s *Server) diagnosisVitalsContext(ctx context.Context, token string, patientID int32) ([]grpc.VitalSigns, []grpc.VitalTrend) {
+	listOutput, err := s.dbClient.ListVitals(ctx, grpc.ListVitalsInput{
+		Token:     token,
+		Since:     time.Now().Add(-diagnosisVitalsWindow),
+		PatientID: patientID,
+	})
+	if err != nil {
+		s.errorLogger.Printf("Failed to load vitals for diagnosis: %v", err)
+		return nil, nil
+	}
+
+	recent := listOutput.Vitals
+	if len(recent) > diagnosisRecentVitals {
+		recent = recent[:diagnosisRecentVitals]
+	}
+
+	summary := vitals.Summarize(listOutput.Vitals)
+	trends := make([]grpc.VitalTrend, len(summary))
+	for i, trend := range summary {
+		trends[i] = grpc.VitalTrend{
+			Metric:  trend.Metric,
+			Unit:    trend.Unit,
+			Count:   int32(trend.Count),
+			Latest:  float32(trend.Latest),
+			Average: float32(trend.Average),
+			Min:     float32(trend.Min),
+			Max:     float32(trend.Max),
+			Change:  float32(trend.Change),
+		}
+	}
+
+	return recent, trends
+}
+
+// authorizeVitals resolves the account and audit resource for a vitals request.
+// Managed profiles must be owned by the account; on failure the error response has already been written.
+func (s *Server) authorizeVitals(c *gin.Context, token string, patientID int32, action string) (*grpc.Account, string, bool) {
+	if patientID == 0 {
+		account := s.lookupAccount(c, token)
+		return account, patientResource(account), true
+	}
+
+	account, _, ok := s.authorizePatient(c, token, patientID, action)
+	return account, profileResource(patientID), ok
+}
+
+// parsePatientIDQuery reads the optional patient_id query parameter, answering 400 when it is malformed
+func parsePatientIDQuery(c *gin.Context) (int32, bool) {
+	value := c.Query("patient_id")
+	if value == "" {
+		return 0, true
+	}
+	id, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid patient ID"})
+		return 0, false
+	}
+	return int32(id), true
+}
+
+// parseTimeRange reads the optional from/to RFC 3339 query parameters, answering 400 when they are malformed
+func parseTimeRange(c *gin.Context) (time.Time, time.Time, bool) {
+	var from, to time.Time
+	var err error
+
+	if value := c.Query("from"); value != "" {
+		if from, err = time.Parse(time.RFC3339, value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "from must be an RFC 3339 timestamp"})
+			return from, to, false
+		}
+	}
+	if value := c.Query("to"); value != "" {
+		if to, err = time.Parse(time.RFC3339, value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "to must be an RFC 3339 timestamp"})
+			return from, to, false
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "to must not be before from"})
+		return from, to, false
+	}
+
+	return from, to, true
+}
+
+// vitalSignsToGrpc converts vital signs to the gRPC client format
+func vitalSignsToGrpc(v VitalSigns) grpc.VitalSigns {
+	return grpc.VitalSigns{
+		ID:          v.ID,
+		RecordedAt:  v.RecordedAt,
+		Systolic:    v.Systolic,
+		Diastolic:   v.Diastolic,
+		HeartRate:   v.HeartRate,
+		Temperature: v.Temperature,
+		SpO2:        v.SpO2,
+		Glucose:     v.Glucose,
+		Weight:      v.Weight,
+	}
+}
+
+// vitalSignsFromGrpc converts vital signs from the gRPC client format
+func vitalSignsFromGrpc(v grpc.VitalSigns) VitalSigns {
+	return VitalSigns{
+		ID:          v.ID,
+		RecordedAt:  v.RecordedAt.UTC(),
+		Systolic:    v.Systolic,
+		Diastolic:   v.Diastolic,
+		HeartRate:   v.HeartRate,
+		Temperature: v.Temperature,
+		SpO2:        v.SpO2,
+		Glucose:     v.Glucose,
+		Weight:      v.Weight,
+	}
+}
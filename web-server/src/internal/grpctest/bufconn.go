@@ -0,0 +1,49 @@
+// Package grpctest serves gRPC services in memory, for tests
+package grpctest
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// bufconnSize is the buffer of the in-memory connections
+const bufconnSize = 1024 * 1024
+
+// Listener serves a gRPC server in memory
+type Listener struct {
+	name     string
+	listener *bufconn.Listener
+	server   *grpc.Server
+}
+
+// Serve starts a server in memory with the services register adds to it. The name only shows in Addr.
+func Serve(name string, register func(*grpc.Server)) *Listener {
+	l := &Listener{
+		name:     name,
+		listener: bufconn.Listen(bufconnSize),
+		server:   grpc.NewServer(),
+	}
+	register(l.server)
+	go l.server.Serve(l.listener)
+	return l
+}
+
+// Addr is the address to connect to, which only resolves with DialOption
+func (l *Listener) Addr() string {
+	return "passthrough:///" + l.name
+}
+
+// DialOption connects clients to the server in memory
+func (l *Listener) DialOption() grpc.DialOption {
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return l.listener.DialContext(ctx)
+	})
+}
+
+// Close stops the server, failing the calls and ending the streams in progress
+func (l *Listener) Close() {
+	l.server.Stop()
+}
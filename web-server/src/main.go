@@ -5,9 +5,13 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
+	"unb.br/web-server/src/grpc"
 	"unb.br/web-server/src/http"
 )
 
@@ -21,16 +25,151 @@ func main() {
 	// Get server addresses from environment variables with defaults
 	aiServerAddr := getEnv("AI_SERVER_ADDR", "localhost:50051")
 	dbServerAddr := getEnv("DB_SERVER_ADDR", "localhost:50052")
+	dbServiceToken := getEnv("DB_SERVICE_TOKEN", "")
+	aiBackends, err := grpc.ParseBackends(getEnv("AI_BACKENDS", ""))
+	if err != nil {
+		log.Fatalf("Invalid AI_BACKENDS: %v", err)
+	}
+	aiFailoverWindow, err := time.ParseDuration(getEnv("AI_FAILOVER_WINDOW", "0s"))
+	if err != nil {
+		log.Fatalf("Invalid AI_FAILOVER_WINDOW: %v", err)
+	}
+	aiMode := getEnv("AI_MODE", grpc.AiModeLive)
+	aiCassetteDir := getEnv("AI_CASSETTE_DIR", "cassettes")
+	aiReplayTiming := getEnv("AI_REPLAY_TIMING", "original")
+	if aiReplayTiming != "original" && aiReplayTiming != "instant" {
+		log.Fatalf("Invalid AI_REPLAY_TIMING: must be original or instant, got %q", aiReplayTiming)
+	}
 	httpServerAddr := getEnv("HTTP_SERVER_ADDR", ":8080")
+	allowedOrigins := getEnv("ALLOWED_ORIGINS", "")
+	auditSinks := getEnv("AUDIT_SINKS", "file")
+	auditFile := getEnv("AUDIT_FILE", "audit.log")
+	auditKey := getEnv("AUDIT_KEY", "")
+	encryptionKeyfile := getEnv("ENCRYPTION_KEYFILE", "")
+	triageRules := getEnv("TRIAGE_RULES", "")
+	screeningRules := getEnv("SCREENING_RULES", "")
+	outputFilterRules := getEnv("OUTPUT_FILTER_RULES", "")
+	diagnosisCacheTTL, err := time.ParseDuration(getEnv("DIAGNOSIS_CACHE_TTL", "0s"))
+	if err != nil {
+		log.Fatalf("Invalid DIAGNOSIS_CACHE_TTL: %v", err)
+	}
+	diagnosisCacheMaxBytes, err := strconv.Atoi(getEnv("DIAGNOSIS_CACHE_MAX_BYTES", "67108864"))
+	if err != nil {
+		log.Fatalf("Invalid DIAGNOSIS_CACHE_MAX_BYTES: %v", err)
+	}
+	screeningModeration, err := strconv.ParseBool(getEnv("SCREENING_MODERATION", "false"))
+	if err != nil {
+		log.Fatalf("Invalid SCREENING_MODERATION: %v", err)
+	}
+	chatStreamRetention, err := time.ParseDuration(getEnv("CHAT_STREAM_RETENTION", "2m"))
+	if err != nil {
+		log.Fatalf("Invalid CHAT_STREAM_RETENTION: %v", err)
+	}
+	contextTokenBudget, err := strconv.Atoi(getEnv("CONTEXT_TOKEN_BUDGET", "8000"))
+	if err != nil {
+		log.Fatalf("Invalid CONTEXT_TOKEN_BUDGET: %v", err)
+	}
+	chatMaxMessages, err := strconv.Atoi(getEnv("CHAT_MAX_MESSAGES", "100"))
+	if err != nil {
+		log.Fatalf("Invalid CHAT_MAX_MESSAGES: %v", err)
+	}
+	chatMaxMessageBytes, err := strconv.Atoi(getEnv("CHAT_MAX_MESSAGE_BYTES", "16384"))
+	if err != nil {
+		log.Fatalf("Invalid CHAT_MAX_MESSAGE_BYTES: %v", err)
+	}
+	chatMaxPayloadBytes, err := strconv.ParseInt(getEnv("CHAT_MAX_PAYLOAD_BYTES", "524288"), 10, 64)
+	if err != nil {
+		log.Fatalf("Invalid CHAT_MAX_PAYLOAD_BYTES: %v", err)
+	}
+	replySigningKey := getEnv("REPLY_SIGNING_KEY", "")
+	modelAllowlist, err := http.ParseModelAllowlist(getEnv("MODEL_ALLOWLIST", ""))
+	if err != nil {
+		log.Fatalf("Invalid MODEL_ALLOWLIST: %v", err)
+	}
+	maxOutputTokens, err := strconv.Atoi(getEnv("MAX_OUTPUT_TOKENS", "4096"))
+	if err != nil {
+		log.Fatalf("Invalid MAX_OUTPUT_TOKENS: %v", err)
+	}
+	usagePrices, err := http.ParseUsagePrices(getEnv("USAGE_PRICES", ""))
+	if err != nil {
+		log.Fatalf("Invalid USAGE_PRICES: %v", err)
+	}
+	monthlyTokenQuota, err := strconv.ParseInt(getEnv("MONTHLY_TOKEN_QUOTA", "0"), 10, 64)
+	if err != nil {
+		log.Fatalf("Invalid MONTHLY_TOKEN_QUOTA: %v", err)
+	}
+	monthlyCostQuota, err := strconv.ParseFloat(getEnv("MONTHLY_COST_QUOTA", "0"), 64)
+	if err != nil {
+		log.Fatalf("Invalid MONTHLY_COST_QUOTA: %v", err)
+	}
+	quotaFailOpen, err := strconv.ParseBool(getEnv("QUOTA_FAIL_OPEN", "true"))
+	if err != nil {
+		log.Fatalf("Invalid QUOTA_FAIL_OPEN: %v", err)
+	}
 
 	// Print startup message
 	fmt.Println("=== Medical Diagnosis Web Server ===")
-	fmt.Printf("AI Server: %s\n", aiServerAddr)
+	if len(aiBackends) > 0 {
+		for _, backend := range aiBackends {
+			fmt.Printf("AI Backend: %s (%s) at %s\n", backend.Name, backend.Role, backend.Addr)
+		}
+	} else {
+		fmt.Printf("AI Server: %s\n", aiServerAddr)
+	}
+	if aiMode != grpc.AiModeLive {
+		fmt.Printf("AI Mode: %s (%s)\n", aiMode, aiCassetteDir)
+	}
 	fmt.Printf("Database Server: %s\n", dbServerAddr)
 	fmt.Printf("HTTP Server: %s\n", httpServerAddr)
+	fmt.Printf("Audit Sinks: %s\n", auditSinks)
+	fmt.Printf("Audit Head Authenticated: %t\n", auditKey != "")
+	fmt.Printf("Field Encryption: %t\n", encryptionKeyfile != "")
+	fmt.Printf("Reply Signing: %t\n", replySigningKey != "")
+	if triageRules != "" {
+		fmt.Printf("Triage Rules: %s\n", triageRules)
+	}
+	if screeningRules != "" {
+		fmt.Printf("Screening Rules: %s\n", screeningRules)
+	}
+	fmt.Printf("Screening Moderation: %t\n", screeningModeration)
+	if outputFilterRules != "" {
+		fmt.Printf("Output Filter Rules: %s\n", outputFilterRules)
+	}
 
 	// Create HTTP server
-	server := http.NewServer(aiServerAddr, dbServerAddr)
+	server := http.NewServer(http.Config{
+		AiServerAddr:           aiServerAddr,
+		DbServerAddr:           dbServerAddr,
+		DbServiceToken:         dbServiceToken,
+		AllowedOrigins:         splitList(allowedOrigins),
+		AiBackends:             aiBackends,
+		AiFailoverWindow:       aiFailoverWindow,
+		AiMode:                 aiMode,
+		AiCassetteDir:          aiCassetteDir,
+		AiReplayRealTime:       aiReplayTiming == "original",
+		AuditSinks:             splitList(auditSinks),
+		AuditFile:              auditFile,
+		AuditKey:               auditKey,
+		EncryptionKeyfile:      encryptionKeyfile,
+		TriageRules:            triageRules,
+		ScreeningRules:         screeningRules,
+		ScreeningModeration:    screeningModeration,
+		OutputFilterRules:      outputFilterRules,
+		DiagnosisCacheTTL:      diagnosisCacheTTL,
+		DiagnosisCacheMaxBytes: diagnosisCacheMaxBytes,
+		ChatStreamRetention:    chatStreamRetention,
+		ContextTokenBudget:     contextTokenBudget,
+		ChatMaxMessages:        chatMaxMessages,
+		ChatMaxMessageBytes:    chatMaxMessageBytes,
+		ChatMaxPayloadBytes:    chatMaxPayloadBytes,
+		ReplySigningKey:        replySigningKey,
+		ModelAllowlist:         modelAllowlist,
+		MaxOutputTokens:        maxOutputTokens,
+		UsagePrices:            usagePrices,
+		MonthlyTokenQuota:      monthlyTokenQuota,
+		MonthlyCostQuota:       monthlyCostQuota,
+		QuotaFailOpen:          quotaFailOpen,
+	})
 
 	// Setup graceful shutdown
 	quit := make(chan os.Signal, 1)
@@ -60,3 +199,14 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+// splitList splits a comma-separated environment value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,21 @@
+package mockai
+
+import (
+	"google.golang.org/grpc"
+	"unb.br/web-server/src/internal/grpctest"
+	pb "unb.br/web-server/src/proto"
+)
+
+// Listener serves a mock AI server in memory, for tests
+type Listener = grpctest.Listener
+
+// Serve starts serving s in memory. Clients connect to Addr with DialOption, for example:
+//
+//	listener := mockai.Serve(mockai.NewServer(mockai.DefaultScript()))
+//	defer listener.Close()
+//	client, err := grpc.NewAiClient(listener.Addr(), listener.DialOption())
+func Serve(s *Server) *Listener {
+	return grpctest.Serve("mock-ai", func(server *grpc.Server) {
+		pb.RegisterAiServiceServer(server, s)
+	})
+}
@@ -0,0 +1,424 @@
+// Package mockai is a scripted AI server, to run the web server and its tests without the Python AI server.
+//
+// Replies follow a Script: a canned markdown reply or an echo of the last message, split in chunks sent with a delay,
+// optionally failing or hanging mid-stream. A message can override the script for its own reply with a directive
+// such as "[mock mode=echo,chunk=4,fail-after=3]".
+package mockai
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	pb "unb.br/web-server/src/proto"
+)
+
+// Reply modes
+const (
+	// ModeCanned replies with the script's markdown
+	ModeCanned = "canned"
+	// ModeEcho replies with the last message, without its directive
+	ModeEcho = "echo"
+)
+
+// defaultReply is the canned markdown used when the script has none
+//
+//go:embed default-reply.md
+var defaultReply string
+
+// directivePattern finds the script overrides within a message
+var directivePattern = regexp.MustCompile(`\[mock\s+([^\]]*)\]`)
+
+// Script describes how the mock server replies
+type Script struct {
+	Mode string
+	// Reply is the markdown of canned replies
+	Reply string
+	// ChunkSize is how many characters each content event carries
+	ChunkSize int
+	// Delay is waited before each content event
+	Delay time.Duration
+	// FailAfter is how many content events are sent before the reply fails; negative never fails
+	FailAfter int
+	// HangAfter is how many content events are sent before the reply hangs until cancelled; negative never hangs
+	HangAfter int
+	// Summary tells whether the structured summary is sent after the content
+	Summary bool
+	// Model is reported in the metadata when the request does not choose one
+	Model string
+	// Moderation is the outcome of every moderation check: "allow", "warn" or "block"
+	Moderation string
+}
+
+// DefaultScript replies with the canned markdown at once, without failures
+func DefaultScript() Script {
+	return Script{
+		Mode:       ModeCanned,
+		Reply:      defaultReply,
+		ChunkSize:  16,
+		FailAfter:  -1,
+		HangAfter:  -1,
+		Summary:    true,
+		Model:      "mock",
+		Moderation: "allow",
+	}
+}
+
+// ParseScript applies overrides to a script, as "key=value,key=value".
+// Keys are mode, chunk, delay, fail-after, hang-after, summary, model and moderation.
+func ParseScript(script Script, value string) (Script, error) {
+	for _, entry := range strings.Split(value, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(entry, "=")
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		if !ok {
+			return script, fmt.Errorf("invalid mock script entry %q", entry)
+		}
+
+		var err error
+		switch key {
+		case "mode":
+			if val != ModeCanned && val != ModeEcho {
+				return script, fmt.Errorf("mock mode must be %s or %s, got %q", ModeCanned, ModeEcho, val)
+			}
+			script.Mode = val
+		case "chunk":
+			script.ChunkSize, err = strconv.Atoi(val)
+			if err == nil && script.ChunkSize <= 0 {
+				err = fmt.Errorf("must be positive")
+			}
+		case "delay":
+			script.Delay, err = time.ParseDuration(val)
+		case "fail-after":
+			script.FailAfter, err = strconv.Atoi(val)
+		case "hang-after":
+			script.HangAfter, err = strconv.Atoi(val)
+		case "summary":
+			script.Summary, err = strconv.ParseBool(val)
+		case "model":
+			script.Model = val
+		case "moderation":
+			if val != "allow" && val != "warn" && val != "block" {
+				err = fmt.Errorf("must be allow, warn or block")
+			}
+			script.Moderation = val
+		default:
+			return script, fmt.Errorf("unknown mock script key %q", key)
+		}
+		if err != nil {
+			return script, fmt.Errorf("invalid mock script %s %q: %v", key, val, err)
+		}
+	}
+	return script, nil
+}
+
+// Server implements the AI service following a script
+type Server struct {
+	pb.UnimplementedAiServiceServer
+
+	mu     sync.Mutex
+	script Script
+	// requests holds the diagnoses asked so far, chat turns included
+	requests []*pb.DiagnoseRequest
+}
+
+// NewServer creates a mock AI server following script
+func NewServer(script Script) *Server {
+	return &Server{script: script}
+}
+
+// SetScript changes the script of the next replies
+func (s *Server) SetScript(script Script) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.script = script
+}
+
+// Requests returns the diagnoses asked so far, chat turns included, in order
+func (s *Server) Requests() []*pb.DiagnoseRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requests := make([]*pb.DiagnoseRequest, len(s.requests))
+	for i, req := range s.requests {
+		requests[i] = proto.Clone(req).(*pb.DiagnoseRequest)
+	}
+	return requests
+}
+
+// scriptFor records a request and returns the script of its reply, with the overrides of its last message
+func (s *Server) scriptFor(req *pb.DiagnoseRequest) (Script, string, error) {
+	s.mu.Lock()
+	s.requests = append(s.requests, proto.Clone(req).(*pb.DiagnoseRequest))
+	script := s.script
+	s.mu.Unlock()
+
+	last := ""
+	if messages := req.GetMessages(); len(messages) > 0 {
+		last = messages[len(messages)-1].GetContent()
+	}
+	return applyDirectives(script, last)
+}
+
+// applyDirectives applies the directives of a text to a script and returns the text without them
+func applyDirectives(script Script, text string) (Script, string, error) {
+	for _, match := range directivePattern.FindAllStringSubmatch(text, -1) {
+		var err error
+		if script, err = ParseScript(script, match[1]); err != nil {
+			return script, text, err
+		}
+	}
+	return script, strings.TrimSpace(directivePattern.ReplaceAllString(text, "")), nil
+}
+
+// Diagnose streams a scripted diagnosis
+func (s *Server) Diagnose(req *pb.DiagnoseRequest, stream pb.AiService_DiagnoseServer) error {
+	return s.reply(stream.Context(), req, stream.Send)
+}
+
+// reply sends the events of a scripted diagnosis: the metadata, the content chunks, the summary and the usage
+func (s *Server) reply(ctx context.Context, req *pb.DiagnoseRequest, send func(*pb.DiagnoseResponse) error) error {
+	script, last, err := s.scriptFor(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	model := req.GetModel()
+	if model == "" {
+		model = script.Model
+	}
+	if err := send(&pb.DiagnoseResponse{Event: &pb.DiagnoseResponse_Metadata{Metadata: &pb.DiagnoseMetadata{Model: model}}}); err != nil {
+		return err
+	}
+
+	text := script.Reply
+	if script.Mode == ModeEcho {
+		text = last
+	}
+	for i, chunk := range chunks(text, script.ChunkSize) {
+		if i == script.FailAfter {
+			return status.Errorf(codes.Internal, "mock failure after %d chunks", i)
+		}
+		if i == script.HangAfter {
+			<-ctx.Done()
+			return status.FromContextError(ctx.Err()).Err()
+		}
+
+		select {
+		case <-time.After(script.Delay):
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		if err := send(&pb.DiagnoseResponse{Event: &pb.DiagnoseResponse_Content{Content: chunk}}); err != nil {
+			return err
+		}
+	}
+
+	// Failures and hangs scripted past the last chunk happen before the end of the stream
+	if script.FailAfter >= 0 {
+		return status.Errorf(codes.Internal, "mock failure at the end of the reply")
+	}
+	if script.HangAfter >= 0 {
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	if script.Summary {
+		if err := send(&pb.DiagnoseResponse{Event: &pb.DiagnoseResponse_Summary{Summary: cannedSummary()}}); err != nil {
+			return err
+		}
+	}
+	return send(&pb.DiagnoseResponse{Event: &pb.DiagnoseResponse_Usage{Usage: &pb.TokenUsage{
+		PromptTokens:     promptTokens(req),
+		CompletionTokens: int32(len(strings.Fields(text))),
+	}}})
+}
+
+// chunks splits a text in chunks of size characters
+func chunks(text string, size int) []string {
+	if size <= 0 {
+		size = 1
+	}
+	runes := []rune(text)
+	var out []string
+	for len(runes) > 0 {
+		n := min(size, len(runes))
+		out = append(out, string(runes[:n]))
+		runes = runes[n:]
+	}
+	return out
+}
+
+// promptTokens roughly counts the tokens of a request, one per word
+func promptTokens(req *pb.DiagnoseRequest) int32 {
+	count := len(strings.Fields(req.GetConversationSummary()))
+	for _, message := range req.GetMessages() {
+		count += len(strings.Fields(message.GetContent()))
+	}
+	return int32(count)
+}
+
+// cannedSummary is the structured summary sent after every reply
+func cannedSummary() *pb.StructuredDiagnosis {
+	return &pb.StructuredDiagnosis{
+		Differentials: []*pb.DifferentialDiagnosis{
+			{Condition: "Resfriado comum", Likelihood: 0.6, Rationale: "Resposta simulada"},
+			{Condition: "Rinite alérgica", Likelihood: 0.3, Rationale: "Resposta simulada"},
+		},
+		TriageLevel: "routine",
+		Specialties: []string{"clínica geral"},
+		Disclaimers: []string{"Resposta simulada pelo servidor de IA de desenvolvimento"},
+		NextSteps:   []string{"Repouso e hidratação"},
+	}
+}
+
+// Chat answers the turns of a chat session one at a time, stopping a turn when asked
+func (s *Server) Chat(stream pb.AiService_ChatServer) error {
+	// The turns are waited for once cancelled
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// Read the requests apart, so a failing turn can end the session while waiting for them
+	requests := make(chan *pb.ChatSessionRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var sendMu sync.Mutex
+	send := func(resp *pb.ChatSessionResponse) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return stream.Send(resp)
+	}
+
+	turnErr := make(chan error, 1)
+	stops := map[string]context.CancelFunc{}
+
+	for {
+		select {
+		case err := <-recvErr:
+			if err == io.EOF {
+				// The client closed the session, the turns in progress still end
+				wg.Wait()
+				return nil
+			}
+			return err
+		case err := <-turnErr:
+			return err
+		case req := <-requests:
+			switch action := req.GetAction().(type) {
+			case *pb.ChatSessionRequest_Stop:
+				if stop, ok := stops[req.GetTurnId()]; ok {
+					stop()
+				}
+			case *pb.ChatSessionRequest_Turn:
+				turnCtx, stop := context.WithCancel(ctx)
+				stops[req.GetTurnId()] = stop
+				wg.Add(1)
+				go func(turnID string, turn *pb.DiagnoseRequest) {
+					defer wg.Done()
+					defer stop()
+					if err := s.chatTurn(turnCtx, ctx, turnID, turn, send); err != nil {
+						select {
+						case turnErr <- err:
+						default:
+						}
+					}
+				}(req.GetTurnId(), action.Turn)
+			}
+		}
+	}
+}
+
+// chatTurn answers one turn, ending it as stopped when turnCtx is cancelled while the session goes on
+func (s *Server) chatTurn(turnCtx, sessionCtx context.Context, turnID string, turn *pb.DiagnoseRequest, send func(*pb.ChatSessionResponse) error) error {
+	err := s.reply(turnCtx, turn, func(resp *pb.DiagnoseResponse) error {
+		event := &pb.ChatSessionResponse{TurnId: turnID}
+		switch e := resp.GetEvent().(type) {
+		case *pb.DiagnoseResponse_Content:
+			event.Event = &pb.ChatSessionResponse_Content{Content: e.Content}
+		case *pb.DiagnoseResponse_Summary:
+			event.Event = &pb.ChatSessionResponse_Summary{Summary: e.Summary}
+		case *pb.DiagnoseResponse_Metadata:
+			event.Event = &pb.ChatSessionResponse_Metadata{Metadata: e.Metadata}
+		case *pb.DiagnoseResponse_Usage:
+			event.Event = &pb.ChatSessionResponse_Usage{Usage: e.Usage}
+		}
+		return send(event)
+	})
+
+	stopped := err != nil && turnCtx.Err() != nil && sessionCtx.Err() == nil
+	if err != nil && !stopped {
+		return err
+	}
+	return send(&pb.ChatSessionResponse{TurnId: turnID, Event: &pb.ChatSessionResponse_End{End: &pb.TurnEnd{Stopped: stopped}}})
+}
+
+// Summarize folds the messages into the previous summary, one line per message
+func (s *Server) Summarize(ctx context.Context, req *pb.SummarizeRequest) (*pb.SummarizeResponse, error) {
+	s.mu.Lock()
+	model := s.script.Model
+	s.mu.Unlock()
+
+	var summary strings.Builder
+	summary.WriteString(req.GetPreviousSummary())
+	prompt := len(strings.Fields(req.GetPreviousSummary()))
+	for _, message := range req.GetMessages() {
+		prompt += len(strings.Fields(message.GetContent()))
+		if summary.Len() > 0 {
+			summary.WriteString("\n")
+		}
+		content := []rune(message.GetContent())
+		if len(content) > 80 {
+			content = append(content[:80], '…')
+		}
+		fmt.Fprintf(&summary, "- %s: %s", message.GetRole(), string(content))
+	}
+	return &pb.SummarizeResponse{
+		Summary: summary.String(),
+		Usage:   &pb.TokenUsage{PromptTokens: int32(prompt), CompletionTokens: int32(len(strings.Fields(summary.String())))},
+		Model:   model,
+	}, nil
+}
+
+// Moderate returns the scripted outcome, which the text can override with a directive
+func (s *Server) Moderate(ctx context.Context, req *pb.ModerateRequest) (*pb.ModerateResponse, error) {
+	s.mu.Lock()
+	script := s.script
+	s.mu.Unlock()
+
+	script, _, err := applyDirectives(script, req.GetText())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &pb.ModerateResponse{Outcome: script.Moderation}
+	if script.Moderation != "allow" {
+		resp.Categories = []string{"mock"}
+	}
+	return resp, nil
+}
@@ -0,0 +1,173 @@
+package mockai
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	pb "unb.br/web-server/src/proto"
+)
+
+// dial serves a mock AI server following script in memory and connects a client to it
+func dial(t *testing.T, script Script) pb.AiServiceClient {
+	t.Helper()
+
+	listener := Serve(NewServer(script))
+	t.Cleanup(listener.Close)
+	conn, err := grpc.NewClient(listener.Addr(), listener.DialOption(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to connect to the mock AI server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewAiServiceClient(conn)
+}
+
+// diagnosis is what a diagnosis stream sent before it ended
+type diagnosis struct {
+	model   string
+	chunks  []string
+	summary bool
+	usage   *pb.TokenUsage
+	err     error
+}
+
+// diagnose asks a diagnosis of a single message and reads its stream until it ends
+func diagnose(ctx context.Context, t *testing.T, client pb.AiServiceClient, message string) diagnosis {
+	t.Helper()
+
+	stream, err := client.Diagnose(ctx, &pb.DiagnoseRequest{Messages: []*pb.Message{{Role: "user", Content: message}}})
+	if err != nil {
+		t.Fatalf("failed to start the diagnosis: %v", err)
+	}
+
+	var got diagnosis
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				got.err = err
+			}
+			return got
+		}
+		switch event := resp.GetEvent().(type) {
+		case *pb.DiagnoseResponse_Metadata:
+			got.model = event.Metadata.GetModel()
+		case *pb.DiagnoseResponse_Content:
+			got.chunks = append(got.chunks, event.Content)
+		case *pb.DiagnoseResponse_Summary:
+			got.summary = true
+		case *pb.DiagnoseResponse_Usage:
+			got.usage = event.Usage
+		}
+	}
+}
+
+func TestDiagnoseCanned(t *testing.T) {
+	client := dial(t, DefaultScript())
+
+	got := diagnose(context.Background(), t, client, "Estou com dor de cabeça")
+	if got.err != nil {
+		t.Fatalf("diagnosis failed: %v", got.err)
+	}
+	if got.model != "mock" {
+		t.Fatalf("got model %q, want mock", got.model)
+	}
+	if content := strings.Join(got.chunks, ""); content != defaultReply {
+		t.Fatalf("got content %q, want the canned reply", content)
+	}
+	if !got.summary || got.usage == nil || got.usage.GetCompletionTokens() == 0 {
+		t.Fatalf("got summary %t and usage %v, want both", got.summary, got.usage)
+	}
+}
+
+func TestDiagnoseEcho(t *testing.T) {
+	client := dial(t, DefaultScript())
+
+	got := diagnose(context.Background(), t, client, "[mock mode=echo,summary=false] tenho febre")
+	if got.err != nil {
+		t.Fatalf("diagnosis failed: %v", got.err)
+	}
+	if content := strings.Join(got.chunks, ""); content != "tenho febre" {
+		t.Fatalf("got content %q, want the message without its directive", content)
+	}
+	if got.summary {
+		t.Fatal("got a summary the script turned off")
+	}
+}
+
+func TestDiagnoseChunkSize(t *testing.T) {
+	client := dial(t, DefaultScript())
+
+	got := diagnose(context.Background(), t, client, "[mock mode=echo,chunk=4] abcdefghij")
+	if chunks := strings.Join(got.chunks, "|"); chunks != "abcd|efgh|ij" {
+		t.Fatalf("got chunks %s, want abcd|efgh|ij", chunks)
+	}
+}
+
+func TestDiagnoseDelay(t *testing.T) {
+	client := dial(t, DefaultScript())
+
+	start := time.Now()
+	got := diagnose(context.Background(), t, client, "[mock mode=echo,chunk=2,delay=20ms] abcdef")
+	if got.err != nil {
+		t.Fatalf("diagnosis failed: %v", got.err)
+	}
+	// Each of the 3 chunks waits for the delay
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Fatalf("diagnosis took %s, want at least 60ms", elapsed)
+	}
+}
+
+func TestDiagnoseFailAfter(t *testing.T) {
+	client := dial(t, DefaultScript())
+
+	tests := []struct {
+		name    string
+		message string
+		chunks  int
+	}{
+		{"before any chunk", "[mock mode=echo,chunk=2,fail-after=0] abcdef", 0},
+		{"mid-stream", "[mock mode=echo,chunk=2,fail-after=2] abcdef", 2},
+		{"past the last chunk", "[mock mode=echo,chunk=2,fail-after=5] abcdef", 3},
+	}
+
+	for _, tt := range tests {
+		got := diagnose(context.Background(), t, client, tt.message)
+		if status.Code(got.err) != codes.Internal {
+			t.Errorf("%s: got error %v, want Internal", tt.name, got.err)
+		}
+		if len(got.chunks) != tt.chunks || got.summary || got.usage != nil {
+			t.Errorf("%s: got %d chunks, summary %t and usage %v, want %d chunks only", tt.name, len(got.chunks), got.summary, got.usage, tt.chunks)
+		}
+	}
+}
+
+func TestDiagnoseHangAfter(t *testing.T) {
+	client := dial(t, DefaultScript())
+
+	// The reply hangs after its first chunk until the deadline cancels it
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	got := diagnose(ctx, t, client, "[mock mode=echo,chunk=2,hang-after=1] abcdef")
+	if status.Code(got.err) != codes.DeadlineExceeded {
+		t.Fatalf("got error %v, want DeadlineExceeded", got.err)
+	}
+	if chunks := strings.Join(got.chunks, "|"); chunks != "ab" || got.usage != nil {
+		t.Fatalf("got chunks %s and usage %v, want the first chunk only", chunks, got.usage)
+	}
+}
+
+func TestDiagnoseInvalidDirective(t *testing.T) {
+	client := dial(t, DefaultScript())
+
+	got := diagnose(context.Background(), t, client, "[mock chunk=0] olá")
+	if status.Code(got.err) != codes.InvalidArgument {
+		t.Fatalf("got error %v, want InvalidArgument", got.err)
+	}
+}
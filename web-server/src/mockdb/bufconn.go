@@ -0,0 +1,21 @@
+package mockdb
+
+import (
+	"google.golang.org/grpc"
+	"unb.br/web-server/src/internal/grpctest"
+	pb "unb.br/web-server/src/proto"
+)
+
+// Listener serves a mock database server in memory, for tests
+type Listener = grpctest.Listener
+
+// Serve starts serving s in memory. Clients connect to Addr with DialOption, for example:
+//
+//	listener := mockdb.Serve(mockdb.NewServer())
+//	defer listener.Close()
+//	client, err := grpc.NewDatabaseClient(listener.Addr(), listener.DialOption())
+func Serve(s *Server) *Listener {
+	return grpctest.Serve("mock-db", func(server *grpc.Server) {
+		pb.RegisterDatabaseServiceServer(server, s)
+	})
+}
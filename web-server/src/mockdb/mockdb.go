@@ -0,0 +1,473 @@
+// Package mockdb is an in-memory database service, to run the web server's tests without the database server.
+//
+// It keeps accounts with their tokens, patient profiles, conversations, vitals and token usage,
+// and fails the way the web server expects: Unauthenticated for unknown tokens, NotFound for missing records,
+// AlreadyExists for taken usernames and FailedPrecondition for version conflicts.
+// The other RPCs, such as the audit ones, are unimplemented.
+package mockdb
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	pb "unb.br/web-server/src/proto"
+)
+
+// RoleUser is the role of registered accounts
+const RoleUser = "user"
+
+// Server implements the database service in memory
+type Server struct {
+	pb.UnimplementedDatabaseServiceServer
+
+	mu            sync.Mutex
+	users         map[string]*user
+	tokens        map[string]*user
+	nextID        int32
+	patients      map[int32]*pb.PatientProfile
+	nextPatientID int32
+	// conversations holds the saved messages of each conversation, in order
+	conversations map[string][]*pb.ChatMessage
+	summaries     map[string]*pb.ConversationSummary // keyed by summaryKey
+	flags         []*pb.FlagConversationRequest
+	vitals        []storedVitals
+	usage         []*pb.UsageRecord
+}
+
+// user is an account with its own patient profile
+type user struct {
+	account  *pb.Account
+	password string
+	// patient is the account's own profile, nil until saved
+	patient *pb.PatientInfo
+	version int64
+}
+
+// storedVitals is a vitals reading of a patient
+type storedVitals struct {
+	ownerID   int32
+	patientID int32
+	vitals    *pb.VitalSigns
+}
+
+// NewServer creates an empty database
+func NewServer() *Server {
+	return &Server{
+		users:         map[string]*user{},
+		tokens:        map[string]*user{},
+		patients:      map[int32]*pb.PatientProfile{},
+		conversations: map[string][]*pb.ChatMessage{},
+		summaries:     map[string]*pb.ConversationSummary{},
+	}
+}
+
+// AddUser creates an account with a role and returns a token for it
+func (s *Server) AddUser(username, password, role string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.addUser(username, password, role)
+	if err != nil {
+		return "", err
+	}
+	return s.newToken(u), nil
+}
+
+// Conversation returns the messages saved in a conversation, in order
+func (s *Server) Conversation(conversationID string) []*pb.ChatMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := make([]*pb.ChatMessage, len(s.conversations[conversationID]))
+	for i, message := range s.conversations[conversationID] {
+		messages[i] = proto.Clone(message).(*pb.ChatMessage)
+	}
+	return messages
+}
+
+// Flags returns the conversations flagged so far, in order
+func (s *Server) Flags() []*pb.FlagConversationRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	flags := make([]*pb.FlagConversationRequest, len(s.flags))
+	for i, flag := range s.flags {
+		flags[i] = proto.Clone(flag).(*pb.FlagConversationRequest)
+	}
+	return flags
+}
+
+// addUser creates an account; the lock must be held
+func (s *Server) addUser(username, password, role string) (*user, error) {
+	if username == "" || password == "" {
+		return nil, status.Error(codes.InvalidArgument, "username and password are required")
+	}
+	if _, ok := s.users[username]; ok {
+		return nil, status.Error(codes.AlreadyExists, "user already exists")
+	}
+
+	s.nextID++
+	u := &user{
+		account:  &pb.Account{Id: s.nextID, Username: username, Role: role},
+		password: password,
+	}
+	s.users[username] = u
+	return u, nil
+}
+
+// newToken issues a token for an account; the lock must be held
+func (s *Server) newToken(u *user) string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	token := hex.EncodeToString(b)
+	s.tokens[token] = u
+	return token
+}
+
+// authenticate returns the account of a token; the lock must be held
+func (s *Server) authenticate(token string) (*user, error) {
+	u, ok := s.tokens[token]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return u, nil
+}
+
+// ownedPatient returns a managed profile of an account; the lock must be held
+func (s *Server) ownedPatient(u *user, patientID int32) (*pb.PatientProfile, error) {
+	profile, ok := s.patients[patientID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "patient not found")
+	}
+	if profile.OwnerId != u.account.Id {
+		return nil, status.Error(codes.PermissionDenied, "patient belongs to another account")
+	}
+	return profile, nil
+}
+
+// Login returns a new token for valid credentials
+func (s *Server) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[req.GetUsername()]
+	if !ok || u.password != req.GetPassword() {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	return &pb.LoginResponse{Token: s.newToken(u)}, nil
+}
+
+// Register creates an account with the user role and returns a token for it
+func (s *Server) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.addUser(req.GetUsername(), req.GetPassword(), RoleUser)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RegisterResponse{Token: s.newToken(u)}, nil
+}
+
+// GetAccount returns the account of a token
+func (s *Server) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAccountResponse{Account: proto.Clone(u.account).(*pb.Account)}, nil
+}
+
+// SavePatientInfo replaces the account's own profile, checking the expected version unless it is 0
+func (s *Server) SavePatientInfo(ctx context.Context, req *pb.SavePatientInfoRequest) (*pb.SavePatientInfoResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetPatientInfo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "patient info is required")
+	}
+	if req.GetExpectedVersion() != 0 && req.GetExpectedVersion() != u.version {
+		return nil, status.Errorf(codes.FailedPrecondition, "expected version %d, stored version is %d", req.GetExpectedVersion(), u.version)
+	}
+
+	u.patient = proto.Clone(req.GetPatientInfo()).(*pb.PatientInfo)
+	u.version++
+	return &pb.SavePatientInfoResponse{Success: true, Version: u.version}, nil
+}
+
+// GetPatient returns the account's own profile
+func (s *Server) GetPatient(ctx context.Context, req *pb.GetPatientRequest) (*pb.GetPatientResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	if u.patient == nil {
+		return nil, status.Error(codes.NotFound, "patient not found")
+	}
+	return &pb.GetPatientResponse{PatientInfo: proto.Clone(u.patient).(*pb.PatientInfo), Version: u.version}, nil
+}
+
+// ListPatients returns the profiles managed by the account
+func (s *Server) ListPatients(ctx context.Context, req *pb.ListPatientsRequest) (*pb.ListPatientsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListPatientsResponse{}
+	for id := int32(1); id <= s.nextPatientID; id++ {
+		if profile, ok := s.patients[id]; ok && profile.OwnerId == u.account.Id {
+			resp.Patients = append(resp.Patients, proto.Clone(profile).(*pb.PatientProfile))
+		}
+	}
+	return resp, nil
+}
+
+// CreatePatient adds a profile managed by the account
+func (s *Server) CreatePatient(ctx context.Context, req *pb.CreatePatientRequest) (*pb.CreatePatientResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	s.nextPatientID++
+	profile := &pb.PatientProfile{
+		Id:           s.nextPatientID,
+		OwnerId:      u.account.Id,
+		Relationship: req.GetRelationship(),
+		PatientInfo:  proto.Clone(req.GetPatientInfo()).(*pb.PatientInfo),
+		Version:      1,
+	}
+	s.patients[profile.Id] = profile
+	return &pb.CreatePatientResponse{Patient: proto.Clone(profile).(*pb.PatientProfile)}, nil
+}
+
+// GetPatientById returns a managed profile. Like a database that trusts the gateway, it does not check the owner,
+// so the gateway's own check can be tested.
+func (s *Server) GetPatientById(ctx context.Context, req *pb.GetPatientByIdRequest) (*pb.GetPatientByIdResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.authenticate(req.GetToken()); err != nil {
+		return nil, err
+	}
+	profile, ok := s.patients[req.GetPatientId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "patient not found")
+	}
+	return &pb.GetPatientByIdResponse{Patient: proto.Clone(profile).(*pb.PatientProfile)}, nil
+}
+
+// UpdatePatient replaces a managed profile, checking the expected version unless it is 0
+func (s *Server) UpdatePatient(ctx context.Context, req *pb.UpdatePatientRequest) (*pb.UpdatePatientResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.ownedPatient(u, req.GetPatientId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetExpectedVersion() != 0 && req.GetExpectedVersion() != profile.Version {
+		return nil, status.Errorf(codes.FailedPrecondition, "expected version %d, stored version is %d", req.GetExpectedVersion(), profile.Version)
+	}
+
+	profile.Relationship = req.GetRelationship()
+	profile.PatientInfo = proto.Clone(req.GetPatientInfo()).(*pb.PatientInfo)
+	profile.Version++
+	return &pb.UpdatePatientResponse{Patient: proto.Clone(profile).(*pb.PatientProfile)}, nil
+}
+
+// DeletePatient removes a managed profile
+func (s *Server) DeletePatient(ctx context.Context, req *pb.DeletePatientRequest) (*pb.DeletePatientResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.ownedPatient(u, req.GetPatientId()); err != nil {
+		return nil, err
+	}
+	delete(s.patients, req.GetPatientId())
+	return &pb.DeletePatientResponse{Success: true}, nil
+}
+
+// SaveConversation appends messages to a conversation
+func (s *Server) SaveConversation(ctx context.Context, req *pb.SaveConversationRequest) (*pb.SaveConversationResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.authenticate(req.GetToken()); err != nil {
+		return nil, err
+	}
+	for _, message := range req.GetMessages() {
+		s.conversations[req.GetConversationId()] = append(s.conversations[req.GetConversationId()], proto.Clone(message).(*pb.ChatMessage))
+	}
+	return &pb.SaveConversationResponse{Success: true}, nil
+}
+
+// FlagConversation records a conversation flagged by triage
+func (s *Server) FlagConversation(ctx context.Context, req *pb.FlagConversationRequest) (*pb.FlagConversationResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.authenticate(req.GetToken()); err != nil {
+		return nil, err
+	}
+	s.flags = append(s.flags, proto.Clone(req).(*pb.FlagConversationRequest))
+	return &pb.FlagConversationResponse{Success: true}, nil
+}
+
+// summaryKey scopes a conversation summary to its account, so one account cannot read another's
+func summaryKey(accountID int32, conversationID string) string {
+	return fmt.Sprintf("%d/%s", accountID, conversationID)
+}
+
+// GetConversationSummary returns the summary of a conversation, unset when it has none
+func (s *Server) GetConversationSummary(ctx context.Context, req *pb.GetConversationSummaryRequest) (*pb.GetConversationSummaryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetConversationSummaryResponse{}
+	if summary, ok := s.summaries[summaryKey(u.account.Id, req.GetConversationId())]; ok {
+		resp.Summary = proto.Clone(summary).(*pb.ConversationSummary)
+	}
+	return resp, nil
+}
+
+// SaveConversationSummary replaces the summary of a conversation
+func (s *Server) SaveConversationSummary(ctx context.Context, req *pb.SaveConversationSummaryRequest) (*pb.SaveConversationSummaryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	s.summaries[summaryKey(u.account.Id, req.GetConversationId())] = proto.Clone(req.GetSummary()).(*pb.ConversationSummary)
+	return &pb.SaveConversationSummaryResponse{Success: true}, nil
+}
+
+// RecordVitals stores a vitals reading of the account's own profile or of a managed one
+func (s *Server) RecordVitals(ctx context.Context, req *pb.RecordVitalsRequest) (*pb.RecordVitalsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetPatientId() != 0 {
+		if _, err := s.ownedPatient(u, req.GetPatientId()); err != nil {
+			return nil, err
+		}
+	}
+
+	vitals := proto.Clone(req.GetVitals()).(*pb.VitalSigns)
+	vitals.Id = fmt.Sprintf("%d", len(s.vitals)+1)
+	s.vitals = append(s.vitals, storedVitals{ownerID: u.account.Id, patientID: req.GetPatientId(), vitals: vitals})
+	return &pb.RecordVitalsResponse{Id: vitals.Id}, nil
+}
+
+// ListVitals returns the vitals readings of a profile within the period, the most recent first
+func (s *Server) ListVitals(ctx context.Context, req *pb.ListVitalsRequest) (*pb.ListVitalsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.authenticate(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListVitalsResponse{}
+	for i := len(s.vitals) - 1; i >= 0; i-- {
+		stored := s.vitals[i]
+		recordedAt := stored.vitals.GetRecordedAt()
+		if stored.ownerID != u.account.Id || stored.patientID != req.GetPatientId() ||
+			(req.GetSince() != 0 && recordedAt < req.GetSince()) || (req.GetUntil() != 0 && recordedAt > req.GetUntil()) {
+			continue
+		}
+		resp.Vitals = append(resp.Vitals, proto.Clone(stored.vitals).(*pb.VitalSigns))
+		if req.GetLimit() > 0 && len(resp.Vitals) == int(req.GetLimit()) {
+			break
+		}
+	}
+	return resp, nil
+}
+
+// RecordUsage stores the token usage of a generation
+func (s *Server) RecordUsage(ctx context.Context, req *pb.RecordUsageRequest) (*pb.RecordUsageResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record := proto.Clone(req.GetRecord()).(*pb.UsageRecord)
+	if record.Time == 0 {
+		record.Time = time.Now().UnixMilli()
+	}
+	s.usage = append(s.usage, record)
+	return &pb.RecordUsageResponse{Success: true}, nil
+}
+
+// ListUsage totals the token usage within the period by account, conversation and model
+func (s *Server) ListUsage(ctx context.Context, req *pb.ListUsageRequest) (*pb.ListUsageResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type key struct {
+		accountID      int32
+		conversationID string
+		model          string
+	}
+	totals := map[key]*pb.UsageTotal{}
+	resp := &pb.ListUsageResponse{}
+	for _, record := range s.usage {
+		if (req.GetAccountId() != 0 && record.GetAccountId() != req.GetAccountId()) ||
+			(req.GetSince() != 0 && record.GetTime() < req.GetSince()) || (req.GetUntil() != 0 && record.GetTime() >= req.GetUntil()) {
+			continue
+		}
+
+		k := key{record.GetAccountId(), record.GetConversationId(), record.GetModel()}
+		total, ok := totals[k]
+		if !ok {
+			total = &pb.UsageTotal{AccountId: k.accountID, ConversationId: k.conversationID, Model: k.model}
+			totals[k] = total
+			resp.Totals = append(resp.Totals, total)
+		}
+		total.PromptTokens += int64(record.GetPromptTokens())
+		total.CompletionTokens += int64(record.GetCompletionTokens())
+		total.Requests++
+	}
+	return resp, nil
+}
@@ -22,9 +22,17 @@ const (
 )
 
 type DiagnoseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PatientInfo   *PatientInfoForPrompt  `protobuf:"bytes,1,opt,name=patient_info,json=patientInfo,proto3" json:"patient_info,omitempty"`
-	Messages      []*Message             `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PatientInfo         *PatientInfoForPrompt  `protobuf:"bytes,1,opt,name=patient_info,json=patientInfo,proto3" json:"patient_info,omitempty"`
+	Messages            []*Message             `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	RecentVitals        []*VitalSignsForPrompt `protobuf:"bytes,3,rep,name=recent_vitals,json=recentVitals,proto3" json:"recent_vitals,omitempty"`
+	VitalTrends         []*VitalTrendForPrompt `protobuf:"bytes,4,rep,name=vital_trends,json=vitalTrends,proto3" json:"vital_trends,omitempty"`
+	ConversationSummary string                 `protobuf:"bytes,5,opt,name=conversation_summary,json=conversationSummary,proto3" json:"conversation_summary,omitempty"` // summary of the earlier turns left out of messages, if any
+	// Generation parameters chosen by the gateway; unset ones use the AI server's defaults
+	Model         string   `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	Temperature   *float32 `protobuf:"fixed32,7,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	MaxTokens     int32    `protobuf:"varint,8,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	Language      string   `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"` // BCP 47 tag of the language to answer in, such as "pt-BR"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,9 +81,66 @@ func (x *DiagnoseRequest) GetMessages() []*Message {
 	return nil
 }
 
+func (x *DiagnoseRequest) GetRecentVitals() []*VitalSignsForPrompt {
+	if x != nil {
+		return x.RecentVitals
+	}
+	return nil
+}
+
+func (x *DiagnoseRequest) GetVitalTrends() []*VitalTrendForPrompt {
+	if x != nil {
+		return x.VitalTrends
+	}
+	return nil
+}
+
+func (x *DiagnoseRequest) GetConversationSummary() string {
+	if x != nil {
+		return x.ConversationSummary
+	}
+	return ""
+}
+
+func (x *DiagnoseRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *DiagnoseRequest) GetTemperature() float32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *DiagnoseRequest) GetMaxTokens() int32 {
+	if x != nil {
+		return x.MaxTokens
+	}
+	return 0
+}
+
+func (x *DiagnoseRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// The stream starts with the metadata, then carries markdown deltas and at most one structured summary,
+// and ends with the token usage of the generation
 type DiagnoseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*DiagnoseResponse_Content
+	//	*DiagnoseResponse_Summary
+	//	*DiagnoseResponse_Metadata
+	//	*DiagnoseResponse_Usage
+	Event         isDiagnoseResponse_Event `protobuf_oneof:"event"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,38 +175,99 @@ func (*DiagnoseResponse) Descriptor() ([]byte, []int) {
 	return file_ai_server_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *DiagnoseResponse) GetEvent() isDiagnoseResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
 func (x *DiagnoseResponse) GetContent() string {
 	if x != nil {
-		return x.Content
+		if x, ok := x.Event.(*DiagnoseResponse_Content); ok {
+			return x.Content
+		}
 	}
 	return ""
 }
 
-type PatientInfoForPrompt struct {
+func (x *DiagnoseResponse) GetSummary() *StructuredDiagnosis {
+	if x != nil {
+		if x, ok := x.Event.(*DiagnoseResponse_Summary); ok {
+			return x.Summary
+		}
+	}
+	return nil
+}
+
+func (x *DiagnoseResponse) GetMetadata() *DiagnoseMetadata {
+	if x != nil {
+		if x, ok := x.Event.(*DiagnoseResponse_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *DiagnoseResponse) GetUsage() *TokenUsage {
+	if x != nil {
+		if x, ok := x.Event.(*DiagnoseResponse_Usage); ok {
+			return x.Usage
+		}
+	}
+	return nil
+}
+
+type isDiagnoseResponse_Event interface {
+	isDiagnoseResponse_Event()
+}
+
+type DiagnoseResponse_Content struct {
+	Content string `protobuf:"bytes,1,opt,name=content,proto3,oneof"`
+}
+
+type DiagnoseResponse_Summary struct {
+	Summary *StructuredDiagnosis `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+type DiagnoseResponse_Metadata struct {
+	Metadata *DiagnoseMetadata `protobuf:"bytes,3,opt,name=metadata,proto3,oneof"`
+}
+
+type DiagnoseResponse_Usage struct {
+	Usage *TokenUsage `protobuf:"bytes,4,opt,name=usage,proto3,oneof"`
+}
+
+func (*DiagnoseResponse_Content) isDiagnoseResponse_Event() {}
+
+func (*DiagnoseResponse_Summary) isDiagnoseResponse_Event() {}
+
+func (*DiagnoseResponse_Metadata) isDiagnoseResponse_Event() {}
+
+func (*DiagnoseResponse_Usage) isDiagnoseResponse_Event() {}
+
+// Describes how a diagnosis is generated
+type DiagnoseMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Age           int32                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
-	Gender        string                 `protobuf:"bytes,3,opt,name=gender,proto3" json:"gender,omitempty"`
-	Weight        float32                `protobuf:"fixed32,4,opt,name=weight,proto3" json:"weight,omitempty"`
-	Height        float32                `protobuf:"fixed32,5,opt,name=height,proto3" json:"height,omitempty"`
+	Model         string                 `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"` // model actually used, after the AI server's defaults and fallbacks
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatientInfoForPrompt) Reset() {
-	*x = PatientInfoForPrompt{}
+func (x *DiagnoseMetadata) Reset() {
+	*x = DiagnoseMetadata{}
 	mi := &file_ai_server_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatientInfoForPrompt) String() string {
+func (x *DiagnoseMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatientInfoForPrompt) ProtoMessage() {}
+func (*DiagnoseMetadata) ProtoMessage() {}
 
-func (x *PatientInfoForPrompt) ProtoReflect() protoreflect.Message {
+func (x *DiagnoseMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_ai_server_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -153,69 +279,179 @@ func (x *PatientInfoForPrompt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatientInfoForPrompt.ProtoReflect.Descriptor instead.
-func (*PatientInfoForPrompt) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiagnoseMetadata.ProtoReflect.Descriptor instead.
+func (*DiagnoseMetadata) Descriptor() ([]byte, []int) {
 	return file_ai_server_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PatientInfoForPrompt) GetName() string {
+func (x *DiagnoseMetadata) GetModel() string {
 	if x != nil {
-		return x.Name
+		return x.Model
 	}
 	return ""
 }
 
-func (x *PatientInfoForPrompt) GetAge() int32 {
+// Tokens billed by the model provider for a generation
+type TokenUsage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PromptTokens     int32                  `protobuf:"varint,1,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int32                  `protobuf:"varint,2,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TokenUsage) Reset() {
+	*x = TokenUsage{}
+	mi := &file_ai_server_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenUsage) ProtoMessage() {}
+
+func (x *TokenUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[3]
 	if x != nil {
-		return x.Age
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenUsage.ProtoReflect.Descriptor instead.
+func (*TokenUsage) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TokenUsage) GetPromptTokens() int32 {
+	if x != nil {
+		return x.PromptTokens
 	}
 	return 0
 }
 
-func (x *PatientInfoForPrompt) GetGender() string {
+func (x *TokenUsage) GetCompletionTokens() int32 {
 	if x != nil {
-		return x.Gender
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+type StructuredDiagnosis struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Differentials []*DifferentialDiagnosis `protobuf:"bytes,1,rep,name=differentials,proto3" json:"differentials,omitempty"`                // most likely first
+	TriageLevel   string                   `protobuf:"bytes,2,opt,name=triage_level,json=triageLevel,proto3" json:"triage_level,omitempty"` // "emergency", "urgent", "routine" or "self_care"
+	Specialties   []string                 `protobuf:"bytes,3,rep,name=specialties,proto3" json:"specialties,omitempty"`
+	RedFlags      []string                 `protobuf:"bytes,4,rep,name=red_flags,json=redFlags,proto3" json:"red_flags,omitempty"`
+	Disclaimers   []string                 `protobuf:"bytes,5,rep,name=disclaimers,proto3" json:"disclaimers,omitempty"`
+	NextSteps     []string                 `protobuf:"bytes,6,rep,name=next_steps,json=nextSteps,proto3" json:"next_steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StructuredDiagnosis) Reset() {
+	*x = StructuredDiagnosis{}
+	mi := &file_ai_server_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StructuredDiagnosis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructuredDiagnosis) ProtoMessage() {}
+
+func (x *StructuredDiagnosis) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructuredDiagnosis.ProtoReflect.Descriptor instead.
+func (*StructuredDiagnosis) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StructuredDiagnosis) GetDifferentials() []*DifferentialDiagnosis {
+	if x != nil {
+		return x.Differentials
+	}
+	return nil
+}
+
+func (x *StructuredDiagnosis) GetTriageLevel() string {
+	if x != nil {
+		return x.TriageLevel
 	}
 	return ""
 }
 
-func (x *PatientInfoForPrompt) GetWeight() float32 {
+func (x *StructuredDiagnosis) GetSpecialties() []string {
 	if x != nil {
-		return x.Weight
+		return x.Specialties
 	}
-	return 0
+	return nil
 }
 
-func (x *PatientInfoForPrompt) GetHeight() float32 {
+func (x *StructuredDiagnosis) GetRedFlags() []string {
 	if x != nil {
-		return x.Height
+		return x.RedFlags
 	}
-	return 0
+	return nil
 }
 
-type Message struct {
+func (x *StructuredDiagnosis) GetDisclaimers() []string {
+	if x != nil {
+		return x.Disclaimers
+	}
+	return nil
+}
+
+func (x *StructuredDiagnosis) GetNextSteps() []string {
+	if x != nil {
+		return x.NextSteps
+	}
+	return nil
+}
+
+type DifferentialDiagnosis struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Condition     string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Likelihood    float32                `protobuf:"fixed32,2,opt,name=likelihood,proto3" json:"likelihood,omitempty"` // 0 to 1
+	Rationale     string                 `protobuf:"bytes,3,opt,name=rationale,proto3" json:"rationale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
-	mi := &file_ai_server_proto_msgTypes[3]
+func (x *DifferentialDiagnosis) Reset() {
+	*x = DifferentialDiagnosis{}
+	mi := &file_ai_server_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Message) String() string {
+func (x *DifferentialDiagnosis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*DifferentialDiagnosis) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_server_proto_msgTypes[3]
+func (x *DifferentialDiagnosis) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,82 +462,1467 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
-	return file_ai_server_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DifferentialDiagnosis.ProtoReflect.Descriptor instead.
+func (*DifferentialDiagnosis) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Message) GetRole() string {
+func (x *DifferentialDiagnosis) GetCondition() string {
 	if x != nil {
-		return x.Role
+		return x.Condition
 	}
 	return ""
 }
 
-func (x *Message) GetContent() string {
+func (x *DifferentialDiagnosis) GetLikelihood() float32 {
 	if x != nil {
-		return x.Content
+		return x.Likelihood
+	}
+	return 0
+}
+
+func (x *DifferentialDiagnosis) GetRationale() string {
+	if x != nil {
+		return x.Rationale
 	}
 	return ""
 }
 
-var File_ai_server_proto protoreflect.FileDescriptor
+type PatientInfoForPrompt struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Name          string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Age           int32                     `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+	Gender        string                    `protobuf:"bytes,3,opt,name=gender,proto3" json:"gender,omitempty"`
+	Weight        float32                   `protobuf:"fixed32,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Height        float32                   `protobuf:"fixed32,5,opt,name=height,proto3" json:"height,omitempty"`
+	Allergies     []*AllergyForPrompt       `protobuf:"bytes,6,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	Medications   []*MedicationForPrompt    `protobuf:"bytes,7,rep,name=medications,proto3" json:"medications,omitempty"`
+	Conditions    []*ConditionForPrompt     `protobuf:"bytes,8,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Surgeries     []*SurgeryForPrompt       `protobuf:"bytes,9,rep,name=surgeries,proto3" json:"surgeries,omitempty"`
+	FamilyHistory []*FamilyHistoryForPrompt `protobuf:"bytes,10,rep,name=family_history,json=familyHistory,proto3" json:"family_history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_ai_server_proto_rawDesc = "" +
-	"\n" +
-	"\x0fai-server.proto\x12\x02ai\"w\n" +
-	"\x0fDiagnoseRequest\x12;\n" +
-	"\fpatient_info\x18\x01 \x01(\v2\x18.ai.PatientInfoForPromptR\vpatientInfo\x12'\n" +
-	"\bmessages\x18\x02 \x03(\v2\v.ai.MessageR\bmessages\",\n" +
-	"\x10DiagnoseResponse\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent\"\x84\x01\n" +
-	"\x14PatientInfoForPrompt\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
-	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x16\n" +
-	"\x06gender\x18\x03 \x01(\tR\x06gender\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\x02R\x06weight\x12\x16\n" +
-	"\x06height\x18\x05 \x01(\x02R\x06height\"7\n" +
-	"\aMessage\x12\x12\n" +
-	"\x04role\x18\x01 \x01(\tR\x04role\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent2F\n" +
-	"\tAiService\x129\n" +
-	"\bDiagnose\x12\x13.ai.DiagnoseRequest\x1a\x14.ai.DiagnoseResponse\"\x000\x01B\x1dZ\x1bunb.br/web-server/src/protob\x06proto3"
+func (x *PatientInfoForPrompt) Reset() {
+	*x = PatientInfoForPrompt{}
+	mi := &file_ai_server_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_ai_server_proto_rawDescOnce sync.Once
-	file_ai_server_proto_rawDescData []byte
-)
+func (x *PatientInfoForPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_ai_server_proto_rawDescGZIP() []byte {
-	file_ai_server_proto_rawDescOnce.Do(func() {
-		file_ai_server_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ai_server_proto_rawDesc), len(file_ai_server_proto_rawDesc)))
-	})
-	return file_ai_server_proto_rawDescData
+func (*PatientInfoForPrompt) ProtoMessage() {}
+
+func (x *PatientInfoForPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_ai_server_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_ai_server_proto_goTypes = []any{
-	(*DiagnoseRequest)(nil),      // 0: ai.DiagnoseRequest
-	(*DiagnoseResponse)(nil),     // 1: ai.DiagnoseResponse
-	(*PatientInfoForPrompt)(nil), // 2: ai.PatientInfoForPrompt
-	(*Message)(nil),              // 3: ai.Message
+// Deprecated: Use PatientInfoForPrompt.ProtoReflect.Descriptor instead.
+func (*PatientInfoForPrompt) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{6}
 }
-var file_ai_server_proto_depIdxs = []int32{
-	2, // 0: ai.DiagnoseRequest.patient_info:type_name -> ai.PatientInfoForPrompt
-	3, // 1: ai.DiagnoseRequest.messages:type_name -> ai.Message
-	0, // 2: ai.AiService.Diagnose:input_type -> ai.DiagnoseRequest
-	1, // 3: ai.AiService.Diagnose:output_type -> ai.DiagnoseResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+func (x *PatientInfoForPrompt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func init() { file_ai_server_proto_init() }
-func file_ai_server_proto_init() {
-	if File_ai_server_proto != nil {
-		return
+func (x *PatientInfoForPrompt) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *PatientInfoForPrompt) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *PatientInfoForPrompt) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PatientInfoForPrompt) GetHeight() float32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PatientInfoForPrompt) GetAllergies() []*AllergyForPrompt {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+func (x *PatientInfoForPrompt) GetMedications() []*MedicationForPrompt {
+	if x != nil {
+		return x.Medications
+	}
+	return nil
+}
+
+func (x *PatientInfoForPrompt) GetConditions() []*ConditionForPrompt {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *PatientInfoForPrompt) GetSurgeries() []*SurgeryForPrompt {
+	if x != nil {
+		return x.Surgeries
+	}
+	return nil
+}
+
+func (x *PatientInfoForPrompt) GetFamilyHistory() []*FamilyHistoryForPrompt {
+	if x != nil {
+		return x.FamilyHistory
+	}
+	return nil
+}
+
+type AllergyForPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Substance     string                 `protobuf:"bytes,1,opt,name=substance,proto3" json:"substance,omitempty"`
+	Reaction      string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllergyForPrompt) Reset() {
+	*x = AllergyForPrompt{}
+	mi := &file_ai_server_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllergyForPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllergyForPrompt) ProtoMessage() {}
+
+func (x *AllergyForPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllergyForPrompt.ProtoReflect.Descriptor instead.
+func (*AllergyForPrompt) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AllergyForPrompt) GetSubstance() string {
+	if x != nil {
+		return x.Substance
+	}
+	return ""
+}
+
+func (x *AllergyForPrompt) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *AllergyForPrompt) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+type MedicationForPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Dosage        string                 `protobuf:"bytes,2,opt,name=dosage,proto3" json:"dosage,omitempty"`
+	Frequency     string                 `protobuf:"bytes,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicationForPrompt) Reset() {
+	*x = MedicationForPrompt{}
+	mi := &file_ai_server_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicationForPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicationForPrompt) ProtoMessage() {}
+
+func (x *MedicationForPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicationForPrompt.ProtoReflect.Descriptor instead.
+func (*MedicationForPrompt) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MedicationForPrompt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MedicationForPrompt) GetDosage() string {
+	if x != nil {
+		return x.Dosage
+	}
+	return ""
+}
+
+func (x *MedicationForPrompt) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+type ConditionForPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DiagnosedYear int32                  `protobuf:"varint,2,opt,name=diagnosed_year,json=diagnosedYear,proto3" json:"diagnosed_year,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConditionForPrompt) Reset() {
+	*x = ConditionForPrompt{}
+	mi := &file_ai_server_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConditionForPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionForPrompt) ProtoMessage() {}
+
+func (x *ConditionForPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionForPrompt.ProtoReflect.Descriptor instead.
+func (*ConditionForPrompt) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConditionForPrompt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConditionForPrompt) GetDiagnosedYear() int32 {
+	if x != nil {
+		return x.DiagnosedYear
+	}
+	return 0
+}
+
+func (x *ConditionForPrompt) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type SurgeryForPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Procedure     string                 `protobuf:"bytes,1,opt,name=procedure,proto3" json:"procedure,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SurgeryForPrompt) Reset() {
+	*x = SurgeryForPrompt{}
+	mi := &file_ai_server_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SurgeryForPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurgeryForPrompt) ProtoMessage() {}
+
+func (x *SurgeryForPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurgeryForPrompt.ProtoReflect.Descriptor instead.
+func (*SurgeryForPrompt) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SurgeryForPrompt) GetProcedure() string {
+	if x != nil {
+		return x.Procedure
+	}
+	return ""
+}
+
+func (x *SurgeryForPrompt) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SurgeryForPrompt) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type FamilyHistoryForPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relative      string                 `protobuf:"bytes,1,opt,name=relative,proto3" json:"relative,omitempty"`
+	Condition     string                 `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FamilyHistoryForPrompt) Reset() {
+	*x = FamilyHistoryForPrompt{}
+	mi := &file_ai_server_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FamilyHistoryForPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FamilyHistoryForPrompt) ProtoMessage() {}
+
+func (x *FamilyHistoryForPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FamilyHistoryForPrompt.ProtoReflect.Descriptor instead.
+func (*FamilyHistoryForPrompt) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FamilyHistoryForPrompt) GetRelative() string {
+	if x != nil {
+		return x.Relative
+	}
+	return ""
+}
+
+func (x *FamilyHistoryForPrompt) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+type Message struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_ai_server_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Message) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Message) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type VitalSignsForPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordedAt    int64                  `protobuf:"varint,1,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`    // Unix time in milliseconds
+	Systolic      *int32                 `protobuf:"varint,2,opt,name=systolic,proto3,oneof" json:"systolic,omitempty"`                    // mmHg
+	Diastolic     *int32                 `protobuf:"varint,3,opt,name=diastolic,proto3,oneof" json:"diastolic,omitempty"`                  // mmHg
+	HeartRate     *int32                 `protobuf:"varint,4,opt,name=heart_rate,json=heartRate,proto3,oneof" json:"heart_rate,omitempty"` // beats per minute
+	Temperature   *float32               `protobuf:"fixed32,5,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`             // Celsius
+	Spo2          *int32                 `protobuf:"varint,6,opt,name=spo2,proto3,oneof" json:"spo2,omitempty"`                            // percent
+	Glucose       *float32               `protobuf:"fixed32,7,opt,name=glucose,proto3,oneof" json:"glucose,omitempty"`                     // mg/dL
+	Weight        *float32               `protobuf:"fixed32,8,opt,name=weight,proto3,oneof" json:"weight,omitempty"`                       // kg
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VitalSignsForPrompt) Reset() {
+	*x = VitalSignsForPrompt{}
+	mi := &file_ai_server_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VitalSignsForPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VitalSignsForPrompt) ProtoMessage() {}
+
+func (x *VitalSignsForPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VitalSignsForPrompt.ProtoReflect.Descriptor instead.
+func (*VitalSignsForPrompt) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VitalSignsForPrompt) GetRecordedAt() int64 {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return 0
+}
+
+func (x *VitalSignsForPrompt) GetSystolic() int32 {
+	if x != nil && x.Systolic != nil {
+		return *x.Systolic
+	}
+	return 0
+}
+
+func (x *VitalSignsForPrompt) GetDiastolic() int32 {
+	if x != nil && x.Diastolic != nil {
+		return *x.Diastolic
+	}
+	return 0
+}
+
+func (x *VitalSignsForPrompt) GetHeartRate() int32 {
+	if x != nil && x.HeartRate != nil {
+		return *x.HeartRate
+	}
+	return 0
+}
+
+func (x *VitalSignsForPrompt) GetTemperature() float32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *VitalSignsForPrompt) GetSpo2() int32 {
+	if x != nil && x.Spo2 != nil {
+		return *x.Spo2
+	}
+	return 0
+}
+
+func (x *VitalSignsForPrompt) GetGlucose() float32 {
+	if x != nil && x.Glucose != nil {
+		return *x.Glucose
+	}
+	return 0
+}
+
+func (x *VitalSignsForPrompt) GetWeight() float32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+// Aggregate of one measurement over the recent readings
+type VitalTrendForPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metric        string                 `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Latest        float32                `protobuf:"fixed32,4,opt,name=latest,proto3" json:"latest,omitempty"`
+	Average       float32                `protobuf:"fixed32,5,opt,name=average,proto3" json:"average,omitempty"`
+	Min           float32                `protobuf:"fixed32,6,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float32                `protobuf:"fixed32,7,opt,name=max,proto3" json:"max,omitempty"`
+	Change        float32                `protobuf:"fixed32,8,opt,name=change,proto3" json:"change,omitempty"` // latest minus earliest reading
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VitalTrendForPrompt) Reset() {
+	*x = VitalTrendForPrompt{}
+	mi := &file_ai_server_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VitalTrendForPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VitalTrendForPrompt) ProtoMessage() {}
+
+func (x *VitalTrendForPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VitalTrendForPrompt.ProtoReflect.Descriptor instead.
+func (*VitalTrendForPrompt) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VitalTrendForPrompt) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *VitalTrendForPrompt) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *VitalTrendForPrompt) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VitalTrendForPrompt) GetLatest() float32 {
+	if x != nil {
+		return x.Latest
+	}
+	return 0
+}
+
+func (x *VitalTrendForPrompt) GetAverage() float32 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *VitalTrendForPrompt) GetMin() float32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *VitalTrendForPrompt) GetMax() float32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *VitalTrendForPrompt) GetChange() float32 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+// A chat session carries several turns; only one turn is answered at a time
+type ChatSessionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TurnId string                 `protobuf:"bytes,1,opt,name=turn_id,json=turnId,proto3" json:"turn_id,omitempty"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*ChatSessionRequest_Turn
+	//	*ChatSessionRequest_Stop
+	Action        isChatSessionRequest_Action `protobuf_oneof:"action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatSessionRequest) Reset() {
+	*x = ChatSessionRequest{}
+	mi := &file_ai_server_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatSessionRequest) ProtoMessage() {}
+
+func (x *ChatSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatSessionRequest.ProtoReflect.Descriptor instead.
+func (*ChatSessionRequest) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChatSessionRequest) GetTurnId() string {
+	if x != nil {
+		return x.TurnId
+	}
+	return ""
+}
+
+func (x *ChatSessionRequest) GetAction() isChatSessionRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *ChatSessionRequest) GetTurn() *DiagnoseRequest {
+	if x != nil {
+		if x, ok := x.Action.(*ChatSessionRequest_Turn); ok {
+			return x.Turn
+		}
+	}
+	return nil
+}
+
+func (x *ChatSessionRequest) GetStop() *StopGeneration {
+	if x != nil {
+		if x, ok := x.Action.(*ChatSessionRequest_Stop); ok {
+			return x.Stop
+		}
+	}
+	return nil
+}
+
+type isChatSessionRequest_Action interface {
+	isChatSessionRequest_Action()
+}
+
+type ChatSessionRequest_Turn struct {
+	Turn *DiagnoseRequest `protobuf:"bytes,2,opt,name=turn,proto3,oneof"` // the full context of the turn, including the conversation so far
+}
+
+type ChatSessionRequest_Stop struct {
+	Stop *StopGeneration `protobuf:"bytes,3,opt,name=stop,proto3,oneof"` // stops the turn with the same ID
+}
+
+func (*ChatSessionRequest_Turn) isChatSessionRequest_Action() {}
+
+func (*ChatSessionRequest_Stop) isChatSessionRequest_Action() {}
+
+type StopGeneration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopGeneration) Reset() {
+	*x = StopGeneration{}
+	mi := &file_ai_server_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopGeneration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopGeneration) ProtoMessage() {}
+
+func (x *StopGeneration) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopGeneration.ProtoReflect.Descriptor instead.
+func (*StopGeneration) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{16}
+}
+
+// Events of a turn, in the same order as in Diagnose, closed by a TurnEnd
+type ChatSessionResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TurnId string                 `protobuf:"bytes,1,opt,name=turn_id,json=turnId,proto3" json:"turn_id,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*ChatSessionResponse_Content
+	//	*ChatSessionResponse_Summary
+	//	*ChatSessionResponse_End
+	//	*ChatSessionResponse_Metadata
+	//	*ChatSessionResponse_Usage
+	Event         isChatSessionResponse_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatSessionResponse) Reset() {
+	*x = ChatSessionResponse{}
+	mi := &file_ai_server_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatSessionResponse) ProtoMessage() {}
+
+func (x *ChatSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatSessionResponse.ProtoReflect.Descriptor instead.
+func (*ChatSessionResponse) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChatSessionResponse) GetTurnId() string {
+	if x != nil {
+		return x.TurnId
+	}
+	return ""
+}
+
+func (x *ChatSessionResponse) GetEvent() isChatSessionResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ChatSessionResponse) GetContent() string {
+	if x != nil {
+		if x, ok := x.Event.(*ChatSessionResponse_Content); ok {
+			return x.Content
+		}
+	}
+	return ""
+}
+
+func (x *ChatSessionResponse) GetSummary() *StructuredDiagnosis {
+	if x != nil {
+		if x, ok := x.Event.(*ChatSessionResponse_Summary); ok {
+			return x.Summary
+		}
+	}
+	return nil
+}
+
+func (x *ChatSessionResponse) GetEnd() *TurnEnd {
+	if x != nil {
+		if x, ok := x.Event.(*ChatSessionResponse_End); ok {
+			return x.End
+		}
+	}
+	return nil
+}
+
+func (x *ChatSessionResponse) GetMetadata() *DiagnoseMetadata {
+	if x != nil {
+		if x, ok := x.Event.(*ChatSessionResponse_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *ChatSessionResponse) GetUsage() *TokenUsage {
+	if x != nil {
+		if x, ok := x.Event.(*ChatSessionResponse_Usage); ok {
+			return x.Usage
+		}
+	}
+	return nil
+}
+
+type isChatSessionResponse_Event interface {
+	isChatSessionResponse_Event()
+}
+
+type ChatSessionResponse_Content struct {
+	Content string `protobuf:"bytes,2,opt,name=content,proto3,oneof"`
+}
+
+type ChatSessionResponse_Summary struct {
+	Summary *StructuredDiagnosis `protobuf:"bytes,3,opt,name=summary,proto3,oneof"`
+}
+
+type ChatSessionResponse_End struct {
+	End *TurnEnd `protobuf:"bytes,4,opt,name=end,proto3,oneof"`
+}
+
+type ChatSessionResponse_Metadata struct {
+	Metadata *DiagnoseMetadata `protobuf:"bytes,5,opt,name=metadata,proto3,oneof"`
+}
+
+type ChatSessionResponse_Usage struct {
+	Usage *TokenUsage `protobuf:"bytes,6,opt,name=usage,proto3,oneof"` // sent before the TurnEnd
+}
+
+func (*ChatSessionResponse_Content) isChatSessionResponse_Event() {}
+
+func (*ChatSessionResponse_Summary) isChatSessionResponse_Event() {}
+
+func (*ChatSessionResponse_End) isChatSessionResponse_Event() {}
+
+func (*ChatSessionResponse_Metadata) isChatSessionResponse_Event() {}
+
+func (*ChatSessionResponse_Usage) isChatSessionResponse_Event() {}
+
+type TurnEnd struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stopped       bool                   `protobuf:"varint,1,opt,name=stopped,proto3" json:"stopped,omitempty"` // true when the turn was stopped before the answer was complete
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurnEnd) Reset() {
+	*x = TurnEnd{}
+	mi := &file_ai_server_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnEnd) ProtoMessage() {}
+
+func (x *TurnEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnEnd.ProtoReflect.Descriptor instead.
+func (*TurnEnd) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TurnEnd) GetStopped() bool {
+	if x != nil {
+		return x.Stopped
+	}
+	return false
+}
+
+// Folds older turns into a rolling summary, so long conversations fit in the model's context
+type SummarizeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PreviousSummary string                 `protobuf:"bytes,1,opt,name=previous_summary,json=previousSummary,proto3" json:"previous_summary,omitempty"` // summary of the turns before messages, empty on the first call
+	Messages        []*Message             `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	MaxTokens       int32                  `protobuf:"varint,3,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SummarizeRequest) Reset() {
+	*x = SummarizeRequest{}
+	mi := &file_ai_server_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizeRequest) ProtoMessage() {}
+
+func (x *SummarizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizeRequest.ProtoReflect.Descriptor instead.
+func (*SummarizeRequest) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SummarizeRequest) GetPreviousSummary() string {
+	if x != nil {
+		return x.PreviousSummary
+	}
+	return ""
+}
+
+func (x *SummarizeRequest) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *SummarizeRequest) GetMaxTokens() int32 {
+	if x != nil {
+		return x.MaxTokens
+	}
+	return 0
+}
+
+type SummarizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Usage         *TokenUsage            `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"` // tokens billed for the summary, counted against the account's quota
+	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"` // model that wrote the summary
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SummarizeResponse) Reset() {
+	*x = SummarizeResponse{}
+	mi := &file_ai_server_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizeResponse) ProtoMessage() {}
+
+func (x *SummarizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizeResponse.ProtoReflect.Descriptor instead.
+func (*SummarizeResponse) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SummarizeResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *SummarizeResponse) GetUsage() *TokenUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *SummarizeResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+// Moderation check of a user message, run by the gateway before diagnosing
+type ModerateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateRequest) Reset() {
+	*x = ModerateRequest{}
+	mi := &file_ai_server_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateRequest) ProtoMessage() {}
+
+func (x *ModerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateRequest.ProtoReflect.Descriptor instead.
+func (*ModerateRequest) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ModerateRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ModerateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "allow", "warn" or "block"
+	Outcome string `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// Categories that were flagged, such as "prompt_injection" or "self_harm"
+	Categories    []string `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateResponse) Reset() {
+	*x = ModerateResponse{}
+	mi := &file_ai_server_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateResponse) ProtoMessage() {}
+
+func (x *ModerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_server_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateResponse.ProtoReflect.Descriptor instead.
+func (*ModerateResponse) Descriptor() ([]byte, []int) {
+	return file_ai_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ModerateResponse) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ModerateResponse) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+var File_ai_server_proto protoreflect.FileDescriptor
+
+const file_ai_server_proto_rawDesc = "" +
+	"\n" +
+	"\x0fai-server.proto\x12\x02ai\"\xac\x03\n" +
+	"\x0fDiagnoseRequest\x12;\n" +
+	"\fpatient_info\x18\x01 \x01(\v2\x18.ai.PatientInfoForPromptR\vpatientInfo\x12'\n" +
+	"\bmessages\x18\x02 \x03(\v2\v.ai.MessageR\bmessages\x12<\n" +
+	"\rrecent_vitals\x18\x03 \x03(\v2\x17.ai.VitalSignsForPromptR\frecentVitals\x12:\n" +
+	"\fvital_trends\x18\x04 \x03(\v2\x17.ai.VitalTrendForPromptR\vvitalTrends\x121\n" +
+	"\x14conversation_summary\x18\x05 \x01(\tR\x13conversationSummary\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12%\n" +
+	"\vtemperature\x18\a \x01(\x02H\x00R\vtemperature\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"max_tokens\x18\b \x01(\x05R\tmaxTokens\x12\x1a\n" +
+	"\blanguage\x18\t \x01(\tR\blanguageB\x0e\n" +
+	"\f_temperature\"\xc8\x01\n" +
+	"\x10DiagnoseResponse\x12\x1a\n" +
+	"\acontent\x18\x01 \x01(\tH\x00R\acontent\x123\n" +
+	"\asummary\x18\x02 \x01(\v2\x17.ai.StructuredDiagnosisH\x00R\asummary\x122\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x14.ai.DiagnoseMetadataH\x00R\bmetadata\x12&\n" +
+	"\x05usage\x18\x04 \x01(\v2\x0e.ai.TokenUsageH\x00R\x05usageB\a\n" +
+	"\x05event\"(\n" +
+	"\x10DiagnoseMetadata\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\"^\n" +
+	"\n" +
+	"TokenUsage\x12#\n" +
+	"\rprompt_tokens\x18\x01 \x01(\x05R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x02 \x01(\x05R\x10completionTokens\"\xf9\x01\n" +
+	"\x13StructuredDiagnosis\x12?\n" +
+	"\rdifferentials\x18\x01 \x03(\v2\x19.ai.DifferentialDiagnosisR\rdifferentials\x12!\n" +
+	"\ftriage_level\x18\x02 \x01(\tR\vtriageLevel\x12 \n" +
+	"\vspecialties\x18\x03 \x03(\tR\vspecialties\x12\x1b\n" +
+	"\tred_flags\x18\x04 \x03(\tR\bredFlags\x12 \n" +
+	"\vdisclaimers\x18\x05 \x03(\tR\vdisclaimers\x12\x1d\n" +
+	"\n" +
+	"next_steps\x18\x06 \x03(\tR\tnextSteps\"s\n" +
+	"\x15DifferentialDiagnosis\x12\x1c\n" +
+	"\tcondition\x18\x01 \x01(\tR\tcondition\x12\x1e\n" +
+	"\n" +
+	"likelihood\x18\x02 \x01(\x02R\n" +
+	"likelihood\x12\x1c\n" +
+	"\trationale\x18\x03 \x01(\tR\trationale\"\xa2\x03\n" +
+	"\x14PatientInfoForPrompt\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x16\n" +
+	"\x06gender\x18\x03 \x01(\tR\x06gender\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x02R\x06weight\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x02R\x06height\x122\n" +
+	"\tallergies\x18\x06 \x03(\v2\x14.ai.AllergyForPromptR\tallergies\x129\n" +
+	"\vmedications\x18\a \x03(\v2\x17.ai.MedicationForPromptR\vmedications\x126\n" +
+	"\n" +
+	"conditions\x18\b \x03(\v2\x16.ai.ConditionForPromptR\n" +
+	"conditions\x122\n" +
+	"\tsurgeries\x18\t \x03(\v2\x14.ai.SurgeryForPromptR\tsurgeries\x12A\n" +
+	"\x0efamily_history\x18\n" +
+	" \x03(\v2\x1a.ai.FamilyHistoryForPromptR\rfamilyHistory\"h\n" +
+	"\x10AllergyForPrompt\x12\x1c\n" +
+	"\tsubstance\x18\x01 \x01(\tR\tsubstance\x12\x1a\n" +
+	"\breaction\x18\x02 \x01(\tR\breaction\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\"_\n" +
+	"\x13MedicationForPrompt\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06dosage\x18\x02 \x01(\tR\x06dosage\x12\x1c\n" +
+	"\tfrequency\x18\x03 \x01(\tR\tfrequency\"e\n" +
+	"\x12ConditionForPrompt\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0ediagnosed_year\x18\x02 \x01(\x05R\rdiagnosedYear\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"Z\n" +
+	"\x10SurgeryForPrompt\x12\x1c\n" +
+	"\tprocedure\x18\x01 \x01(\tR\tprocedure\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"R\n" +
+	"\x16FamilyHistoryForPrompt\x12\x1a\n" +
+	"\brelative\x18\x01 \x01(\tR\brelative\x12\x1c\n" +
+	"\tcondition\x18\x02 \x01(\tR\tcondition\"7\n" +
+	"\aMessage\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\xf4\x02\n" +
+	"\x13VitalSignsForPrompt\x12\x1f\n" +
+	"\vrecorded_at\x18\x01 \x01(\x03R\n" +
+	"recordedAt\x12\x1f\n" +
+	"\bsystolic\x18\x02 \x01(\x05H\x00R\bsystolic\x88\x01\x01\x12!\n" +
+	"\tdiastolic\x18\x03 \x01(\x05H\x01R\tdiastolic\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"heart_rate\x18\x04 \x01(\x05H\x02R\theartRate\x88\x01\x01\x12%\n" +
+	"\vtemperature\x18\x05 \x01(\x02H\x03R\vtemperature\x88\x01\x01\x12\x17\n" +
+	"\x04spo2\x18\x06 \x01(\x05H\x04R\x04spo2\x88\x01\x01\x12\x1d\n" +
+	"\aglucose\x18\a \x01(\x02H\x05R\aglucose\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\b \x01(\x02H\x06R\x06weight\x88\x01\x01B\v\n" +
+	"\t_systolicB\f\n" +
+	"\n" +
+	"_diastolicB\r\n" +
+	"\v_heart_rateB\x0e\n" +
+	"\f_temperatureB\a\n" +
+	"\x05_spo2B\n" +
+	"\n" +
+	"\b_glucoseB\t\n" +
+	"\a_weight\"\xc5\x01\n" +
+	"\x13VitalTrendForPrompt\x12\x16\n" +
+	"\x06metric\x18\x01 \x01(\tR\x06metric\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06latest\x18\x04 \x01(\x02R\x06latest\x12\x18\n" +
+	"\aaverage\x18\x05 \x01(\x02R\aaverage\x12\x10\n" +
+	"\x03min\x18\x06 \x01(\x02R\x03min\x12\x10\n" +
+	"\x03max\x18\a \x01(\x02R\x03max\x12\x16\n" +
+	"\x06change\x18\b \x01(\x02R\x06change\"\x8c\x01\n" +
+	"\x12ChatSessionRequest\x12\x17\n" +
+	"\aturn_id\x18\x01 \x01(\tR\x06turnId\x12)\n" +
+	"\x04turn\x18\x02 \x01(\v2\x13.ai.DiagnoseRequestH\x00R\x04turn\x12(\n" +
+	"\x04stop\x18\x03 \x01(\v2\x12.ai.StopGenerationH\x00R\x04stopB\b\n" +
+	"\x06action\"\x10\n" +
+	"\x0eStopGeneration\"\x85\x02\n" +
+	"\x13ChatSessionResponse\x12\x17\n" +
+	"\aturn_id\x18\x01 \x01(\tR\x06turnId\x12\x1a\n" +
+	"\acontent\x18\x02 \x01(\tH\x00R\acontent\x123\n" +
+	"\asummary\x18\x03 \x01(\v2\x17.ai.StructuredDiagnosisH\x00R\asummary\x12\x1f\n" +
+	"\x03end\x18\x04 \x01(\v2\v.ai.TurnEndH\x00R\x03end\x122\n" +
+	"\bmetadata\x18\x05 \x01(\v2\x14.ai.DiagnoseMetadataH\x00R\bmetadata\x12&\n" +
+	"\x05usage\x18\x06 \x01(\v2\x0e.ai.TokenUsageH\x00R\x05usageB\a\n" +
+	"\x05event\"#\n" +
+	"\aTurnEnd\x12\x18\n" +
+	"\astopped\x18\x01 \x01(\bR\astopped\"\x85\x01\n" +
+	"\x10SummarizeRequest\x12)\n" +
+	"\x10previous_summary\x18\x01 \x01(\tR\x0fpreviousSummary\x12'\n" +
+	"\bmessages\x18\x02 \x03(\v2\v.ai.MessageR\bmessages\x12\x1d\n" +
+	"\n" +
+	"max_tokens\x18\x03 \x01(\x05R\tmaxTokens\"i\n" +
+	"\x11SummarizeResponse\x12\x18\n" +
+	"\asummary\x18\x01 \x01(\tR\asummary\x12$\n" +
+	"\x05usage\x18\x02 \x01(\v2\x0e.ai.TokenUsageR\x05usage\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\"%\n" +
+	"\x0fModerateRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"L\n" +
+	"\x10ModerateResponse\x12\x18\n" +
+	"\aoutcome\x18\x01 \x01(\tR\aoutcome\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\tR\n" +
+	"categories2\xfa\x01\n" +
+	"\tAiService\x129\n" +
+	"\bDiagnose\x12\x13.ai.DiagnoseRequest\x1a\x14.ai.DiagnoseResponse\"\x000\x01\x12=\n" +
+	"\x04Chat\x12\x16.ai.ChatSessionRequest\x1a\x17.ai.ChatSessionResponse\"\x00(\x010\x01\x12:\n" +
+	"\tSummarize\x12\x14.ai.SummarizeRequest\x1a\x15.ai.SummarizeResponse\"\x00\x127\n" +
+	"\bModerate\x12\x13.ai.ModerateRequest\x1a\x14.ai.ModerateResponse\"\x00B\x1dZ\x1bunb.br/web-server/src/protob\x06proto3"
+
+var (
+	file_ai_server_proto_rawDescOnce sync.Once
+	file_ai_server_proto_rawDescData []byte
+)
+
+func file_ai_server_proto_rawDescGZIP() []byte {
+	file_ai_server_proto_rawDescOnce.Do(func() {
+		file_ai_server_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ai_server_proto_rawDesc), len(file_ai_server_proto_rawDesc)))
+	})
+	return file_ai_server_proto_rawDescData
+}
+
+var file_ai_server_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_ai_server_proto_goTypes = []any{
+	(*DiagnoseRequest)(nil),        // 0: ai.DiagnoseRequest
+	(*DiagnoseResponse)(nil),       // 1: ai.DiagnoseResponse
+	(*DiagnoseMetadata)(nil),       // 2: ai.DiagnoseMetadata
+	(*TokenUsage)(nil),             // 3: ai.TokenUsage
+	(*StructuredDiagnosis)(nil),    // 4: ai.StructuredDiagnosis
+	(*DifferentialDiagnosis)(nil),  // 5: ai.DifferentialDiagnosis
+	(*PatientInfoForPrompt)(nil),   // 6: ai.PatientInfoForPrompt
+	(*AllergyForPrompt)(nil),       // 7: ai.AllergyForPrompt
+	(*MedicationForPrompt)(nil),    // 8: ai.MedicationForPrompt
+	(*ConditionForPrompt)(nil),     // 9: ai.ConditionForPrompt
+	(*SurgeryForPrompt)(nil),       // 10: ai.SurgeryForPrompt
+	(*FamilyHistoryForPrompt)(nil), // 11: ai.FamilyHistoryForPrompt
+	(*Message)(nil),                // 12: ai.Message
+	(*VitalSignsForPrompt)(nil),    // 13: ai.VitalSignsForPrompt
+	(*VitalTrendForPrompt)(nil),    // 14: ai.VitalTrendForPrompt
+	(*ChatSessionRequest)(nil),     // 15: ai.ChatSessionRequest
+	(*StopGeneration)(nil),         // 16: ai.StopGeneration
+	(*ChatSessionResponse)(nil),    // 17: ai.ChatSessionResponse
+	(*TurnEnd)(nil),                // 18: ai.TurnEnd
+	(*SummarizeRequest)(nil),       // 19: ai.SummarizeRequest
+	(*SummarizeResponse)(nil),      // 20: ai.SummarizeResponse
+	(*ModerateRequest)(nil),        // 21: ai.ModerateRequest
+	(*ModerateResponse)(nil),       // 22: ai.ModerateResponse
+}
+var file_ai_server_proto_depIdxs = []int32{
+	6,  // 0: ai.DiagnoseRequest.patient_info:type_name -> ai.PatientInfoForPrompt
+	12, // 1: ai.DiagnoseRequest.messages:type_name -> ai.Message
+	13, // 2: ai.DiagnoseRequest.recent_vitals:type_name -> ai.VitalSignsForPrompt
+	14, // 3: ai.DiagnoseRequest.vital_trends:type_name -> ai.VitalTrendForPrompt
+	4,  // 4: ai.DiagnoseResponse.summary:type_name -> ai.StructuredDiagnosis
+	2,  // 5: ai.DiagnoseResponse.metadata:type_name -> ai.DiagnoseMetadata
+	3,  // 6: ai.DiagnoseResponse.usage:type_name -> ai.TokenUsage
+	5,  // 7: ai.StructuredDiagnosis.differentials:type_name -> ai.DifferentialDiagnosis
+	7,  // 8: ai.PatientInfoForPrompt.allergies:type_name -> ai.AllergyForPrompt
+	8,  // 9: ai.PatientInfoForPrompt.medications:type_name -> ai.MedicationForPrompt
+	9,  // 10: ai.PatientInfoForPrompt.conditions:type_name -> ai.ConditionForPrompt
+	10, // 11: ai.PatientInfoForPrompt.surgeries:type_name -> ai.SurgeryForPrompt
+	11, // 12: ai.PatientInfoForPrompt.family_history:type_name -> ai.FamilyHistoryForPrompt
+	0,  // 13: ai.ChatSessionRequest.turn:type_name -> ai.DiagnoseRequest
+	16, // 14: ai.ChatSessionRequest.stop:type_name -> ai.StopGeneration
+	4,  // 15: ai.ChatSessionResponse.summary:type_name -> ai.StructuredDiagnosis
+	18, // 16: ai.ChatSessionResponse.end:type_name -> ai.TurnEnd
+	2,  // 17: ai.ChatSessionResponse.metadata:type_name -> ai.DiagnoseMetadata
+	3,  // 18: ai.ChatSessionResponse.usage:type_name -> ai.TokenUsage
+	12, // 19: ai.SummarizeRequest.messages:type_name -> ai.Message
+	3,  // 20: ai.SummarizeResponse.usage:type_name -> ai.TokenUsage
+	0,  // 21: ai.AiService.Diagnose:input_type -> ai.DiagnoseRequest
+	15, // 22: ai.AiService.Chat:input_type -> ai.ChatSessionRequest
+	19, // 23: ai.AiService.Summarize:input_type -> ai.SummarizeRequest
+	21, // 24: ai.AiService.Moderate:input_type -> ai.ModerateRequest
+	1,  // 25: ai.AiService.Diagnose:output_type -> ai.DiagnoseResponse
+	17, // 26: ai.AiService.Chat:output_type -> ai.ChatSessionResponse
+	20, // 27: ai.AiService.Summarize:output_type -> ai.SummarizeResponse
+	22, // 28: ai.AiService.Moderate:output_type -> ai.ModerateResponse
+	25, // [25:29] is the sub-list for method output_type
+	21, // [21:25] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_ai_server_proto_init() }
+func file_ai_server_proto_init() {
+	if File_ai_server_proto != nil {
+		return
+	}
+	file_ai_server_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ai_server_proto_msgTypes[1].OneofWrappers = []any{
+		(*DiagnoseResponse_Content)(nil),
+		(*DiagnoseResponse_Summary)(nil),
+		(*DiagnoseResponse_Metadata)(nil),
+		(*DiagnoseResponse_Usage)(nil),
+	}
+	file_ai_server_proto_msgTypes[13].OneofWrappers = []any{}
+	file_ai_server_proto_msgTypes[15].OneofWrappers = []any{
+		(*ChatSessionRequest_Turn)(nil),
+		(*ChatSessionRequest_Stop)(nil),
+	}
+	file_ai_server_proto_msgTypes[17].OneofWrappers = []any{
+		(*ChatSessionResponse_Content)(nil),
+		(*ChatSessionResponse_Summary)(nil),
+		(*ChatSessionResponse_End)(nil),
+		(*ChatSessionResponse_Metadata)(nil),
+		(*ChatSessionResponse_Usage)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -309,7 +1930,7 @@ func file_ai_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ai_server_proto_rawDesc), len(file_ai_server_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -213,12 +213,14 @@ func (x *RegisterResponse) GetToken() string {
 	return ""
 }
 
+// A mismatching expected_version fails with FAILED_PRECONDITION; 0 skips the check
 type SavePatientInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	PatientInfo   *PatientInfo           `protobuf:"bytes,2,opt,name=patient_info,json=patientInfo,proto3" json:"patient_info,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Token           string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	PatientInfo     *PatientInfo           `protobuf:"bytes,2,opt,name=patient_info,json=patientInfo,proto3" json:"patient_info,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *SavePatientInfoRequest) Reset() {
@@ -265,9 +267,17 @@ func (x *SavePatientInfoRequest) GetPatientInfo() *PatientInfo {
 	return nil
 }
 
+func (x *SavePatientInfoRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type SavePatientInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -309,6 +319,13 @@ func (x *SavePatientInfoResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *SavePatientInfoResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetPatientRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -356,6 +373,7 @@ func (x *GetPatientRequest) GetToken() string {
 type GetPatientResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PatientInfo   *PatientInfo           `protobuf:"bytes,1,opt,name=patient_info,json=patientInfo,proto3" json:"patient_info,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Incremented on every save
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -397,6 +415,13 @@ func (x *GetPatientResponse) GetPatientInfo() *PatientInfo {
 	return nil
 }
 
+func (x *GetPatientResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type PatientInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -404,6 +429,11 @@ type PatientInfo struct {
 	Gender        string                 `protobuf:"bytes,3,opt,name=gender,proto3" json:"gender,omitempty"`
 	Weight        float32                `protobuf:"fixed32,4,opt,name=weight,proto3" json:"weight,omitempty"`
 	Height        float32                `protobuf:"fixed32,5,opt,name=height,proto3" json:"height,omitempty"`
+	Allergies     []*Allergy             `protobuf:"bytes,6,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	Medications   []*Medication          `protobuf:"bytes,7,rep,name=medications,proto3" json:"medications,omitempty"`
+	Conditions    []*Condition           `protobuf:"bytes,8,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Surgeries     []*Surgery             `protobuf:"bytes,9,rep,name=surgeries,proto3" json:"surgeries,omitempty"`
+	FamilyHistory []*FamilyHistoryEntry  `protobuf:"bytes,10,rep,name=family_history,json=familyHistory,proto3" json:"family_history,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -473,42 +503,3447 @@ func (x *PatientInfo) GetHeight() float32 {
 	return 0
 }
 
-var File_database_server_proto protoreflect.FileDescriptor
+func (x *PatientInfo) GetAllergies() []*Allergy {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
 
-const file_database_server_proto_rawDesc = "" +
-	"\n" +
-	"\x15database-server.proto\x12\bdatabase\"F\n" +
-	"\fLoginRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"%\n" +
-	"\rLoginResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"I\n" +
-	"\x0fRegisterRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"(\n" +
-	"\x10RegisterResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"h\n" +
-	"\x16SavePatientInfoRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x128\n" +
-	"\fpatient_info\x18\x02 \x01(\v2\x15.database.PatientInfoR\vpatientInfo\"3\n" +
-	"\x17SavePatientInfoResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\")\n" +
-	"\x11GetPatientRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"N\n" +
-	"\x12GetPatientResponse\x128\n" +
-	"\fpatient_info\x18\x01 \x01(\v2\x15.database.PatientInfoR\vpatientInfo\"{\n" +
-	"\vPatientInfo\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
-	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x16\n" +
-	"\x06gender\x18\x03 \x01(\tR\x06gender\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\x02R\x06weight\x12\x16\n" +
-	"\x06height\x18\x05 \x01(\x02R\x06height2\xb7\x02\n" +
-	"\x0fDatabaseService\x12:\n" +
-	"\x05Login\x12\x16.database.LoginRequest\x1a\x17.database.LoginResponse\"\x00\x12C\n" +
-	"\bRegister\x12\x19.database.RegisterRequest\x1a\x1a.database.RegisterResponse\"\x00\x12X\n" +
-	"\x0fSavePatientInfo\x12 .database.SavePatientInfoRequest\x1a!.database.SavePatientInfoResponse\"\x00\x12I\n" +
+func (x *PatientInfo) GetMedications() []*Medication {
+	if x != nil {
+		return x.Medications
+	}
+	return nil
+}
+
+func (x *PatientInfo) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *PatientInfo) GetSurgeries() []*Surgery {
+	if x != nil {
+		return x.Surgeries
+	}
+	return nil
+}
+
+func (x *PatientInfo) GetFamilyHistory() []*FamilyHistoryEntry {
+	if x != nil {
+		return x.FamilyHistory
+	}
+	return nil
+}
+
+type Allergy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Substance     string                 `protobuf:"bytes,1,opt,name=substance,proto3" json:"substance,omitempty"`
+	Reaction      string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Allergy) Reset() {
+	*x = Allergy{}
+	mi := &file_database_server_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Allergy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Allergy) ProtoMessage() {}
+
+func (x *Allergy) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Allergy.ProtoReflect.Descriptor instead.
+func (*Allergy) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Allergy) GetSubstance() string {
+	if x != nil {
+		return x.Substance
+	}
+	return ""
+}
+
+func (x *Allergy) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *Allergy) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+type Medication struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Dosage        string                 `protobuf:"bytes,2,opt,name=dosage,proto3" json:"dosage,omitempty"`
+	Frequency     string                 `protobuf:"bytes,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Medication) Reset() {
+	*x = Medication{}
+	mi := &file_database_server_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Medication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Medication) ProtoMessage() {}
+
+func (x *Medication) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Medication.ProtoReflect.Descriptor instead.
+func (*Medication) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Medication) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Medication) GetDosage() string {
+	if x != nil {
+		return x.Dosage
+	}
+	return ""
+}
+
+func (x *Medication) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+type Condition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DiagnosedYear int32                  `protobuf:"varint,2,opt,name=diagnosed_year,json=diagnosedYear,proto3" json:"diagnosed_year,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_database_server_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Condition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Condition) GetDiagnosedYear() int32 {
+	if x != nil {
+		return x.DiagnosedYear
+	}
+	return 0
+}
+
+func (x *Condition) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type Surgery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Procedure     string                 `protobuf:"bytes,1,opt,name=procedure,proto3" json:"procedure,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Surgery) Reset() {
+	*x = Surgery{}
+	mi := &file_database_server_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Surgery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Surgery) ProtoMessage() {}
+
+func (x *Surgery) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Surgery.ProtoReflect.Descriptor instead.
+func (*Surgery) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Surgery) GetProcedure() string {
+	if x != nil {
+		return x.Procedure
+	}
+	return ""
+}
+
+func (x *Surgery) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Surgery) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type FamilyHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relative      string                 `protobuf:"bytes,1,opt,name=relative,proto3" json:"relative,omitempty"`
+	Condition     string                 `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FamilyHistoryEntry) Reset() {
+	*x = FamilyHistoryEntry{}
+	mi := &file_database_server_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FamilyHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FamilyHistoryEntry) ProtoMessage() {}
+
+func (x *FamilyHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FamilyHistoryEntry.ProtoReflect.Descriptor instead.
+func (*FamilyHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FamilyHistoryEntry) GetRelative() string {
+	if x != nil {
+		return x.Relative
+	}
+	return ""
+}
+
+func (x *FamilyHistoryEntry) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+// Appends messages to a conversation, creating it when the ID is unknown
+type SaveConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Messages       []*ChatMessage         `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	PatientId      int32                  `protobuf:"varint,4,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"` // 0 for the account's own profile
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SaveConversationRequest) Reset() {
+	*x = SaveConversationRequest{}
+	mi := &file_database_server_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConversationRequest) ProtoMessage() {}
+
+func (x *SaveConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConversationRequest.ProtoReflect.Descriptor instead.
+func (*SaveConversationRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SaveConversationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SaveConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SaveConversationRequest) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *SaveConversationRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+type SaveConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveConversationResponse) Reset() {
+	*x = SaveConversationResponse{}
+	mi := &file_database_server_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConversationResponse) ProtoMessage() {}
+
+func (x *SaveConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConversationResponse.ProtoReflect.Descriptor instead.
+func (*SaveConversationResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SaveConversationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Exports every record tied to the account; the server writes an audit record
+type ExportAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAccountRequest) Reset() {
+	*x = ExportAccountRequest{}
+	mi := &file_database_server_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountRequest) ProtoMessage() {}
+
+func (x *ExportAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountRequest.ProtoReflect.Descriptor instead.
+func (*ExportAccountRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportAccountRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ExportAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	PatientInfo   *PatientInfo           `protobuf:"bytes,2,opt,name=patient_info,json=patientInfo,proto3" json:"patient_info,omitempty"`
+	Conversations []*Conversation        `protobuf:"bytes,3,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	AuditId       string                 `protobuf:"bytes,4,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	Patients      []*PatientProfile      `protobuf:"bytes,5,rep,name=patients,proto3" json:"patients,omitempty"` // Profiles managed besides the account's own
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAccountResponse) Reset() {
+	*x = ExportAccountResponse{}
+	mi := &file_database_server_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountResponse) ProtoMessage() {}
+
+func (x *ExportAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountResponse.ProtoReflect.Descriptor instead.
+func (*ExportAccountResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ExportAccountResponse) GetPatientInfo() *PatientInfo {
+	if x != nil {
+		return x.PatientInfo
+	}
+	return nil
+}
+
+func (x *ExportAccountResponse) GetConversations() []*Conversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+func (x *ExportAccountResponse) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+func (x *ExportAccountResponse) GetPatients() []*PatientProfile {
+	if x != nil {
+		return x.Patients
+	}
+	return nil
+}
+
+// Erases the account after checking the password again; the audit record outlives the account
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_database_server_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteAccountRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	AuditId       string                 `protobuf:"bytes,2,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_database_server_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteAccountResponse) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_database_server_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAccountRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	mi := &file_database_server_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type Account struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_database_server_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Account) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Account) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Account) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type Conversation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Messages      []*ChatMessage         `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix time in milliseconds
+	PatientId     int32                  `protobuf:"varint,4,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"` // 0 for the account's own profile
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Conversation) Reset() {
+	*x = Conversation{}
+	mi := &file_database_server_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversation) ProtoMessage() {}
+
+func (x *Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Conversation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Conversation) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *Conversation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Conversation) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix time in milliseconds
+	Summary       *DiagnosisSummary      `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`                       // structured output of an assistant reply, if any
+	Truncated     bool                   `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`                  // the reply was stopped or interrupted before it was complete
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_database_server_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChatMessage) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetSummary() *DiagnosisSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type DiagnosisSummary struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Differentials []*DiagnosisDifferential `protobuf:"bytes,1,rep,name=differentials,proto3" json:"differentials,omitempty"`
+	TriageLevel   string                   `protobuf:"bytes,2,opt,name=triage_level,json=triageLevel,proto3" json:"triage_level,omitempty"`
+	Specialties   []string                 `protobuf:"bytes,3,rep,name=specialties,proto3" json:"specialties,omitempty"`
+	RedFlags      []string                 `protobuf:"bytes,4,rep,name=red_flags,json=redFlags,proto3" json:"red_flags,omitempty"`
+	Disclaimers   []string                 `protobuf:"bytes,5,rep,name=disclaimers,proto3" json:"disclaimers,omitempty"`
+	NextSteps     []string                 `protobuf:"bytes,6,rep,name=next_steps,json=nextSteps,proto3" json:"next_steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosisSummary) Reset() {
+	*x = DiagnosisSummary{}
+	mi := &file_database_server_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosisSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosisSummary) ProtoMessage() {}
+
+func (x *DiagnosisSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosisSummary.ProtoReflect.Descriptor instead.
+func (*DiagnosisSummary) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DiagnosisSummary) GetDifferentials() []*DiagnosisDifferential {
+	if x != nil {
+		return x.Differentials
+	}
+	return nil
+}
+
+func (x *DiagnosisSummary) GetTriageLevel() string {
+	if x != nil {
+		return x.TriageLevel
+	}
+	return ""
+}
+
+func (x *DiagnosisSummary) GetSpecialties() []string {
+	if x != nil {
+		return x.Specialties
+	}
+	return nil
+}
+
+func (x *DiagnosisSummary) GetRedFlags() []string {
+	if x != nil {
+		return x.RedFlags
+	}
+	return nil
+}
+
+func (x *DiagnosisSummary) GetDisclaimers() []string {
+	if x != nil {
+		return x.Disclaimers
+	}
+	return nil
+}
+
+func (x *DiagnosisSummary) GetNextSteps() []string {
+	if x != nil {
+		return x.NextSteps
+	}
+	return nil
+}
+
+type DiagnosisDifferential struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Condition     string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Likelihood    float32                `protobuf:"fixed32,2,opt,name=likelihood,proto3" json:"likelihood,omitempty"` // 0 to 1
+	Rationale     string                 `protobuf:"bytes,3,opt,name=rationale,proto3" json:"rationale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosisDifferential) Reset() {
+	*x = DiagnosisDifferential{}
+	mi := &file_database_server_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosisDifferential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosisDifferential) ProtoMessage() {}
+
+func (x *DiagnosisDifferential) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosisDifferential.ProtoReflect.Descriptor instead.
+func (*DiagnosisDifferential) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DiagnosisDifferential) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *DiagnosisDifferential) GetLikelihood() float32 {
+	if x != nil {
+		return x.Likelihood
+	}
+	return 0
+}
+
+func (x *DiagnosisDifferential) GetRationale() string {
+	if x != nil {
+		return x.Rationale
+	}
+	return ""
+}
+
+// Audit events are append-only, the service exposes no way to change or delete them
+// The audit RPCs are called by the web server itself, authenticated by the shared service token
+type RecordAuditEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *AuditEvent            `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ServiceToken  string                 `protobuf:"bytes,2,opt,name=service_token,json=serviceToken,proto3" json:"service_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAuditEventRequest) Reset() {
+	*x = RecordAuditEventRequest{}
+	mi := &file_database_server_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAuditEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAuditEventRequest) ProtoMessage() {}
+
+func (x *RecordAuditEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAuditEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordAuditEventRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RecordAuditEventRequest) GetEvent() *AuditEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *RecordAuditEventRequest) GetServiceToken() string {
+	if x != nil {
+		return x.ServiceToken
+	}
+	return ""
+}
+
+type RecordAuditEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAuditEventResponse) Reset() {
+	*x = RecordAuditEventResponse{}
+	mi := &file_database_server_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAuditEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAuditEventResponse) ProtoMessage() {}
+
+func (x *RecordAuditEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAuditEventResponse.ProtoReflect.Descriptor instead.
+func (*RecordAuditEventResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RecordAuditEventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         string                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Since         int64                  `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"` // Unix time in milliseconds, 0 for no lower bound
+	Until         int64                  `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"` // Unix time in milliseconds, 0 for no upper bound
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	ServiceToken  string                 `protobuf:"bytes,7,opt,name=service_token,json=serviceToken,proto3" json:"service_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_database_server_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetServiceToken() string {
+	if x != nil {
+		return x.ServiceToken
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_database_server_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time          int64                  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"` // Unix time in milliseconds
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Outcome       string                 `protobuf:"bytes,6,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	RequestId     string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,8,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	Detail        string                 `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_database_server_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type RecordVitalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Vitals        *VitalSigns            `protobuf:"bytes,2,opt,name=vitals,proto3" json:"vitals,omitempty"`
+	PatientId     int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"` // 0 for the account's own profile
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordVitalsRequest) Reset() {
+	*x = RecordVitalsRequest{}
+	mi := &file_database_server_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordVitalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordVitalsRequest) ProtoMessage() {}
+
+func (x *RecordVitalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordVitalsRequest.ProtoReflect.Descriptor instead.
+func (*RecordVitalsRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RecordVitalsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RecordVitalsRequest) GetVitals() *VitalSigns {
+	if x != nil {
+		return x.Vitals
+	}
+	return nil
+}
+
+func (x *RecordVitalsRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+type RecordVitalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordVitalsResponse) Reset() {
+	*x = RecordVitalsResponse{}
+	mi := &file_database_server_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordVitalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordVitalsResponse) ProtoMessage() {}
+
+func (x *RecordVitalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordVitalsResponse.ProtoReflect.Descriptor instead.
+func (*RecordVitalsResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RecordVitalsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Lists the patient's vitals newest first
+type ListVitalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Since         int64                  `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"` // Unix time in milliseconds, 0 for no lower bound
+	Until         int64                  `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"` // Unix time in milliseconds, 0 for no upper bound
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	PatientId     int32                  `protobuf:"varint,5,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"` // 0 for the account's own profile
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVitalsRequest) Reset() {
+	*x = ListVitalsRequest{}
+	mi := &file_database_server_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVitalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVitalsRequest) ProtoMessage() {}
+
+func (x *ListVitalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVitalsRequest.ProtoReflect.Descriptor instead.
+func (*ListVitalsRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListVitalsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListVitalsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListVitalsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListVitalsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListVitalsRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+type ListVitalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vitals        []*VitalSigns          `protobuf:"bytes,1,rep,name=vitals,proto3" json:"vitals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVitalsResponse) Reset() {
+	*x = ListVitalsResponse{}
+	mi := &file_database_server_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVitalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVitalsResponse) ProtoMessage() {}
+
+func (x *ListVitalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVitalsResponse.ProtoReflect.Descriptor instead.
+func (*ListVitalsResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListVitalsResponse) GetVitals() []*VitalSigns {
+	if x != nil {
+		return x.Vitals
+	}
+	return nil
+}
+
+// A set of measurements taken at the same time, unmeasured fields are left unset
+type VitalSigns struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RecordedAt    int64                  `protobuf:"varint,2,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`    // Unix time in milliseconds
+	Systolic      *int32                 `protobuf:"varint,3,opt,name=systolic,proto3,oneof" json:"systolic,omitempty"`                    // mmHg
+	Diastolic     *int32                 `protobuf:"varint,4,opt,name=diastolic,proto3,oneof" json:"diastolic,omitempty"`                  // mmHg
+	HeartRate     *int32                 `protobuf:"varint,5,opt,name=heart_rate,json=heartRate,proto3,oneof" json:"heart_rate,omitempty"` // beats per minute
+	Temperature   *float32               `protobuf:"fixed32,6,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`             // Celsius
+	Spo2          *int32                 `protobuf:"varint,7,opt,name=spo2,proto3,oneof" json:"spo2,omitempty"`                            // percent
+	Glucose       *float32               `protobuf:"fixed32,8,opt,name=glucose,proto3,oneof" json:"glucose,omitempty"`                     // mg/dL
+	Weight        *float32               `protobuf:"fixed32,9,opt,name=weight,proto3,oneof" json:"weight,omitempty"`                       // kg
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VitalSigns) Reset() {
+	*x = VitalSigns{}
+	mi := &file_database_server_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VitalSigns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VitalSigns) ProtoMessage() {}
+
+func (x *VitalSigns) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VitalSigns.ProtoReflect.Descriptor instead.
+func (*VitalSigns) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VitalSigns) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VitalSigns) GetRecordedAt() int64 {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return 0
+}
+
+func (x *VitalSigns) GetSystolic() int32 {
+	if x != nil && x.Systolic != nil {
+		return *x.Systolic
+	}
+	return 0
+}
+
+func (x *VitalSigns) GetDiastolic() int32 {
+	if x != nil && x.Diastolic != nil {
+		return *x.Diastolic
+	}
+	return 0
+}
+
+func (x *VitalSigns) GetHeartRate() int32 {
+	if x != nil && x.HeartRate != nil {
+		return *x.HeartRate
+	}
+	return 0
+}
+
+func (x *VitalSigns) GetTemperature() float32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *VitalSigns) GetSpo2() int32 {
+	if x != nil && x.Spo2 != nil {
+		return *x.Spo2
+	}
+	return 0
+}
+
+func (x *VitalSigns) GetGlucose() float32 {
+	if x != nil && x.Glucose != nil {
+		return *x.Glucose
+	}
+	return 0
+}
+
+func (x *VitalSigns) GetWeight() float32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+// A patient profile managed by an account, which may belong to someone else the user cares for
+type PatientProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       int32                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Relationship  string                 `protobuf:"bytes,3,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	PatientInfo   *PatientInfo           `protobuf:"bytes,4,opt,name=patient_info,json=patientInfo,proto3" json:"patient_info,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatientProfile) Reset() {
+	*x = PatientProfile{}
+	mi := &file_database_server_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatientProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatientProfile) ProtoMessage() {}
+
+func (x *PatientProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatientProfile.ProtoReflect.Descriptor instead.
+func (*PatientProfile) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PatientProfile) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatientProfile) GetOwnerId() int32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *PatientProfile) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *PatientProfile) GetPatientInfo() *PatientInfo {
+	if x != nil {
+		return x.PatientInfo
+	}
+	return nil
+}
+
+func (x *PatientProfile) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ListPatientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPatientsRequest) Reset() {
+	*x = ListPatientsRequest{}
+	mi := &file_database_server_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPatientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPatientsRequest) ProtoMessage() {}
+
+func (x *ListPatientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPatientsRequest.ProtoReflect.Descriptor instead.
+func (*ListPatientsRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListPatientsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListPatientsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patients      []*PatientProfile      `protobuf:"bytes,1,rep,name=patients,proto3" json:"patients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPatientsResponse) Reset() {
+	*x = ListPatientsResponse{}
+	mi := &file_database_server_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPatientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPatientsResponse) ProtoMessage() {}
+
+func (x *ListPatientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPatientsResponse.ProtoReflect.Descriptor instead.
+func (*ListPatientsResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListPatientsResponse) GetPatients() []*PatientProfile {
+	if x != nil {
+		return x.Patients
+	}
+	return nil
+}
+
+type CreatePatientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Relationship  string                 `protobuf:"bytes,2,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	PatientInfo   *PatientInfo           `protobuf:"bytes,3,opt,name=patient_info,json=patientInfo,proto3" json:"patient_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePatientRequest) Reset() {
+	*x = CreatePatientRequest{}
+	mi := &file_database_server_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePatientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePatientRequest) ProtoMessage() {}
+
+func (x *CreatePatientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePatientRequest.ProtoReflect.Descriptor instead.
+func (*CreatePatientRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreatePatientRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreatePatientRequest) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *CreatePatientRequest) GetPatientInfo() *PatientInfo {
+	if x != nil {
+		return x.PatientInfo
+	}
+	return nil
+}
+
+type CreatePatientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patient       *PatientProfile        `protobuf:"bytes,1,opt,name=patient,proto3" json:"patient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePatientResponse) Reset() {
+	*x = CreatePatientResponse{}
+	mi := &file_database_server_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePatientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePatientResponse) ProtoMessage() {}
+
+func (x *CreatePatientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePatientResponse.ProtoReflect.Descriptor instead.
+func (*CreatePatientResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreatePatientResponse) GetPatient() *PatientProfile {
+	if x != nil {
+		return x.Patient
+	}
+	return nil
+}
+
+type GetPatientByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientByIdRequest) Reset() {
+	*x = GetPatientByIdRequest{}
+	mi := &file_database_server_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientByIdRequest) ProtoMessage() {}
+
+func (x *GetPatientByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetPatientByIdRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetPatientByIdRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetPatientByIdRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+type GetPatientByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patient       *PatientProfile        `protobuf:"bytes,1,opt,name=patient,proto3" json:"patient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientByIdResponse) Reset() {
+	*x = GetPatientByIdResponse{}
+	mi := &file_database_server_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientByIdResponse) ProtoMessage() {}
+
+func (x *GetPatientByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetPatientByIdResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetPatientByIdResponse) GetPatient() *PatientProfile {
+	if x != nil {
+		return x.Patient
+	}
+	return nil
+}
+
+// A mismatching expected_version fails with FAILED_PRECONDITION; 0 skips the check
+type UpdatePatientRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Token           string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	PatientId       int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Relationship    string                 `protobuf:"bytes,3,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	PatientInfo     *PatientInfo           `protobuf:"bytes,4,opt,name=patient_info,json=patientInfo,proto3" json:"patient_info,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdatePatientRequest) Reset() {
+	*x = UpdatePatientRequest{}
+	mi := &file_database_server_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePatientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePatientRequest) ProtoMessage() {}
+
+func (x *UpdatePatientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePatientRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePatientRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdatePatientRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *UpdatePatientRequest) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetPatientInfo() *PatientInfo {
+	if x != nil {
+		return x.PatientInfo
+	}
+	return nil
+}
+
+func (x *UpdatePatientRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdatePatientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patient       *PatientProfile        `protobuf:"bytes,1,opt,name=patient,proto3" json:"patient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePatientResponse) Reset() {
+	*x = UpdatePatientResponse{}
+	mi := &file_database_server_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePatientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePatientResponse) ProtoMessage() {}
+
+func (x *UpdatePatientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePatientResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePatientResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdatePatientResponse) GetPatient() *PatientProfile {
+	if x != nil {
+		return x.Patient
+	}
+	return nil
+}
+
+type DeletePatientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePatientRequest) Reset() {
+	*x = DeletePatientRequest{}
+	mi := &file_database_server_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePatientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePatientRequest) ProtoMessage() {}
+
+func (x *DeletePatientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePatientRequest.ProtoReflect.Descriptor instead.
+func (*DeletePatientRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeletePatientRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeletePatientRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+type DeletePatientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePatientResponse) Reset() {
+	*x = DeletePatientResponse{}
+	mi := &file_database_server_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePatientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePatientResponse) ProtoMessage() {}
+
+func (x *DeletePatientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePatientResponse.ProtoReflect.Descriptor instead.
+func (*DeletePatientResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeletePatientResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Marks a conversation for review by a healthcare professional after a red-flag triage match
+type FlagConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	PatientId      int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"` // 0 for the account's own profile
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RuleIds        []string               `protobuf:"bytes,5,rep,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
+	Severity       string                 `protobuf:"bytes,6,opt,name=severity,proto3" json:"severity,omitempty"` // "emergency" or "urgent"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FlagConversationRequest) Reset() {
+	*x = FlagConversationRequest{}
+	mi := &file_database_server_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagConversationRequest) ProtoMessage() {}
+
+func (x *FlagConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagConversationRequest.ProtoReflect.Descriptor instead.
+func (*FlagConversationRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FlagConversationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *FlagConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *FlagConversationRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *FlagConversationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FlagConversationRequest) GetRuleIds() []string {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+func (x *FlagConversationRequest) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+type FlagConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagConversationResponse) Reset() {
+	*x = FlagConversationResponse{}
+	mi := &file_database_server_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagConversationResponse) ProtoMessage() {}
+
+func (x *FlagConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagConversationResponse.ProtoReflect.Descriptor instead.
+func (*FlagConversationResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FlagConversationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Rolling summary of the oldest messages of a conversation, cached so it is not recomputed every turn
+type ConversationSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	MessageCount  int32                  `protobuf:"varint,2,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"` // how many messages, from the start of the conversation, the summary covers
+	MessagesHash  string                 `protobuf:"bytes,3,opt,name=messages_hash,json=messagesHash,proto3" json:"messages_hash,omitempty"`  // hash of those messages, to detect a history that changed
+	UpdatedAt     int64                  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`          // Unix time in milliseconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationSummary) Reset() {
+	*x = ConversationSummary{}
+	mi := &file_database_server_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationSummary) ProtoMessage() {}
+
+func (x *ConversationSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationSummary.ProtoReflect.Descriptor instead.
+func (*ConversationSummary) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ConversationSummary) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ConversationSummary) GetMessageCount() int32 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+func (x *ConversationSummary) GetMessagesHash() string {
+	if x != nil {
+		return x.MessagesHash
+	}
+	return ""
+}
+
+func (x *ConversationSummary) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetConversationSummaryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetConversationSummaryRequest) Reset() {
+	*x = GetConversationSummaryRequest{}
+	mi := &file_database_server_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationSummaryRequest) ProtoMessage() {}
+
+func (x *GetConversationSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetConversationSummaryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetConversationSummaryRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type GetConversationSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *ConversationSummary   `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"` // unset when the conversation has no summary yet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationSummaryResponse) Reset() {
+	*x = GetConversationSummaryResponse{}
+	mi := &file_database_server_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationSummaryResponse) ProtoMessage() {}
+
+func (x *GetConversationSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetConversationSummaryResponse) GetSummary() *ConversationSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type SaveConversationSummaryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Summary        *ConversationSummary   `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SaveConversationSummaryRequest) Reset() {
+	*x = SaveConversationSummaryRequest{}
+	mi := &file_database_server_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveConversationSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConversationSummaryRequest) ProtoMessage() {}
+
+func (x *SaveConversationSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConversationSummaryRequest.ProtoReflect.Descriptor instead.
+func (*SaveConversationSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SaveConversationSummaryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SaveConversationSummaryRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SaveConversationSummaryRequest) GetSummary() *ConversationSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type SaveConversationSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveConversationSummaryResponse) Reset() {
+	*x = SaveConversationSummaryResponse{}
+	mi := &file_database_server_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveConversationSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConversationSummaryResponse) ProtoMessage() {}
+
+func (x *SaveConversationSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConversationSummaryResponse.ProtoReflect.Descriptor instead.
+func (*SaveConversationSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SaveConversationSummaryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Tokens a diagnosis used, recorded by the gateway for cost accounting and quotas
+type UsageRecord struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        int32                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ConversationId   string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Model            string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	PromptTokens     int32                  `protobuf:"varint,4,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int32                  `protobuf:"varint,5,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	Time             int64                  `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"` // Unix time in milliseconds
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UsageRecord) Reset() {
+	*x = UsageRecord{}
+	mi := &file_database_server_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRecord) ProtoMessage() {}
+
+func (x *UsageRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRecord.ProtoReflect.Descriptor instead.
+func (*UsageRecord) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UsageRecord) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetPromptTokens() int32 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetCompletionTokens() int32 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// The usage RPCs are called by the web server itself, authenticated by the shared service token
+type RecordUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *UsageRecord           `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	ServiceToken  string                 `protobuf:"bytes,2,opt,name=service_token,json=serviceToken,proto3" json:"service_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageRequest) Reset() {
+	*x = RecordUsageRequest{}
+	mi := &file_database_server_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageRequest) ProtoMessage() {}
+
+func (x *RecordUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageRequest.ProtoReflect.Descriptor instead.
+func (*RecordUsageRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RecordUsageRequest) GetRecord() *UsageRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *RecordUsageRequest) GetServiceToken() string {
+	if x != nil {
+		return x.ServiceToken
+	}
+	return ""
+}
+
+type RecordUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageResponse) Reset() {
+	*x = RecordUsageResponse{}
+	mi := &file_database_server_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageResponse) ProtoMessage() {}
+
+func (x *RecordUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageResponse.ProtoReflect.Descriptor instead.
+func (*RecordUsageResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RecordUsageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int32                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // 0 for every account
+	Since         int64                  `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`                          // Unix time in milliseconds, 0 for no lower bound
+	Until         int64                  `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`                          // Unix time in milliseconds, 0 for no upper bound
+	ServiceToken  string                 `protobuf:"bytes,4,opt,name=service_token,json=serviceToken,proto3" json:"service_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageRequest) Reset() {
+	*x = ListUsageRequest{}
+	mi := &file_database_server_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageRequest) ProtoMessage() {}
+
+func (x *ListUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageRequest.ProtoReflect.Descriptor instead.
+func (*ListUsageRequest) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListUsageRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ListUsageRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListUsageRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListUsageRequest) GetServiceToken() string {
+	if x != nil {
+		return x.ServiceToken
+	}
+	return ""
+}
+
+// Usage summed per account, conversation and model
+type ListUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Totals        []*UsageTotal          `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageResponse) Reset() {
+	*x = ListUsageResponse{}
+	mi := &file_database_server_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageResponse) ProtoMessage() {}
+
+func (x *ListUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageResponse.ProtoReflect.Descriptor instead.
+func (*ListUsageResponse) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListUsageResponse) GetTotals() []*UsageTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type UsageTotal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        int32                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ConversationId   string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Model            string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	PromptTokens     int64                  `protobuf:"varint,4,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64                  `protobuf:"varint,5,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	Requests         int32                  `protobuf:"varint,6,opt,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UsageTotal) Reset() {
+	*x = UsageTotal{}
+	mi := &file_database_server_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageTotal) ProtoMessage() {}
+
+func (x *UsageTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_database_server_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageTotal.ProtoReflect.Descriptor instead.
+func (*UsageTotal) Descriptor() ([]byte, []int) {
+	return file_database_server_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UsageTotal) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *UsageTotal) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *UsageTotal) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *UsageTotal) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *UsageTotal) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *UsageTotal) GetRequests() int32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+var File_database_server_proto protoreflect.FileDescriptor
+
+const file_database_server_proto_rawDesc = "" +
+	"\n" +
+	"\x15database-server.proto\x12\bdatabase\"F\n" +
+	"\fLoginRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"%\n" +
+	"\rLoginResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"I\n" +
+	"\x0fRegisterRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"(\n" +
+	"\x10RegisterResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x93\x01\n" +
+	"\x16SavePatientInfoRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x128\n" +
+	"\fpatient_info\x18\x02 \x01(\v2\x15.database.PatientInfoR\vpatientInfo\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"M\n" +
+	"\x17SavePatientInfoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\")\n" +
+	"\x11GetPatientRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"h\n" +
+	"\x12GetPatientResponse\x128\n" +
+	"\fpatient_info\x18\x01 \x01(\v2\x15.database.PatientInfoR\vpatientInfo\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"\x8f\x03\n" +
+	"\vPatientInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x16\n" +
+	"\x06gender\x18\x03 \x01(\tR\x06gender\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x02R\x06weight\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x02R\x06height\x12/\n" +
+	"\tallergies\x18\x06 \x03(\v2\x11.database.AllergyR\tallergies\x126\n" +
+	"\vmedications\x18\a \x03(\v2\x14.database.MedicationR\vmedications\x123\n" +
+	"\n" +
+	"conditions\x18\b \x03(\v2\x13.database.ConditionR\n" +
+	"conditions\x12/\n" +
+	"\tsurgeries\x18\t \x03(\v2\x11.database.SurgeryR\tsurgeries\x12C\n" +
+	"\x0efamily_history\x18\n" +
+	" \x03(\v2\x1c.database.FamilyHistoryEntryR\rfamilyHistory\"_\n" +
+	"\aAllergy\x12\x1c\n" +
+	"\tsubstance\x18\x01 \x01(\tR\tsubstance\x12\x1a\n" +
+	"\breaction\x18\x02 \x01(\tR\breaction\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\"V\n" +
+	"\n" +
+	"Medication\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06dosage\x18\x02 \x01(\tR\x06dosage\x12\x1c\n" +
+	"\tfrequency\x18\x03 \x01(\tR\tfrequency\"\\\n" +
+	"\tCondition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0ediagnosed_year\x18\x02 \x01(\x05R\rdiagnosedYear\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"Q\n" +
+	"\aSurgery\x12\x1c\n" +
+	"\tprocedure\x18\x01 \x01(\tR\tprocedure\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"N\n" +
+	"\x12FamilyHistoryEntry\x12\x1a\n" +
+	"\brelative\x18\x01 \x01(\tR\brelative\x12\x1c\n" +
+	"\tcondition\x18\x02 \x01(\tR\tcondition\"\xaa\x01\n" +
+	"\x17SaveConversationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x121\n" +
+	"\bmessages\x18\x03 \x03(\v2\x15.database.ChatMessageR\bmessages\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x04 \x01(\x05R\tpatientId\"4\n" +
+	"\x18SaveConversationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\",\n" +
+	"\x14ExportAccountRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x8d\x02\n" +
+	"\x15ExportAccountResponse\x12+\n" +
+	"\aaccount\x18\x01 \x01(\v2\x11.database.AccountR\aaccount\x128\n" +
+	"\fpatient_info\x18\x02 \x01(\v2\x15.database.PatientInfoR\vpatientInfo\x12<\n" +
+	"\rconversations\x18\x03 \x03(\v2\x16.database.ConversationR\rconversations\x12\x19\n" +
+	"\baudit_id\x18\x04 \x01(\tR\aauditId\x124\n" +
+	"\bpatients\x18\x05 \x03(\v2\x18.database.PatientProfileR\bpatients\"H\n" +
+	"\x14DeleteAccountRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"L\n" +
+	"\x15DeleteAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x19\n" +
+	"\baudit_id\x18\x02 \x01(\tR\aauditId\")\n" +
+	"\x11GetAccountRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"A\n" +
+	"\x12GetAccountResponse\x12+\n" +
+	"\aaccount\x18\x01 \x01(\v2\x11.database.AccountR\aaccount\"I\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"\x8f\x01\n" +
+	"\fConversation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\bmessages\x18\x02 \x03(\v2\x15.database.ChatMessageR\bmessages\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x04 \x01(\x05R\tpatientId\"\xae\x01\n" +
+	"\vChatMessage\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x124\n" +
+	"\asummary\x18\x04 \x01(\v2\x1a.database.DiagnosisSummaryR\asummary\x12\x1c\n" +
+	"\ttruncated\x18\x05 \x01(\bR\ttruncated\"\xfc\x01\n" +
+	"\x10DiagnosisSummary\x12E\n" +
+	"\rdifferentials\x18\x01 \x03(\v2\x1f.database.DiagnosisDifferentialR\rdifferentials\x12!\n" +
+	"\ftriage_level\x18\x02 \x01(\tR\vtriageLevel\x12 \n" +
+	"\vspecialties\x18\x03 \x03(\tR\vspecialties\x12\x1b\n" +
+	"\tred_flags\x18\x04 \x03(\tR\bredFlags\x12 \n" +
+	"\vdisclaimers\x18\x05 \x03(\tR\vdisclaimers\x12\x1d\n" +
+	"\n" +
+	"next_steps\x18\x06 \x03(\tR\tnextSteps\"s\n" +
+	"\x15DiagnosisDifferential\x12\x1c\n" +
+	"\tcondition\x18\x01 \x01(\tR\tcondition\x12\x1e\n" +
+	"\n" +
+	"likelihood\x18\x02 \x01(\x02R\n" +
+	"likelihood\x12\x1c\n" +
+	"\trationale\x18\x03 \x01(\tR\trationale\"j\n" +
+	"\x17RecordAuditEventRequest\x12*\n" +
+	"\x05event\x18\x01 \x01(\v2\x14.database.AuditEventR\x05event\x12#\n" +
+	"\rservice_token\x18\x02 \x01(\tR\fserviceToken\"*\n" +
+	"\x18RecordAuditEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc9\x01\n" +
+	"\x16ListAuditEventsRequest\x12\x14\n" +
+	"\x05actor\x18\x01 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x12\x14\n" +
+	"\x05since\x18\x04 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x05 \x01(\x03R\x05until\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12#\n" +
+	"\rservice_token\x18\a \x01(\tR\fserviceToken\"G\n" +
+	"\x17ListAuditEventsResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.database.AuditEventR\x06events\"\xe8\x01\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\x03R\x04time\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x12\x18\n" +
+	"\aoutcome\x18\x06 \x01(\tR\aoutcome\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\x12\x1b\n" +
+	"\tclient_ip\x18\b \x01(\tR\bclientIp\x12\x16\n" +
+	"\x06detail\x18\t \x01(\tR\x06detail\"x\n" +
+	"\x13RecordVitalsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12,\n" +
+	"\x06vitals\x18\x02 \x01(\v2\x14.database.VitalSignsR\x06vitals\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x03 \x01(\x05R\tpatientId\"&\n" +
+	"\x14RecordVitalsResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8a\x01\n" +
+	"\x11ListVitalsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x03 \x01(\x03R\x05until\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x05 \x01(\x05R\tpatientId\"B\n" +
+	"\x12ListVitalsResponse\x12,\n" +
+	"\x06vitals\x18\x01 \x03(\v2\x14.database.VitalSignsR\x06vitals\"\xfb\x02\n" +
+	"\n" +
+	"VitalSigns\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrecorded_at\x18\x02 \x01(\x03R\n" +
+	"recordedAt\x12\x1f\n" +
+	"\bsystolic\x18\x03 \x01(\x05H\x00R\bsystolic\x88\x01\x01\x12!\n" +
+	"\tdiastolic\x18\x04 \x01(\x05H\x01R\tdiastolic\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"heart_rate\x18\x05 \x01(\x05H\x02R\theartRate\x88\x01\x01\x12%\n" +
+	"\vtemperature\x18\x06 \x01(\x02H\x03R\vtemperature\x88\x01\x01\x12\x17\n" +
+	"\x04spo2\x18\a \x01(\x05H\x04R\x04spo2\x88\x01\x01\x12\x1d\n" +
+	"\aglucose\x18\b \x01(\x02H\x05R\aglucose\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\t \x01(\x02H\x06R\x06weight\x88\x01\x01B\v\n" +
+	"\t_systolicB\f\n" +
+	"\n" +
+	"_diastolicB\r\n" +
+	"\v_heart_rateB\x0e\n" +
+	"\f_temperatureB\a\n" +
+	"\x05_spo2B\n" +
+	"\n" +
+	"\b_glucoseB\t\n" +
+	"\a_weight\"\xb3\x01\n" +
+	"\x0ePatientProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x05R\aownerId\x12\"\n" +
+	"\frelationship\x18\x03 \x01(\tR\frelationship\x128\n" +
+	"\fpatient_info\x18\x04 \x01(\v2\x15.database.PatientInfoR\vpatientInfo\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\"+\n" +
+	"\x13ListPatientsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"L\n" +
+	"\x14ListPatientsResponse\x124\n" +
+	"\bpatients\x18\x01 \x03(\v2\x18.database.PatientProfileR\bpatients\"\x8a\x01\n" +
+	"\x14CreatePatientRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frelationship\x18\x02 \x01(\tR\frelationship\x128\n" +
+	"\fpatient_info\x18\x03 \x01(\v2\x15.database.PatientInfoR\vpatientInfo\"K\n" +
+	"\x15CreatePatientResponse\x122\n" +
+	"\apatient\x18\x01 \x01(\v2\x18.database.PatientProfileR\apatient\"L\n" +
+	"\x15GetPatientByIdRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\"L\n" +
+	"\x16GetPatientByIdResponse\x122\n" +
+	"\apatient\x18\x01 \x01(\v2\x18.database.PatientProfileR\apatient\"\xd4\x01\n" +
+	"\x14UpdatePatientRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\"\n" +
+	"\frelationship\x18\x03 \x01(\tR\frelationship\x128\n" +
+	"\fpatient_info\x18\x04 \x01(\v2\x15.database.PatientInfoR\vpatientInfo\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\"K\n" +
+	"\x15UpdatePatientResponse\x122\n" +
+	"\apatient\x18\x01 \x01(\v2\x18.database.PatientProfileR\apatient\"K\n" +
+	"\x14DeletePatientRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\"1\n" +
+	"\x15DeletePatientResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc6\x01\n" +
+	"\x17FlagConversationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x03 \x01(\x05R\tpatientId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x19\n" +
+	"\brule_ids\x18\x05 \x03(\tR\aruleIds\x12\x1a\n" +
+	"\bseverity\x18\x06 \x01(\tR\bseverity\"4\n" +
+	"\x18FlagConversationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x98\x01\n" +
+	"\x13ConversationSummary\x12\x18\n" +
+	"\asummary\x18\x01 \x01(\tR\asummary\x12#\n" +
+	"\rmessage_count\x18\x02 \x01(\x05R\fmessageCount\x12#\n" +
+	"\rmessages_hash\x18\x03 \x01(\tR\fmessagesHash\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\"^\n" +
+	"\x1dGetConversationSummaryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\"Y\n" +
+	"\x1eGetConversationSummaryResponse\x127\n" +
+	"\asummary\x18\x01 \x01(\v2\x1d.database.ConversationSummaryR\asummary\"\x98\x01\n" +
+	"\x1eSaveConversationSummaryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x127\n" +
+	"\asummary\x18\x03 \x01(\v2\x1d.database.ConversationSummaryR\asummary\";\n" +
+	"\x1fSaveConversationSummaryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd1\x01\n" +
+	"\vUsageRecord\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x05R\taccountId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12#\n" +
+	"\rprompt_tokens\x18\x04 \x01(\x05R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x05 \x01(\x05R\x10completionTokens\x12\x12\n" +
+	"\x04time\x18\x06 \x01(\x03R\x04time\"h\n" +
+	"\x12RecordUsageRequest\x12-\n" +
+	"\x06record\x18\x01 \x01(\v2\x15.database.UsageRecordR\x06record\x12#\n" +
+	"\rservice_token\x18\x02 \x01(\tR\fserviceToken\"/\n" +
+	"\x13RecordUsageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x82\x01\n" +
+	"\x10ListUsageRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x05R\taccountId\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x03 \x01(\x03R\x05until\x12#\n" +
+	"\rservice_token\x18\x04 \x01(\tR\fserviceToken\"A\n" +
+	"\x11ListUsageResponse\x12,\n" +
+	"\x06totals\x18\x01 \x03(\v2\x14.database.UsageTotalR\x06totals\"\xd8\x01\n" +
+	"\n" +
+	"UsageTotal\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x05R\taccountId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12#\n" +
+	"\rprompt_tokens\x18\x04 \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x05 \x01(\x03R\x10completionTokens\x12\x1a\n" +
+	"\brequests\x18\x06 \x01(\x05R\brequests2\xd2\x0e\n" +
+	"\x0fDatabaseService\x12:\n" +
+	"\x05Login\x12\x16.database.LoginRequest\x1a\x17.database.LoginResponse\"\x00\x12C\n" +
+	"\bRegister\x12\x19.database.RegisterRequest\x1a\x1a.database.RegisterResponse\"\x00\x12X\n" +
+	"\x0fSavePatientInfo\x12 .database.SavePatientInfoRequest\x1a!.database.SavePatientInfoResponse\"\x00\x12I\n" +
+	"\n" +
+	"GetPatient\x12\x1b.database.GetPatientRequest\x1a\x1c.database.GetPatientResponse\"\x00\x12[\n" +
+	"\x10SaveConversation\x12!.database.SaveConversationRequest\x1a\".database.SaveConversationResponse\"\x00\x12R\n" +
+	"\rExportAccount\x12\x1e.database.ExportAccountRequest\x1a\x1f.database.ExportAccountResponse\"\x00\x12R\n" +
+	"\rDeleteAccount\x12\x1e.database.DeleteAccountRequest\x1a\x1f.database.DeleteAccountResponse\"\x00\x12I\n" +
+	"\n" +
+	"GetAccount\x12\x1b.database.GetAccountRequest\x1a\x1c.database.GetAccountResponse\"\x00\x12[\n" +
+	"\x10RecordAuditEvent\x12!.database.RecordAuditEventRequest\x1a\".database.RecordAuditEventResponse\"\x00\x12X\n" +
+	"\x0fListAuditEvents\x12 .database.ListAuditEventsRequest\x1a!.database.ListAuditEventsResponse\"\x00\x12O\n" +
+	"\fRecordVitals\x12\x1d.database.RecordVitalsRequest\x1a\x1e.database.RecordVitalsResponse\"\x00\x12I\n" +
 	"\n" +
-	"GetPatient\x12\x1b.database.GetPatientRequest\x1a\x1c.database.GetPatientResponse\"\x00B\x1dZ\x1bunb.br/web-server/src/protob\x06proto3"
+	"ListVitals\x12\x1b.database.ListVitalsRequest\x1a\x1c.database.ListVitalsResponse\"\x00\x12O\n" +
+	"\fListPatients\x12\x1d.database.ListPatientsRequest\x1a\x1e.database.ListPatientsResponse\"\x00\x12R\n" +
+	"\rCreatePatient\x12\x1e.database.CreatePatientRequest\x1a\x1f.database.CreatePatientResponse\"\x00\x12U\n" +
+	"\x0eGetPatientById\x12\x1f.database.GetPatientByIdRequest\x1a .database.GetPatientByIdResponse\"\x00\x12R\n" +
+	"\rUpdatePatient\x12\x1e.database.UpdatePatientRequest\x1a\x1f.database.UpdatePatientResponse\"\x00\x12R\n" +
+	"\rDeletePatient\x12\x1e.database.DeletePatientRequest\x1a\x1f.database.DeletePatientResponse\"\x00\x12[\n" +
+	"\x10FlagConversation\x12!.database.FlagConversationRequest\x1a\".database.FlagConversationResponse\"\x00\x12m\n" +
+	"\x16GetConversationSummary\x12'.database.GetConversationSummaryRequest\x1a(.database.GetConversationSummaryResponse\"\x00\x12p\n" +
+	"\x17SaveConversationSummary\x12(.database.SaveConversationSummaryRequest\x1a).database.SaveConversationSummaryResponse\"\x00\x12L\n" +
+	"\vRecordUsage\x12\x1c.database.RecordUsageRequest\x1a\x1d.database.RecordUsageResponse\"\x00\x12F\n" +
+	"\tListUsage\x12\x1a.database.ListUsageRequest\x1a\x1b.database.ListUsageResponse\"\x00B\x1dZ\x1bunb.br/web-server/src/protob\x06proto3"
 
 var (
 	file_database_server_proto_rawDescOnce sync.Once
@@ -522,34 +3957,151 @@ func file_database_server_proto_rawDescGZIP() []byte {
 	return file_database_server_proto_rawDescData
 }
 
-var file_database_server_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_database_server_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_database_server_proto_goTypes = []any{
-	(*LoginRequest)(nil),            // 0: database.LoginRequest
-	(*LoginResponse)(nil),           // 1: database.LoginResponse
-	(*RegisterRequest)(nil),         // 2: database.RegisterRequest
-	(*RegisterResponse)(nil),        // 3: database.RegisterResponse
-	(*SavePatientInfoRequest)(nil),  // 4: database.SavePatientInfoRequest
-	(*SavePatientInfoResponse)(nil), // 5: database.SavePatientInfoResponse
-	(*GetPatientRequest)(nil),       // 6: database.GetPatientRequest
-	(*GetPatientResponse)(nil),      // 7: database.GetPatientResponse
-	(*PatientInfo)(nil),             // 8: database.PatientInfo
+	(*LoginRequest)(nil),                    // 0: database.LoginRequest
+	(*LoginResponse)(nil),                   // 1: database.LoginResponse
+	(*RegisterRequest)(nil),                 // 2: database.RegisterRequest
+	(*RegisterResponse)(nil),                // 3: database.RegisterResponse
+	(*SavePatientInfoRequest)(nil),          // 4: database.SavePatientInfoRequest
+	(*SavePatientInfoResponse)(nil),         // 5: database.SavePatientInfoResponse
+	(*GetPatientRequest)(nil),               // 6: database.GetPatientRequest
+	(*GetPatientResponse)(nil),              // 7: database.GetPatientResponse
+	(*PatientInfo)(nil),                     // 8: database.PatientInfo
+	(*Allergy)(nil),                         // 9: database.Allergy
+	(*Medication)(nil),                      // 10: database.Medication
+	(*Condition)(nil),                       // 11: database.Condition
+	(*Surgery)(nil),                         // 12: database.Surgery
+	(*FamilyHistoryEntry)(nil),              // 13: database.FamilyHistoryEntry
+	(*SaveConversationRequest)(nil),         // 14: database.SaveConversationRequest
+	(*SaveConversationResponse)(nil),        // 15: database.SaveConversationResponse
+	(*ExportAccountRequest)(nil),            // 16: database.ExportAccountRequest
+	(*ExportAccountResponse)(nil),           // 17: database.ExportAccountResponse
+	(*DeleteAccountRequest)(nil),            // 18: database.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),           // 19: database.DeleteAccountResponse
+	(*GetAccountRequest)(nil),               // 20: database.GetAccountRequest
+	(*GetAccountResponse)(nil),              // 21: database.GetAccountResponse
+	(*Account)(nil),                         // 22: database.Account
+	(*Conversation)(nil),                    // 23: database.Conversation
+	(*ChatMessage)(nil),                     // 24: database.ChatMessage
+	(*DiagnosisSummary)(nil),                // 25: database.DiagnosisSummary
+	(*DiagnosisDifferential)(nil),           // 26: database.DiagnosisDifferential
+	(*RecordAuditEventRequest)(nil),         // 27: database.RecordAuditEventRequest
+	(*RecordAuditEventResponse)(nil),        // 28: database.RecordAuditEventResponse
+	(*ListAuditEventsRequest)(nil),          // 29: database.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),         // 30: database.ListAuditEventsResponse
+	(*AuditEvent)(nil),                      // 31: database.AuditEvent
+	(*RecordVitalsRequest)(nil),             // 32: database.RecordVitalsRequest
+	(*RecordVitalsResponse)(nil),            // 33: database.RecordVitalsResponse
+	(*ListVitalsRequest)(nil),               // 34: database.ListVitalsRequest
+	(*ListVitalsResponse)(nil),              // 35: database.ListVitalsResponse
+	(*VitalSigns)(nil),                      // 36: database.VitalSigns
+	(*PatientProfile)(nil),                  // 37: database.PatientProfile
+	(*ListPatientsRequest)(nil),             // 38: database.ListPatientsRequest
+	(*ListPatientsResponse)(nil),            // 39: database.ListPatientsResponse
+	(*CreatePatientRequest)(nil),            // 40: database.CreatePatientRequest
+	(*CreatePatientResponse)(nil),           // 41: database.CreatePatientResponse
+	(*GetPatientByIdRequest)(nil),           // 42: database.GetPatientByIdRequest
+	(*GetPatientByIdResponse)(nil),          // 43: database.GetPatientByIdResponse
+	(*UpdatePatientRequest)(nil),            // 44: database.UpdatePatientRequest
+	(*UpdatePatientResponse)(nil),           // 45: database.UpdatePatientResponse
+	(*DeletePatientRequest)(nil),            // 46: database.DeletePatientRequest
+	(*DeletePatientResponse)(nil),           // 47: database.DeletePatientResponse
+	(*FlagConversationRequest)(nil),         // 48: database.FlagConversationRequest
+	(*FlagConversationResponse)(nil),        // 49: database.FlagConversationResponse
+	(*ConversationSummary)(nil),             // 50: database.ConversationSummary
+	(*GetConversationSummaryRequest)(nil),   // 51: database.GetConversationSummaryRequest
+	(*GetConversationSummaryResponse)(nil),  // 52: database.GetConversationSummaryResponse
+	(*SaveConversationSummaryRequest)(nil),  // 53: database.SaveConversationSummaryRequest
+	(*SaveConversationSummaryResponse)(nil), // 54: database.SaveConversationSummaryResponse
+	(*UsageRecord)(nil),                     // 55: database.UsageRecord
+	(*RecordUsageRequest)(nil),              // 56: database.RecordUsageRequest
+	(*RecordUsageResponse)(nil),             // 57: database.RecordUsageResponse
+	(*ListUsageRequest)(nil),                // 58: database.ListUsageRequest
+	(*ListUsageResponse)(nil),               // 59: database.ListUsageResponse
+	(*UsageTotal)(nil),                      // 60: database.UsageTotal
 }
 var file_database_server_proto_depIdxs = []int32{
-	8, // 0: database.SavePatientInfoRequest.patient_info:type_name -> database.PatientInfo
-	8, // 1: database.GetPatientResponse.patient_info:type_name -> database.PatientInfo
-	0, // 2: database.DatabaseService.Login:input_type -> database.LoginRequest
-	2, // 3: database.DatabaseService.Register:input_type -> database.RegisterRequest
-	4, // 4: database.DatabaseService.SavePatientInfo:input_type -> database.SavePatientInfoRequest
-	6, // 5: database.DatabaseService.GetPatient:input_type -> database.GetPatientRequest
-	1, // 6: database.DatabaseService.Login:output_type -> database.LoginResponse
-	3, // 7: database.DatabaseService.Register:output_type -> database.RegisterResponse
-	5, // 8: database.DatabaseService.SavePatientInfo:output_type -> database.SavePatientInfoResponse
-	7, // 9: database.DatabaseService.GetPatient:output_type -> database.GetPatientResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8,  // 0: database.SavePatientInfoRequest.patient_info:type_name -> database.PatientInfo
+	8,  // 1: database.GetPatientResponse.patient_info:type_name -> database.PatientInfo
+	9,  // 2: database.PatientInfo.allergies:type_name -> database.Allergy
+	10, // 3: database.PatientInfo.medications:type_name -> database.Medication
+	11, // 4: database.PatientInfo.conditions:type_name -> database.Condition
+	12, // 5: database.PatientInfo.surgeries:type_name -> database.Surgery
+	13, // 6: database.PatientInfo.family_history:type_name -> database.FamilyHistoryEntry
+	24, // 7: database.SaveConversationRequest.messages:type_name -> database.ChatMessage
+	22, // 8: database.ExportAccountResponse.account:type_name -> database.Account
+	8,  // 9: database.ExportAccountResponse.patient_info:type_name -> database.PatientInfo
+	23, // 10: database.ExportAccountResponse.conversations:type_name -> database.Conversation
+	37, // 11: database.ExportAccountResponse.patients:type_name -> database.PatientProfile
+	22, // 12: database.GetAccountResponse.account:type_name -> database.Account
+	24, // 13: database.Conversation.messages:type_name -> database.ChatMessage
+	25, // 14: database.ChatMessage.summary:type_name -> database.DiagnosisSummary
+	26, // 15: database.DiagnosisSummary.differentials:type_name -> database.DiagnosisDifferential
+	31, // 16: database.RecordAuditEventRequest.event:type_name -> database.AuditEvent
+	31, // 17: database.ListAuditEventsResponse.events:type_name -> database.AuditEvent
+	36, // 18: database.RecordVitalsRequest.vitals:type_name -> database.VitalSigns
+	36, // 19: database.ListVitalsResponse.vitals:type_name -> database.VitalSigns
+	8,  // 20: database.PatientProfile.patient_info:type_name -> database.PatientInfo
+	37, // 21: database.ListPatientsResponse.patients:type_name -> database.PatientProfile
+	8,  // 22: database.CreatePatientRequest.patient_info:type_name -> database.PatientInfo
+	37, // 23: database.CreatePatientResponse.patient:type_name -> database.PatientProfile
+	37, // 24: database.GetPatientByIdResponse.patient:type_name -> database.PatientProfile
+	8,  // 25: database.UpdatePatientRequest.patient_info:type_name -> database.PatientInfo
+	37, // 26: database.UpdatePatientResponse.patient:type_name -> database.PatientProfile
+	50, // 27: database.GetConversationSummaryResponse.summary:type_name -> database.ConversationSummary
+	50, // 28: database.SaveConversationSummaryRequest.summary:type_name -> database.ConversationSummary
+	55, // 29: database.RecordUsageRequest.record:type_name -> database.UsageRecord
+	60, // 30: database.ListUsageResponse.totals:type_name -> database.UsageTotal
+	0,  // 31: database.DatabaseService.Login:input_type -> database.LoginRequest
+	2,  // 32: database.DatabaseService.Register:input_type -> database.RegisterRequest
+	4,  // 33: database.DatabaseService.SavePatientInfo:input_type -> database.SavePatientInfoRequest
+	6,  // 34: database.DatabaseService.GetPatient:input_type -> database.GetPatientRequest
+	14, // 35: database.DatabaseService.SaveConversation:input_type -> database.SaveConversationRequest
+	16, // 36: database.DatabaseService.ExportAccount:input_type -> database.ExportAccountRequest
+	18, // 37: database.DatabaseService.DeleteAccount:input_type -> database.DeleteAccountRequest
+	20, // 38: database.DatabaseService.GetAccount:input_type -> database.GetAccountRequest
+	27, // 39: database.DatabaseService.RecordAuditEvent:input_type -> database.RecordAuditEventRequest
+	29, // 40: database.DatabaseService.ListAuditEvents:input_type -> database.ListAuditEventsRequest
+	32, // 41: database.DatabaseService.RecordVitals:input_type -> database.RecordVitalsRequest
+	34, // 42: database.DatabaseService.ListVitals:input_type -> database.ListVitalsRequest
+	38, // 43: database.DatabaseService.ListPatients:input_type -> database.ListPatientsRequest
+	40, // 44: database.DatabaseService.CreatePatient:input_type -> database.CreatePatientRequest
+	42, // 45: database.DatabaseService.GetPatientById:input_type -> database.GetPatientByIdRequest
+	44, // 46: database.DatabaseService.UpdatePatient:input_type -> database.UpdatePatientRequest
+	46, // 47: database.DatabaseService.DeletePatient:input_type -> database.DeletePatientRequest
+	48, // 48: database.DatabaseService.FlagConversation:input_type -> database.FlagConversationRequest
+	51, // 49: database.DatabaseService.GetConversationSummary:input_type -> database.GetConversationSummaryRequest
+	53, // 50: database.DatabaseService.SaveConversationSummary:input_type -> database.SaveConversationSummaryRequest
+	56, // 51: database.DatabaseService.RecordUsage:input_type -> database.RecordUsageRequest
+	58, // 52: database.DatabaseService.ListUsage:input_type -> database.ListUsageRequest
+	1,  // 53: database.DatabaseService.Login:output_type -> database.LoginResponse
+	3,  // 54: database.DatabaseService.Register:output_type -> database.RegisterResponse
+	5,  // 55: database.DatabaseService.SavePatientInfo:output_type -> database.SavePatientInfoResponse
+	7,  // 56: database.DatabaseService.GetPatient:output_type -> database.GetPatientResponse
+	15, // 57: database.DatabaseService.SaveConversation:output_type -> database.SaveConversationResponse
+	17, // 58: database.DatabaseService.ExportAccount:output_type -> database.ExportAccountResponse
+	19, // 59: database.DatabaseService.DeleteAccount:output_type -> database.DeleteAccountResponse
+	21, // 60: database.DatabaseService.GetAccount:output_type -> database.GetAccountResponse
+	28, // 61: database.DatabaseService.RecordAuditEvent:output_type -> database.RecordAuditEventResponse
+	30, // 62: database.DatabaseService.ListAuditEvents:output_type -> database.ListAuditEventsResponse
+	33, // 63: database.DatabaseService.RecordVitals:output_type -> database.RecordVitalsResponse
+	35, // 64: database.DatabaseService.ListVitals:output_type -> database.ListVitalsResponse
+	39, // 65: database.DatabaseService.ListPatients:output_type -> database.ListPatientsResponse
+	41, // 66: database.DatabaseService.CreatePatient:output_type -> database.CreatePatientResponse
+	43, // 67: database.DatabaseService.GetPatientById:output_type -> database.GetPatientByIdResponse
+	45, // 68: database.DatabaseService.UpdatePatient:output_type -> database.UpdatePatientResponse
+	47, // 69: database.DatabaseService.DeletePatient:output_type -> database.DeletePatientResponse
+	49, // 70: database.DatabaseService.FlagConversation:output_type -> database.FlagConversationResponse
+	52, // 71: database.DatabaseService.GetConversationSummary:output_type -> database.GetConversationSummaryResponse
+	54, // 72: database.DatabaseService.SaveConversationSummary:output_type -> database.SaveConversationSummaryResponse
+	57, // 73: database.DatabaseService.RecordUsage:output_type -> database.RecordUsageResponse
+	59, // 74: database.DatabaseService.ListUsage:output_type -> database.ListUsageResponse
+	53, // [53:75] is the sub-list for method output_type
+	31, // [31:53] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_database_server_proto_init() }
@@ -557,13 +4109,14 @@ func file_database_server_proto_init() {
 	if File_database_server_proto != nil {
 		return
 	}
+	file_database_server_proto_msgTypes[36].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_server_proto_rawDesc), len(file_database_server_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
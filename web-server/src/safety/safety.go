@@ -0,0 +1,281 @@
+package safety
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// Actions taken when a rule matches
+const (
+	// ActionRedact replaces the match with the rule's replacement
+	ActionRedact = "redact"
+	// ActionAnnotate keeps the match and adds the rule's annotation after it
+	ActionAnnotate = "annotate"
+)
+
+// defaultWindow is how many bytes are held back when the rules file does not say
+const defaultWindow = 200
+
+// markdownDelimiters are kept when a match is redacted, so emphasis and code spans stay balanced
+const markdownDelimiters = "*_`~"
+
+// defaultRules is the rule set used when no rules file is configured
+//
+//go:embed default-rules.json
+var defaultRules []byte
+
+// Config is the format of a rules file
+type Config struct {
+	// Disclaimer is the markdown footer added to every reply
+	Disclaimer string `json:"disclaimer"`
+	// Window is how many bytes of a reply are held back, so patterns split across chunks are still found
+	Window int    `json:"window,omitempty"`
+	Rules  []Rule `json:"rules"`
+}
+
+// Rule describes text the AI service should not send as is.
+// Patterns are case insensitive and must stay within a line; matches longer than the window may be missed.
+type Rule struct {
+	ID       string   `json:"id"`
+	Action   string   `json:"action"`
+	Patterns []string `json:"patterns"`
+	// Replacement is the plain text put in place of a redacted match
+	Replacement string `json:"replacement,omitempty"`
+	// Annotation is the plain text added after an annotated match
+	Annotation string `json:"annotation,omitempty"`
+}
+
+// Filter checks streamed replies against a rule set and adds the disclaimer footer
+type Filter struct {
+	disclaimer string
+	window     int
+	rules      []compiledRule
+}
+
+// compiledRule is a rule with its patterns compiled
+type compiledRule struct {
+	Rule
+	patterns []*regexp.Regexp
+}
+
+// Load reads a rule set from a file, or the built-in one when path is empty
+func Load(path string) (*Filter, error) {
+	if path == "" {
+		return Parse(defaultRules)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read output filter rules: %v", err)
+	}
+	return Parse(data)
+}
+
+// Parse compiles a rule set; the disclaimer is mandatory
+func Parse(data []byte) (*Filter, error) {
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse output filter rules: %v", err)
+	}
+	if strings.TrimSpace(config.Disclaimer) == "" {
+		return nil, fmt.Errorf("output filter disclaimer is missing")
+	}
+
+	f := &Filter{
+		disclaimer: strings.TrimSpace(config.Disclaimer),
+		window:     config.Window,
+	}
+	if f.window <= 0 {
+		f.window = defaultWindow
+	}
+	for _, rule := range config.Rules {
+		if rule.ID == "" {
+			return nil, fmt.Errorf("output filter rule without an ID")
+		}
+		switch rule.Action {
+		case ActionRedact:
+			if rule.Replacement == "" {
+				return nil, fmt.Errorf("rule %s: replacement is required to redact", rule.ID)
+			}
+		case ActionAnnotate:
+			if rule.Annotation == "" {
+				return nil, fmt.Errorf("rule %s: annotation is required to annotate", rule.ID)
+			}
+		default:
+			return nil, fmt.Errorf("rule %s: action must be %s or %s", rule.ID, ActionRedact, ActionAnnotate)
+		}
+
+		compiled := compiledRule{Rule: rule}
+		for _, pattern := range rule.Patterns {
+			re, err := regexp.Compile("(?i)" + pattern)
+			if err != nil {
+				return nil, fmt.Errorf("rule %s: invalid pattern %q: %v", rule.ID, pattern, err)
+			}
+			compiled.patterns = append(compiled.patterns, re)
+		}
+		f.rules = append(f.rules, compiled)
+	}
+
+	return f, nil
+}
+
+// NewStream starts filtering a reply
+func (f *Filter) NewStream() grpc.OutputStream {
+	return &stream{filter: f}
+}
+
+// stream filters one reply over a sliding window: the newest bytes are held back until enough text follows them
+// to tell whether they belong to a match
+type stream struct {
+	filter  *Filter
+	pending string
+	// inFence tracks whether the released text is inside a fenced code block
+	inFence bool
+	// released tracks whether any text was released, which the footer then follows
+	released bool
+}
+
+// match is a rule match within the pending text
+type match struct {
+	start, end int
+	rule       *compiledRule
+}
+
+// Write takes the next chunk and returns the text that is ready to be sent
+func (s *stream) Write(chunk string) string {
+	s.pending += chunk
+	return s.release(false)
+}
+
+// Flush returns the text held back, followed by the disclaimer footer.
+// A reply that released nothing, such as one the AI service failed before answering, gets no footer either.
+func (s *stream) Flush() string {
+	var out strings.Builder
+	out.WriteString(s.release(true))
+	if !s.released {
+		return ""
+	}
+
+	// Close an unfinished code block, or the footer would be shown as code
+	if s.inFence {
+		out.WriteString("\n```")
+	}
+	out.WriteString("\n\n---\n\n")
+	out.WriteString(s.filter.disclaimer)
+	out.WriteString("\n")
+	return out.String()
+}
+
+// release filters and returns the pending text up to the window, or all of it when final
+func (s *stream) release(final bool) string {
+	matches := s.filter.matches(s.pending)
+
+	cut := len(s.pending)
+	if !final {
+		cut -= s.filter.window
+		if cut <= 0 {
+			return ""
+		}
+		// Cut at a word boundary, so the next patterns start at the beginning of a word, or at least between runes
+		if i := strings.LastIndexFunc(s.pending[:cut], unicode.IsSpace); i >= 0 {
+			_, size := utf8.DecodeRuneInString(s.pending[i:])
+			cut = i + size
+		}
+		for cut > 0 && !utf8.RuneStart(s.pending[cut]) {
+			cut--
+		}
+		// Keep runs of backticks together, or a code fence split across releases would not be tracked
+		if s.pending[cut] == '`' {
+			for cut > 0 && s.pending[cut-1] == '`' {
+				cut--
+			}
+		}
+		// A match across the cut waits for the next chunks
+		for _, m := range matches {
+			if m.start < cut && m.end > cut {
+				cut = m.start
+				break
+			}
+		}
+		if cut == 0 {
+			return ""
+		}
+	}
+
+	var out strings.Builder
+	last := 0
+	for _, m := range matches {
+		if m.end > cut {
+			break
+		}
+		out.WriteString(s.pending[last:m.start])
+		out.WriteString(m.rule.apply(s.pending[m.start:m.end]))
+		last = m.end
+	}
+	out.WriteString(s.pending[last:cut])
+	s.pending = s.pending[cut:]
+
+	text := out.String()
+	if text != "" {
+		s.released = true
+	}
+	if strings.Count(text, "```")%2 == 1 {
+		s.inFence = !s.inFence
+	}
+	return text
+}
+
+// matches finds the rule matches in a text, in order and without overlaps, the earliest and longest first
+func (f *Filter) matches(text string) []match {
+	var found []match
+	for i := range f.rules {
+		rule := &f.rules[i]
+		for _, re := range rule.patterns {
+			for _, loc := range re.FindAllStringIndex(text, -1) {
+				if loc[1] > loc[0] {
+					found = append(found, match{start: loc[0], end: loc[1], rule: rule})
+				}
+			}
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].start != found[j].start {
+			return found[i].start < found[j].start
+		}
+		return found[i].end > found[j].end
+	})
+
+	var kept []match
+	for _, m := range found {
+		if len(kept) > 0 && m.start < kept[len(kept)-1].end {
+			continue
+		}
+		kept = append(kept, m)
+	}
+	return kept
+}
+
+// apply redacts or annotates a match. Redactions keep the markdown delimiters of the match,
+// so a match ending inside bold text or a code span does not leave it open.
+func (r *compiledRule) apply(text string) string {
+	if r.Action == ActionAnnotate {
+		return text + " " + r.Annotation
+	}
+
+	var delimiters strings.Builder
+	for _, c := range text {
+		if strings.ContainsRune(markdownDelimiters, c) {
+			delimiters.WriteRune(c)
+		}
+	}
+	return r.Replacement + delimiters.String()
+}
@@ -0,0 +1,170 @@
+package safety
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// smallWindowRules holds back only a few bytes, so short replies already go through the sliding window
+const smallWindowRules = `{
+  "disclaimer": "DISCLAIMER",
+  "window": 16,
+  "rules": [
+    {"id": "dose", "action": "redact", "replacement": "[dose]", "patterns": ["\\b\\d+\\s?mg(?:/kg)?\\b"]},
+    {"id": "claim", "action": "annotate", "annotation": "(!)", "patterns": ["\\bvocê tem \\w+"]}
+  ]
+}`
+
+// parseRules parses a rule set, failing the test on error
+func parseRules(t *testing.T, config string) *Filter {
+	t.Helper()
+
+	filter, err := Parse([]byte(config))
+	if err != nil {
+		t.Fatalf("Parse() = %v", err)
+	}
+	return filter
+}
+
+// filterChunks streams the chunks through a new stream, failing the test if a write releases half a rune
+func filterChunks(t *testing.T, filter *Filter, chunks []string) string {
+	t.Helper()
+
+	stream := filter.NewStream()
+	var out strings.Builder
+	for _, chunk := range chunks {
+		released := stream.Write(chunk)
+		if !utf8.ValidString(released) {
+			t.Fatalf("Write(%q) released %q, which splits a rune", chunk, released)
+		}
+		out.WriteString(released)
+	}
+	out.WriteString(stream.Flush())
+	return out.String()
+}
+
+// splitEvery splits a text into chunks of n bytes, ignoring rune boundaries like a network read would
+func splitEvery(text string, n int) []string {
+	var chunks []string
+	for len(text) > n {
+		chunks = append(chunks, text[:n])
+		text = text[n:]
+	}
+	return append(chunks, text)
+}
+
+func TestStreamFiltersAcrossChunkBoundaries(t *testing.T) {
+	filter := parseRules(t, smallWindowRules)
+
+	reply := "Para febre, tome 500 mg de paracetamol. Crianças usam 10 mg/kg. Você tem gripe, provavelmente. Ação: repouso."
+	want := "Para febre, tome [dose] de paracetamol. Crianças usam [dose]. Você tem gripe (!), provavelmente. Ação: repouso.\n\n---\n\nDISCLAIMER\n"
+
+	if got := filterChunks(t, filter, []string{reply}); got != want {
+		t.Fatalf("single chunk = %q, want %q", got, want)
+	}
+	for n := 1; n <= len(reply); n++ {
+		if got := filterChunks(t, filter, splitEvery(reply, n)); got != want {
+			t.Errorf("chunks of %d bytes = %q, want %q", n, got, want)
+		}
+	}
+
+	// Every split of the reply in two, including inside the matches and inside multibyte runes
+	for i := 1; i < len(reply); i++ {
+		if got := filterChunks(t, filter, []string{reply[:i], reply[i:]}); got != want {
+			t.Errorf("split at byte %d = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestStreamHoldsBackTheWindow(t *testing.T) {
+	filter := parseRules(t, smallWindowRules)
+	stream := filter.NewStream()
+
+	// The dose is within the window, so it is held back until the text after it shows where the match ends
+	if released := stream.Write("Tome 500"); released != "" {
+		t.Fatalf("Write() = %q, want the text held back", released)
+	}
+	if released := stream.Write(" mg agora e volte amanhã "); strings.Contains(released, "500") {
+		t.Fatalf("Write() = %q, released the dose before it was redacted", released)
+	}
+	if got := stream.Flush(); !strings.HasSuffix(got, "DISCLAIMER\n") {
+		t.Errorf("Flush() = %q, want it to end with the disclaimer", got)
+	}
+}
+
+func TestStreamEmptyReply(t *testing.T) {
+	filter := parseRules(t, smallWindowRules)
+
+	// A reply that failed before its first chunk gets no footer on its own
+	if got := filter.NewStream().Flush(); got != "" {
+		t.Errorf("Flush() of an empty reply = %q, want nothing", got)
+	}
+	if got := filterChunks(t, filter, []string{"", ""}); got != "" {
+		t.Errorf("empty chunks = %q, want nothing", got)
+	}
+	if got := filterChunks(t, filter, []string{"Ok"}); got != "Ok\n\n---\n\nDISCLAIMER\n" {
+		t.Errorf("short reply = %q, want it followed by the footer", got)
+	}
+}
+
+func TestStreamDefaultRules(t *testing.T) {
+	filter, err := Load("")
+	if err != nil {
+		t.Fatalf("Load() = %v", err)
+	}
+
+	// Longer than the default window, so the dosages are released while the reply is still streaming
+	reply := strings.Repeat("Beba bastante água e descanse. ", 10) +
+		"Você pode tomar 750 mg de dipirona ou 20 gotas a cada 6 horas. " +
+		strings.Repeat("Observe a evolução dos sintomas. ", 10)
+	for _, n := range []int{1, 7, 64, len(reply)} {
+		got := filterChunks(t, filter, splitEvery(reply, n))
+		if strings.Contains(got, "750") || strings.Contains(got, "20 gotas") {
+			t.Errorf("chunks of %d bytes = %q, want the dosages redacted", n, got)
+		}
+		if strings.Count(got, "[dose omitida") != 2 || !strings.Contains(got, "SAMU (192)") {
+			t.Errorf("chunks of %d bytes = %q, want two redactions and the disclaimer", n, got)
+		}
+	}
+}
+
+func TestStreamKeepsMarkdownBalanced(t *testing.T) {
+	filter := parseRules(t, smallWindowRules)
+
+	// A redaction keeps the delimiters of its match
+	if got := filterChunks(t, filter, []string{"Tome **500 mg** hoje"}); !strings.HasPrefix(got, "Tome **[dose]** hoje") {
+		t.Errorf("bold dose = %q, want the emphasis kept around the redaction", got)
+	}
+
+	// An unfinished code block is closed before the footer, wherever the chunks were split
+	reply := "Exemplo:\n```\nsem dose aqui e mais texto"
+	for n := 1; n <= len(reply); n++ {
+		got := filterChunks(t, filter, splitEvery(reply, n))
+		if !strings.HasSuffix(got, "texto\n```\n\n---\n\nDISCLAIMER\n") {
+			t.Errorf("chunks of %d bytes = %q, want the code block closed before the footer", n, got)
+		}
+	}
+}
+
+func TestParseRejectsInvalidRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+	}{
+		{"missing disclaimer", `{"rules": []}`},
+		{"rule without ID", `{"disclaimer": "D", "rules": [{"action": "redact", "replacement": "x"}]}`},
+		{"redact without replacement", `{"disclaimer": "D", "rules": [{"id": "r", "action": "redact"}]}`},
+		{"annotate without annotation", `{"disclaimer": "D", "rules": [{"id": "r", "action": "annotate"}]}`},
+		{"unknown action", `{"disclaimer": "D", "rules": [{"id": "r", "action": "block"}]}`},
+		{"invalid pattern", `{"disclaimer": "D", "rules": [{"id": "r", "action": "redact", "replacement": "x", "patterns": ["(unclosed"]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.config)); err == nil {
+				t.Fatal("Parse() accepted an invalid rule set")
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package screening
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"unb.br/web-server/src/textnorm"
+)
+
+// heuristic detects a known prompt-injection technique
+type heuristic struct {
+	id      string
+	outcome string
+	// pattern runs on the normalized text, or on the raw text when raw is set
+	pattern *regexp.Regexp
+	raw     bool
+}
+
+// heuristics lists the built-in detectors, in English and Portuguese
+var heuristics = []heuristic{
+	{
+		id:      "ignore-instructions",
+		outcome: OutcomeBlock,
+		pattern: regexp.MustCompile(`\b(ignore|disregard|forget|override|bypass)\b.{0,20}\b(previous|prior|above|earlier|preceding|initial|system|your|all)\b.{0,20}\b(instructions?|prompts?|rules|guidelines)\b`),
+	},
+	{
+		// Patients also mention their doctor's instructions, so the instructions must be the previous ones or the assistant's
+		id:      "ignore-instructions",
+		outcome: OutcomeBlock,
+		pattern: regexp.MustCompile(`\b(ignore|ignora|desconsidere|desconsidera|esqueca|esquece)\b.{0,30}\b(instrucoes|regras|orientacoes|diretrizes|prompt)\b.{0,10}\b(anteriores|acima|previas|iniciais|do sistema)\b`),
+	},
+	{
+		id:      "ignore-instructions",
+		outcome: OutcomeBlock,
+		pattern: regexp.MustCompile(`\b(ignore|ignora|desconsidere|desconsidera|esqueca|esquece) (todas )?(as )?(suas|tuas) (instrucoes|regras|orientacoes|diretrizes)\b`),
+	},
+	{
+		id:      "jailbreak",
+		outcome: OutcomeBlock,
+		pattern: regexp.MustCompile(`\b(jailbreak|developer mode|modo (de )?desenvolvedor|do anything now|dan mode)\b`),
+	},
+	{
+		id:      "role-markers",
+		outcome: OutcomeBlock,
+		pattern: regexp.MustCompile(`(?im)(<\|(im_start|im_end|system|endoftext)\|>|\[/?inst\]|<<sys>>|^\s*#*\s*(system|developer)\s*:)`),
+		raw:     true,
+	},
+	{
+		id:      "role-override",
+		outcome: OutcomeWarn,
+		pattern: regexp.MustCompile(`\b(you are now|from now on,? you|pretend (to be|you are)|voce agora e|a partir de agora,? voce|finja (ser|que e))\b`),
+	},
+	{
+		id:      "prompt-leak",
+		outcome: OutcomeWarn,
+		pattern: regexp.MustCompile(`\b(system prompt|initial prompt|your instructions|prompt (do|de) sistema|suas instrucoes)\b`),
+	},
+	{
+		id:      "encoded-payload",
+		outcome: OutcomeWarn,
+		pattern: regexp.MustCompile(`[A-Za-z0-9+/]{200,}={0,2}`),
+		raw:     true,
+	},
+}
+
+// Heuristics detects common prompt-injection techniques
+type Heuristics struct{}
+
+// Name identifies the heuristics in the audit log
+func (Heuristics) Name() string {
+	return "heuristics"
+}
+
+// Screen runs a message through every detector
+func (Heuristics) Screen(ctx context.Context, text string) (string, []string, error) {
+	normalized := textnorm.Fold(text)
+
+	outcome := OutcomeAllow
+	var reasons []string
+	for _, h := range heuristics {
+		subject := normalized
+		if h.raw {
+			subject = text
+		}
+		if !h.pattern.MatchString(subject) || slices.Contains(reasons, h.id) {
+			continue
+		}
+		reasons = append(reasons, h.id)
+		if strictness(h.outcome) > strictness(outcome) {
+			outcome = h.outcome
+		}
+	}
+	return outcome, reasons, nil
+}
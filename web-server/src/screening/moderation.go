@@ -0,0 +1,36 @@
+package screening
+
+import (
+	"context"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// Moderator is the part of the AI client the moderation check uses
+type Moderator interface {
+	Moderate(ctx context.Context, input grpc.ModerateInput) (*grpc.ModerateOutput, error)
+}
+
+// Moderation asks the AI service's Moderate RPC about each message
+type Moderation struct {
+	client Moderator
+}
+
+// NewModeration creates a moderation check using an AI client
+func NewModeration(client Moderator) *Moderation {
+	return &Moderation{client: client}
+}
+
+// Name identifies the moderation check in the audit log
+func (m *Moderation) Name() string {
+	return "moderation"
+}
+
+// Screen returns the AI service's outcome, with the flagged categories as reasons
+func (m *Moderation) Screen(ctx context.Context, text string) (string, []string, error) {
+	output, err := m.client.Moderate(ctx, grpc.ModerateInput{Text: text})
+	if err != nil {
+		return "", nil, err
+	}
+	return output.Outcome, output.Categories, nil
+}
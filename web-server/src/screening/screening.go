@@ -0,0 +1,233 @@
+package screening
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"unb.br/web-server/src/textnorm"
+)
+
+// Outcomes of a check, from the mildest to the strictest
+const (
+	// OutcomeAllow lets the message through
+	OutcomeAllow = "allow"
+	// OutcomeWarn lets the message through, but records it for review
+	OutcomeWarn = "warn"
+	// OutcomeBlock answers with a refusal, without asking the AI service
+	OutcomeBlock = "block"
+)
+
+// defaultRules is the denylist and refusal used when no rules file is configured
+//
+//go:embed default-rules.json
+var defaultRules []byte
+
+// Check screens a user message before it reaches the AI service
+type Check interface {
+	// Name identifies the check in the audit log
+	Name() string
+	// Screen returns the outcome for a message and what triggered it
+	Screen(ctx context.Context, text string) (string, []string, error)
+}
+
+// Config is the format of a rules file
+type Config struct {
+	// Refusal is the markdown streamed back when a message is blocked
+	Refusal  string          `json:"refusal"`
+	Denylist []DenylistEntry `json:"denylist"`
+}
+
+// DenylistEntry describes terms that are not allowed in a message.
+// Matching runs on lowercase text with accents removed, so keywords and patterns must be written the same way.
+type DenylistEntry struct {
+	ID       string   `json:"id"`
+	Outcome  string   `json:"outcome"`
+	Keywords []string `json:"keywords"`
+	Patterns []string `json:"patterns"`
+}
+
+// Verdict represents the outcome of one check
+type Verdict struct {
+	Check   string
+	Outcome string
+	// Reasons names what triggered the outcome, such as heuristic IDs or moderation categories
+	Reasons []string
+	// Err is set when the check could not run, in which case it allows the message
+	Err error
+}
+
+// Result represents the outcome of the whole pipeline, the strictest of its checks
+type Result struct {
+	Outcome  string
+	Verdicts []Verdict
+}
+
+// Pipeline runs a message through a list of checks
+type Pipeline struct {
+	refusal string
+	checks  []Check
+}
+
+// Load reads the denylist and refusal from a file, or the built-in ones when path is empty
+func Load(path string) (*Config, error) {
+	data := defaultRules
+	if path != "" {
+		var err error
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read screening rules: %v", err)
+		}
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse screening rules: %v", err)
+	}
+	if strings.TrimSpace(config.Refusal) == "" {
+		return nil, fmt.Errorf("screening refusal is missing")
+	}
+	return &config, nil
+}
+
+// New creates a pipeline streaming the refusal when a check blocks a message
+func New(refusal string, checks ...Check) *Pipeline {
+	return &Pipeline{refusal: refusal, checks: checks}
+}
+
+// Refusal returns the markdown streamed back when a message is blocked
+func (p *Pipeline) Refusal() string {
+	return p.refusal
+}
+
+// Screen runs a message through every check. A check that fails is recorded and allows the message,
+// so an unavailable moderation service does not stop diagnoses.
+func (p *Pipeline) Screen(ctx context.Context, text string) Result {
+	result := Result{Outcome: OutcomeAllow}
+	for _, check := range p.checks {
+		outcome, reasons, err := check.Screen(ctx, text)
+		if err != nil {
+			outcome, reasons = OutcomeAllow, nil
+		}
+
+		result.Verdicts = append(result.Verdicts, Verdict{
+			Check:   check.Name(),
+			Outcome: outcome,
+			Reasons: reasons,
+			Err:     err,
+		})
+		if strictness(outcome) > strictness(result.Outcome) {
+			result.Outcome = outcome
+		}
+	}
+	return result
+}
+
+// Detail formats the verdicts for the audit log, such as "heuristics=block(ignore-instructions) moderation=error"
+func (r Result) Detail() string {
+	parts := make([]string, len(r.Verdicts))
+	for i, verdict := range r.Verdicts {
+		switch {
+		case verdict.Err != nil:
+			parts[i] = verdict.Check + "=error"
+		case len(verdict.Reasons) > 0:
+			parts[i] = fmt.Sprintf("%s=%s(%s)", verdict.Check, verdict.Outcome, strings.Join(verdict.Reasons, ","))
+		default:
+			parts[i] = verdict.Check + "=" + verdict.Outcome
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// strictness orders the outcomes, unknown ones counting as warnings
+func strictness(outcome string) int {
+	switch outcome {
+	case OutcomeAllow:
+		return 0
+	case OutcomeBlock:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// Denylist blocks or flags messages containing configured terms
+type Denylist struct {
+	entries []compiledEntry
+}
+
+// compiledEntry is a denylist entry with its patterns compiled
+type compiledEntry struct {
+	DenylistEntry
+	keywords []string
+	patterns []*regexp.Regexp
+}
+
+// NewDenylist compiles the denylist of a rules file
+func NewDenylist(entries []DenylistEntry) (*Denylist, error) {
+	d := &Denylist{}
+	for _, entry := range entries {
+		if entry.ID == "" {
+			return nil, fmt.Errorf("denylist entry without an ID")
+		}
+		if entry.Outcome != OutcomeWarn && entry.Outcome != OutcomeBlock {
+			return nil, fmt.Errorf("denylist entry %s: outcome must be %s or %s", entry.ID, OutcomeWarn, OutcomeBlock)
+		}
+
+		compiled := compiledEntry{DenylistEntry: entry}
+		for _, keyword := range entry.Keywords {
+			compiled.keywords = append(compiled.keywords, textnorm.Fold(keyword))
+		}
+		for _, pattern := range entry.Patterns {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("denylist entry %s: invalid pattern %q: %v", entry.ID, pattern, err)
+			}
+			compiled.patterns = append(compiled.patterns, re)
+		}
+		d.entries = append(d.entries, compiled)
+	}
+	return d, nil
+}
+
+// Name identifies the denylist in the audit log
+func (d *Denylist) Name() string {
+	return "denylist"
+}
+
+// Screen matches a message against every entry
+func (d *Denylist) Screen(ctx context.Context, text string) (string, []string, error) {
+	text = textnorm.Fold(text)
+
+	outcome := OutcomeAllow
+	var reasons []string
+	for _, entry := range d.entries {
+		if !entry.matches(text) {
+			continue
+		}
+		reasons = append(reasons, entry.ID)
+		if strictness(entry.Outcome) > strictness(outcome) {
+			outcome = entry.Outcome
+		}
+	}
+	return outcome, reasons, nil
+}
+
+// matches reports whether a normalized message contains any keyword or pattern of the entry
+func (e *compiledEntry) matches(text string) bool {
+	for _, keyword := range e.keywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+	for _, re := range e.patterns {
+		if re.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
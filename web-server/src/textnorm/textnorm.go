@@ -0,0 +1,18 @@
+package textnorm
+
+import "strings"
+
+// accentFolds maps accented Latin letters to their base letter
+var accentFolds = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// Fold lowercases text, removes accents and collapses whitespace, so keywords match however a message is typed
+func Fold(text string) string {
+	return strings.Join(strings.Fields(accentFolds.Replace(strings.ToLower(text))), " ")
+}
@@ -0,0 +1,298 @@
+package triage
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"unb.br/web-server/src/grpc"
+	"unb.br/web-server/src/textnorm"
+)
+
+// Actions taken when a rule matches
+const (
+	// ActionReplace answers with the guidance block only, without asking the AI service
+	ActionReplace = "replace"
+	// ActionPrepend streams the guidance block before the AI answer
+	ActionPrepend = "prepend"
+)
+
+// Severities of a match, from the most to the least serious
+const (
+	SeverityEmergency = "emergency"
+	SeverityUrgent    = "urgent"
+)
+
+// defaultRules is the rule set used when no rules file is configured
+//
+//go:embed default-rules.json
+var defaultRules []byte
+
+// Config is the format of a rules file
+type Config struct {
+	// DefaultLanguage selects the guidance when the matching language has none
+	DefaultLanguage string `json:"default_language"`
+	// Guidance maps severities, then languages, to the markdown block streamed on a match
+	Guidance map[string]map[string]string `json:"guidance"`
+	// Negations maps languages to the words that deny a symptom, such as "nao" or "no".
+	// A match preceded by one of them, at most two words before and in the same clause, is ignored.
+	Negations map[string][]string `json:"negations"`
+	// ClauseBreaks maps languages to the words that end the scope of a negation, such as "mas" or "but"
+	ClauseBreaks map[string][]string `json:"clause_breaks"`
+	Rules        []Rule              `json:"rules"`
+}
+
+// Rule describes a set of red-flag symptoms
+type Rule struct {
+	ID       string `json:"id"`
+	Severity string `json:"severity"`
+	Action   string `json:"action"`
+	// Match maps languages to the keywords and patterns that trigger the rule
+	Match map[string]MatchSet `json:"match"`
+	// MinAge and MaxAge restrict the rule to an age range, zero meaning unbounded
+	MinAge int32 `json:"min_age,omitempty"`
+	MaxAge int32 `json:"max_age,omitempty"`
+	// Conditions restricts the rule to patients with any of these conditions
+	Conditions []string `json:"conditions,omitempty"`
+	// Guidance overrides the default guidance per language
+	Guidance map[string]string `json:"guidance,omitempty"`
+	// MatchNegated keeps negated matches, for symptoms where even a denial warrants the guidance
+	MatchNegated bool `json:"match_negated,omitempty"`
+}
+
+// MatchSet lists what triggers a rule in one language.
+// Matching runs on lowercase text with accents removed, so keywords and patterns must be written the same way.
+// Keywords only match whole words, while patterns need their own \b anchors.
+type MatchSet struct {
+	Keywords []string `json:"keywords"`
+	Patterns []string `json:"patterns"`
+}
+
+// Result represents the outcome of a triage check that matched at least one rule
+type Result struct {
+	// Rules lists the IDs of every matching rule
+	Rules    []string
+	Severity string
+	Action   string
+	Language string
+	// Guidance is the markdown block to stream to the patient
+	Guidance string
+}
+
+// Triage checks messages against a red-flag rule set
+type Triage struct {
+	defaultLanguage string
+	guidance        map[string]map[string]string
+	negations       map[string]*negation
+	rules           []compiledRule
+}
+
+// negation detects a denied symptom in one language
+type negation struct {
+	// cue matches a negation at the end of the text before a match
+	cue *regexp.Regexp
+	// breaks matches the words that end a clause, nil when there are none
+	breaks *regexp.Regexp
+}
+
+// compiledRule is a rule with its keywords and patterns compiled
+type compiledRule struct {
+	Rule
+	languages  []string
+	triggers   map[string][]*regexp.Regexp
+	conditions []string
+}
+
+// Load reads a rule set from a file, or the built-in one when path is empty
+func Load(path string) (*Triage, error) {
+	if path == "" {
+		return Parse(defaultRules)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read triage rules: %v", err)
+	}
+	return Parse(data)
+}
+
+// Parse compiles a rule set, rejecting rules that could never produce a response
+func Parse(data []byte) (*Triage, error) {
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse triage rules: %v", err)
+	}
+	for _, severity := range []string{SeverityEmergency, SeverityUrgent} {
+		if _, ok := config.Guidance[severity][config.DefaultLanguage]; !ok {
+			return nil, fmt.Errorf("%s guidance is missing for default language %q", severity, config.DefaultLanguage)
+		}
+	}
+
+	t := &Triage{
+		defaultLanguage: config.DefaultLanguage,
+		guidance:        config.Guidance,
+		negations:       map[string]*negation{},
+	}
+	for language, words := range config.Negations {
+		if len(words) == 0 {
+			continue
+		}
+		// The negation may be followed by up to two words, such as "nao tenho" or "no sudden", without punctuation
+		n := &negation{cue: regexp.MustCompile(`\b` + wordsPattern(words) + `(?:\s+[^\s.,;:!?]+){0,2}\s*$`)}
+		if breaks := config.ClauseBreaks[language]; len(breaks) > 0 {
+			n.breaks = regexp.MustCompile(`\b` + wordsPattern(breaks) + `\b`)
+		}
+		t.negations[language] = n
+	}
+
+	for _, rule := range config.Rules {
+		if rule.ID == "" {
+			return nil, fmt.Errorf("triage rule without an ID")
+		}
+		if rule.Action != ActionReplace && rule.Action != ActionPrepend {
+			return nil, fmt.Errorf("rule %s: action must be %s or %s", rule.ID, ActionReplace, ActionPrepend)
+		}
+		if rule.Severity != SeverityEmergency && rule.Severity != SeverityUrgent {
+			return nil, fmt.Errorf("rule %s: severity must be %s or %s", rule.ID, SeverityEmergency, SeverityUrgent)
+		}
+
+		compiled := compiledRule{
+			Rule:     rule,
+			triggers: map[string][]*regexp.Regexp{},
+		}
+		for language, set := range rule.Match {
+			compiled.languages = append(compiled.languages, language)
+			for _, keyword := range set.Keywords {
+				re := regexp.MustCompile(`\b` + regexp.QuoteMeta(textnorm.Fold(keyword)) + `\b`)
+				compiled.triggers[language] = append(compiled.triggers[language], re)
+			}
+			for _, pattern := range set.Patterns {
+				re, err := regexp.Compile(pattern)
+				if err != nil {
+					return nil, fmt.Errorf("rule %s: invalid pattern %q: %v", rule.ID, pattern, err)
+				}
+				compiled.triggers[language] = append(compiled.triggers[language], re)
+			}
+		}
+		sort.Strings(compiled.languages)
+		for _, condition := range rule.Conditions {
+			compiled.conditions = append(compiled.conditions, textnorm.Fold(condition))
+		}
+
+		t.rules = append(t.rules, compiled)
+	}
+
+	return t, nil
+}
+
+// wordsPattern builds an alternation matching any of the words, folded like the text they are matched against
+func wordsPattern(words []string) string {
+	quoted := make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = regexp.QuoteMeta(textnorm.Fold(word))
+	}
+	return "(?:" + strings.Join(quoted, "|") + ")"
+}
+
+// denies reports whether the text before a match ends with a negation in the same clause
+func (n *negation) denies(before string) bool {
+	if n.breaks != nil {
+		if breaks := n.breaks.FindAllStringIndex(before, -1); len(breaks) > 0 {
+			before = before[breaks[len(breaks)-1][1]:]
+		}
+	}
+	return n.cue.MatchString(before)
+}
+
+// Check runs a message through every rule, returning nil when none matches.
+// When several rules match, the strongest action and severity win. The guidance comes from the first rule
+// of the strongest severity, preferring one that replaces the answer.
+func (t *Triage) Check(message string, patient grpc.PatientInfo) *Result {
+	text := textnorm.Fold(message)
+
+	var result *Result
+	var guidanceRule *compiledRule
+	for i := range t.rules {
+		rule := &t.rules[i]
+		language, ok := rule.matches(text, patient, t.negations)
+		if !ok {
+			continue
+		}
+
+		if result == nil {
+			result = &Result{Severity: rule.Severity, Action: rule.Action, Language: language}
+			guidanceRule = rule
+		}
+		result.Rules = append(result.Rules, rule.ID)
+		if rule.Severity == SeverityEmergency && result.Severity != SeverityEmergency {
+			result.Severity, result.Language, guidanceRule = SeverityEmergency, language, rule
+		}
+		if rule.Action == ActionReplace {
+			result.Action = ActionReplace
+			if rule.Severity == result.Severity && guidanceRule.Action != ActionReplace {
+				result.Language, guidanceRule = language, rule
+			}
+		}
+	}
+
+	if result != nil {
+		result.Guidance = t.guidanceFor(guidanceRule, result.Severity, result.Language)
+	}
+	return result
+}
+
+// guidanceFor picks the guidance of a rule in a language, falling back to the rule set's guidance for the severity
+// and then to the default language
+func (t *Triage) guidanceFor(rule *compiledRule, severity, language string) string {
+	for _, lang := range []string{language, t.defaultLanguage} {
+		for _, candidates := range []map[string]string{rule.Guidance, t.guidance[severity]} {
+			if text, ok := candidates[lang]; ok {
+				return text
+			}
+		}
+	}
+	return ""
+}
+
+// matches checks the patient restrictions and the triggers of a rule, returning the language that matched.
+// Triggers preceded by a negation of the same language are skipped unless the rule matches negations.
+func (r *compiledRule) matches(text string, patient grpc.PatientInfo, negations map[string]*negation) (string, bool) {
+	if r.MinAge > 0 && patient.Age < r.MinAge {
+		return "", false
+	}
+	if r.MaxAge > 0 && patient.Age > r.MaxAge {
+		return "", false
+	}
+	if len(r.conditions) > 0 && !hasCondition(patient, r.conditions) {
+		return "", false
+	}
+
+	for _, language := range r.languages {
+		denial := negations[language]
+		for _, re := range r.triggers[language] {
+			for _, match := range re.FindAllStringIndex(text, -1) {
+				if r.MatchNegated || denial == nil || !denial.denies(text[:match[0]]) {
+					return language, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// hasCondition reports whether the patient has any of the conditions, by substring
+func hasCondition(patient grpc.PatientInfo, conditions []string) bool {
+	for _, known := range patient.Conditions {
+		name := textnorm.Fold(known.Name)
+		for _, condition := range conditions {
+			if strings.Contains(name, condition) {
+				return true
+			}
+		}
+	}
+	return false
+}
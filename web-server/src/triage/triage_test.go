@@ -0,0 +1,182 @@
+package triage
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// loadDefault loads the built-in rule set, failing the test on error
+func loadDefault(t *testing.T) *Triage {
+	t.Helper()
+
+	rules, err := Load("")
+	if err != nil {
+		t.Fatalf("Load() = %v", err)
+	}
+	return rules
+}
+
+func TestCheckDefaultRules(t *testing.T) {
+	rules := loadDefault(t)
+	adult := grpc.PatientInfo{Age: 40}
+	diabetic := grpc.PatientInfo{Age: 40, Conditions: []grpc.Condition{{Name: "Diabetes tipo 2"}}}
+
+	tests := []struct {
+		name    string
+		message string
+		patient grpc.PatientInfo
+		rules   []string
+	}{
+		{"chest pain", "Estou com uma dor no peito forte", adult, []string{"cardiac-chest-pain"}},
+		{"accents and case", "DOR NO PEITO e dor torácica", adult, []string{"cardiac-chest-pain"}},
+		{"radiating pain", "a dor começou no peito e irradia para o braço esquerdo", adult, []string{"cardiac-chest-pain"}},
+		{"english stroke", "I think my father had a stroke", adult, []string{"stroke-signs"}},
+		{"stroke and breathing", "fala enrolada e não consigo respirar", adult, []string{"stroke-signs", "severe-breathing"}},
+		{"no symptoms", "Tenho dor de cabeça leve desde ontem", adult, nil},
+		{"heatstroke is not a stroke", "I had heatstroke last summer", adult, nil},
+		{"avc inside a word", "Moro na cidade de Cravcity", adult, nil},
+		{"shaking inside a word", "after a handshaking event", diabetic, nil},
+		{"diabetic fainting", "Ela desmaiou agora há pouco", diabetic, []string{"diabetic-hypoglycemia"}},
+		{"fainting without diabetes", "Ela desmaiou agora há pouco", adult, nil},
+		{"elderly fever and confusion", "febre alta e está confuso", grpc.PatientInfo{Age: 80}, []string{"elderly-fever-confusion"}},
+		{"young fever and confusion", "febre alta e está confuso", adult, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := rules.Check(tt.message, tt.patient)
+			var got []string
+			if result != nil {
+				got = result.Rules
+			}
+			if !reflect.DeepEqual(got, tt.rules) {
+				t.Fatalf("Check(%q) rules = %v, want %v", tt.message, got, tt.rules)
+			}
+		})
+	}
+}
+
+func TestCheckNegations(t *testing.T) {
+	rules := loadDefault(t)
+	adult := grpc.PatientInfo{Age: 40}
+
+	tests := []struct {
+		message string
+		matches bool
+	}{
+		{"Não tenho dor no peito", false},
+		{"não sinto nenhuma dor no peito, só cansaço", false},
+		{"Estou sem dor no peito hoje", false},
+		{"no chest pain", false},
+		{"I don't have chest pain", false},
+		{"patient denies chest pain or shortness of breath", false},
+		{"never had a stroke", false},
+		{"Não tenho febre, mas estou com dor no peito", true},
+		{"Não tenho febre mas dor no peito", true},
+		{"no fever and chest pain since this morning", true},
+		{"não sei se é dor no peito", true},
+		{"não. dor no peito", true},
+		{"I'm not sure what it is, maybe chest pain", true},
+		{"Não consigo respirar direito", true},
+		{"dor no peito que não passa", true},
+		{"Não tenho dor no peito, mas tenho dor no peito quando subo escada", true},
+	}
+
+	for _, tt := range tests {
+		result := rules.Check(tt.message, adult)
+		if (result != nil) != tt.matches {
+			t.Errorf("Check(%q) = %+v, want a match: %t", tt.message, result, tt.matches)
+		}
+	}
+}
+
+func TestCheckMatchNegated(t *testing.T) {
+	rules := loadDefault(t)
+
+	result := rules.Check("Eu não quero me matar, mas às vezes penso nisso", grpc.PatientInfo{Age: 30})
+	if result == nil || result.Rules[0] != "self-harm" {
+		t.Fatalf("Check() = %+v, want the self-harm rule to match even when negated", result)
+	}
+	if !strings.Contains(result.Guidance, "CVV") {
+		t.Errorf("guidance = %q, want the self-harm guidance", result.Guidance)
+	}
+}
+
+func TestCheckLanguage(t *testing.T) {
+	rules := loadDefault(t)
+
+	result := rules.Check("my chest pain is spreading", grpc.PatientInfo{})
+	if result == nil || result.Language != "en" || !strings.Contains(result.Guidance, "medical emergency") {
+		t.Fatalf("Check() = %+v, want the english emergency guidance", result)
+	}
+	if result.Severity != SeverityEmergency || result.Action != ActionReplace {
+		t.Errorf("severity, action = %s, %s, want emergency, replace", result.Severity, result.Action)
+	}
+}
+
+// escalationRules has an urgent rule followed by an emergency one, each with its own guidance
+const escalationRules = `{
+  "default_language": "en",
+  "guidance": {
+    "emergency": {"en": "EMERGENCY"},
+    "urgent": {"en": "URGENT"}
+  },
+  "rules": [
+    {"id": "urgent-replace", "severity": "urgent", "action": "replace", "match": {"en": {"keywords": ["dizzy"]}}, "guidance": {"en": "SIT DOWN"}},
+    {"id": "urgent-prepend", "severity": "urgent", "action": "prepend", "match": {"en": {"keywords": ["tired"]}}},
+    {"id": "emergency-prepend", "severity": "emergency", "action": "prepend", "match": {"en": {"keywords": ["bleeding"]}}},
+    {"id": "emergency-replace", "severity": "emergency", "action": "replace", "match": {"en": {"keywords": ["unconscious"]}}, "guidance": {"en": "CALL NOW"}}
+  ]
+}`
+
+func TestCheckGuidanceFollowsSeverity(t *testing.T) {
+	rules, err := Parse([]byte(escalationRules))
+	if err != nil {
+		t.Fatalf("Parse() = %v", err)
+	}
+
+	tests := []struct {
+		message  string
+		severity string
+		action   string
+		guidance string
+	}{
+		{"dizzy", SeverityUrgent, ActionReplace, "SIT DOWN"},
+		{"tired and dizzy", SeverityUrgent, ActionReplace, "SIT DOWN"},
+		{"dizzy and bleeding", SeverityEmergency, ActionReplace, "EMERGENCY"},
+		{"tired and bleeding", SeverityEmergency, ActionPrepend, "EMERGENCY"},
+		{"dizzy, bleeding and unconscious", SeverityEmergency, ActionReplace, "CALL NOW"},
+	}
+
+	for _, tt := range tests {
+		result := rules.Check(tt.message, grpc.PatientInfo{})
+		if result == nil || result.Severity != tt.severity || result.Action != tt.action || result.Guidance != tt.guidance {
+			t.Errorf("Check(%q) = %+v, want %s, %s with guidance %s", tt.message, result, tt.severity, tt.action, tt.guidance)
+		}
+	}
+}
+
+func TestParseRejectsInvalidRules(t *testing.T) {
+	guidance := `"guidance": {"emergency": {"en": "E"}, "urgent": {"en": "U"}}`
+	tests := []struct {
+		name   string
+		config string
+	}{
+		{"missing default guidance", `{"default_language": "pt", ` + guidance + `, "rules": []}`},
+		{"rule without ID", `{"default_language": "en", ` + guidance + `, "rules": [{"severity": "urgent", "action": "prepend"}]}`},
+		{"unknown action", `{"default_language": "en", ` + guidance + `, "rules": [{"id": "r", "severity": "urgent", "action": "block"}]}`},
+		{"unknown severity", `{"default_language": "en", ` + guidance + `, "rules": [{"id": "r", "severity": "low", "action": "prepend"}]}`},
+		{"invalid pattern", `{"default_language": "en", ` + guidance + `, "rules": [{"id": "r", "severity": "urgent", "action": "prepend", "match": {"en": {"patterns": ["(unclosed"]}}}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.config)); err == nil {
+				t.Fatal("Parse() accepted an invalid rule set")
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package vitals
+
+import (
+	"fmt"
+	"time"
+
+	"unb.br/web-server/src/grpc"
+)
+
+// Metric describes one kind of measurement found in a set of vital signs
+type Metric struct {
+	Name string
+	Unit string
+	Min  float64
+	Max  float64
+	// value extracts the measurement, reporting false when it was not taken
+	value func(v grpc.VitalSigns) (float64, bool)
+}
+
+// Metrics lists every supported measurement with its plausible range
+var Metrics = []Metric{
+	{Name: "systolic", Unit: "mmHg", Min: 40, Max: 300, value: func(v grpc.VitalSigns) (float64, bool) { return int32Value(v.Systolic) }},
+	{Name: "diastolic", Unit: "mmHg", Min: 20, Max: 200, value: func(v grpc.VitalSigns) (float64, bool) { return int32Value(v.Diastolic) }},
+	{Name: "heart_rate", Unit: "bpm", Min: 20, Max: 300, value: func(v grpc.VitalSigns) (float64, bool) { return int32Value(v.HeartRate) }},
+	{Name: "temperature", Unit: "°C", Min: 25, Max: 45, value: func(v grpc.VitalSigns) (float64, bool) { return float32Value(v.Temperature) }},
+	{Name: "spo2", Unit: "%", Min: 50, Max: 100, value: func(v grpc.VitalSigns) (float64, bool) { return int32Value(v.SpO2) }},
+	{Name: "glucose", Unit: "mg/dL", Min: 10, Max: 1000, value: func(v grpc.VitalSigns) (float64, bool) { return float32Value(v.Glucose) }},
+	{Name: "weight", Unit: "kg", Min: 0.5, Max: 500, value: func(v grpc.VitalSigns) (float64, bool) { return float32Value(v.Weight) }},
+}
+
+// Trend represents the aggregate of one metric over a set of readings
+type Trend struct {
+	Metric  string    `json:"metric"`
+	Unit    string    `json:"unit"`
+	Count   int       `json:"count"`
+	Latest  float64   `json:"latest"`
+	Average float64   `json:"average"`
+	Min     float64   `json:"min"`
+	Max     float64   `json:"max"`
+	Change  float64   `json:"change"`
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+}
+
+// Validate checks that at least one measurement was taken and that all of them are plausible
+func Validate(v grpc.VitalSigns) error {
+	measured := 0
+	for _, metric := range Metrics {
+		value, ok := metric.value(v)
+		if !ok {
+			continue
+		}
+		measured++
+		if value < metric.Min || value > metric.Max {
+			return fmt.Errorf("%s must be between %g and %g %s", metric.Name, metric.Min, metric.Max, metric.Unit)
+		}
+	}
+
+	if measured == 0 {
+		return fmt.Errorf("at least one measurement is required")
+	}
+	if v.Systolic != nil && v.Diastolic != nil && *v.Diastolic >= *v.Systolic {
+		return fmt.Errorf("diastolic must be lower than systolic")
+	}
+	return nil
+}
+
+// Summarize aggregates readings per metric. Readings may come in any order.
+// Metrics without readings are left out.
+func Summarize(readings []grpc.VitalSigns) []Trend {
+	trends := []Trend{}
+	for _, metric := range Metrics {
+		var trend Trend
+		var first, sum float64
+
+		for _, reading := range readings {
+			value, ok := metric.value(reading)
+			if !ok {
+				continue
+			}
+
+			if trend.Count == 0 {
+				trend = Trend{Metric: metric.Name, Unit: metric.Unit, Min: value, Max: value, From: reading.RecordedAt, To: reading.RecordedAt}
+				first, trend.Latest = value, value
+			}
+			trend.Count++
+			sum += value
+			trend.Min = min(trend.Min, value)
+			trend.Max = max(trend.Max, value)
+			if reading.RecordedAt.Before(trend.From) {
+				trend.From, first = reading.RecordedAt, value
+			}
+			if reading.RecordedAt.After(trend.To) {
+				trend.To, trend.Latest = reading.RecordedAt, value
+			}
+		}
+
+		if trend.Count == 0 {
+			continue
+		}
+		trend.Average = sum / float64(trend.Count)
+		trend.Change = trend.Latest - first
+		trends = append(trends, trend)
+	}
+	return trends
+}
+
+// int32Value reads an optional integer measurement
+func int32Value(value *int32) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+	return float64(*value), true
+}
+
+// float32Value reads an optional decimal measurement
+func float32Value(value *float32) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+	return float64(*value), true
+}
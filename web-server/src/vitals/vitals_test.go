@@ -0,0 +1,115 @@
+package vitals
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"unb.br/web-server/src/grpc"
+)
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func float32Ptr(v float32) *float32 {
+	return &v
+}
+
+// at is a reading time, hours after a fixed start
+func at(hours int) time.Time {
+	return time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC).Add(time.Duration(hours) * time.Hour)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		vitals  grpc.VitalSigns
+		wantErr bool
+	}{
+		{"single measurement", grpc.VitalSigns{HeartRate: int32Ptr(72)}, false},
+		{"blood pressure", grpc.VitalSigns{Systolic: int32Ptr(120), Diastolic: int32Ptr(80)}, false},
+		{"decimal measurements", grpc.VitalSigns{Temperature: float32Ptr(36.8), Weight: float32Ptr(70.5)}, false},
+		{"nothing measured", grpc.VitalSigns{}, true},
+		{"below range", grpc.VitalSigns{SpO2: int32Ptr(40)}, true},
+		{"above range", grpc.VitalSigns{Temperature: float32Ptr(46)}, true},
+		{"diastolic above systolic", grpc.VitalSigns{Systolic: int32Ptr(80), Diastolic: int32Ptr(90)}, true},
+	}
+
+	for _, tt := range tests {
+		if err := Validate(tt.vitals); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() = %v, want error %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name     string
+		readings []grpc.VitalSigns
+		want     []Trend
+	}{
+		{
+			name:     "no readings",
+			readings: nil,
+			want:     []Trend{},
+		},
+		{
+			name: "rising heart rate",
+			readings: []grpc.VitalSigns{
+				{RecordedAt: at(0), HeartRate: int32Ptr(70)},
+				{RecordedAt: at(1), HeartRate: int32Ptr(80)},
+				{RecordedAt: at(2), HeartRate: int32Ptr(90)},
+			},
+			want: []Trend{
+				{Metric: "heart_rate", Unit: "bpm", Count: 3, Latest: 90, Average: 80, Min: 70, Max: 90, Change: 20, From: at(0), To: at(2)},
+			},
+		},
+		{
+			name: "newest first",
+			readings: []grpc.VitalSigns{
+				{RecordedAt: at(2), Weight: float32Ptr(68)},
+				{RecordedAt: at(1), Weight: float32Ptr(71)},
+				{RecordedAt: at(0), Weight: float32Ptr(72)},
+			},
+			want: []Trend{
+				{Metric: "weight", Unit: "kg", Count: 3, Latest: 68, Average: 70.333, Min: 68, Max: 72, Change: -4, From: at(0), To: at(2)},
+			},
+		},
+		{
+			name: "metrics measured in different readings",
+			readings: []grpc.VitalSigns{
+				{RecordedAt: at(1), Systolic: int32Ptr(130), Diastolic: int32Ptr(85)},
+				{RecordedAt: at(0), Systolic: int32Ptr(140), Diastolic: int32Ptr(90), SpO2: int32Ptr(97)},
+				{RecordedAt: at(2), HeartRate: int32Ptr(75)},
+			},
+			want: []Trend{
+				{Metric: "systolic", Unit: "mmHg", Count: 2, Latest: 130, Average: 135, Min: 130, Max: 140, Change: -10, From: at(0), To: at(1)},
+				{Metric: "diastolic", Unit: "mmHg", Count: 2, Latest: 85, Average: 87.5, Min: 85, Max: 90, Change: -5, From: at(0), To: at(1)},
+				{Metric: "heart_rate", Unit: "bpm", Count: 1, Latest: 75, Average: 75, Min: 75, Max: 75, Change: 0, From: at(2), To: at(2)},
+				{Metric: "spo2", Unit: "%", Count: 1, Latest: 97, Average: 97, Min: 97, Max: 97, Change: 0, From: at(0), To: at(0)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Summarize(tt.readings)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Summarize() = %+v, want %+v", got, tt.want)
+			}
+			for i, want := range tt.want {
+				if !trendEqual(got[i], want) {
+					t.Errorf("trend %d = %+v, want %+v", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+// trendEqual compares trends, allowing for rounding in the aggregates
+func trendEqual(a, b Trend) bool {
+	near := func(x, y float64) bool { return math.Abs(x-y) < 0.001 }
+	return a.Metric == b.Metric && a.Unit == b.Unit && a.Count == b.Count && a.From.Equal(b.From) && a.To.Equal(b.To) &&
+		near(a.Latest, b.Latest) && near(a.Average, b.Average) && near(a.Min, b.Min) && near(a.Max, b.Max) && near(a.Change, b.Change)
+}